@@ -2,80 +2,204 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
 
 	"github.com/coneno/logger"
 	"github.com/influenzanet/study-service/pkg/api"
 	"github.com/influenzanet/user-management-service/internal/config"
+	"github.com/influenzanet/user-management-service/pkg/auditlog"
+	"github.com/influenzanet/user-management-service/pkg/authbackend"
+	"github.com/influenzanet/user-management-service/pkg/callerauth"
 	"github.com/influenzanet/user-management-service/pkg/dbs/globaldb"
 	"github.com/influenzanet/user-management-service/pkg/dbs/userdb"
+	"github.com/influenzanet/user-management-service/pkg/emailoutbox"
+	"github.com/influenzanet/user-management-service/pkg/erasure"
+	"github.com/influenzanet/user-management-service/pkg/gateway"
 	gc "github.com/influenzanet/user-management-service/pkg/grpc/clients"
 	"github.com/influenzanet/user-management-service/pkg/grpc/service"
+	"github.com/influenzanet/user-management-service/pkg/instances"
+	"github.com/influenzanet/user-management-service/pkg/instancesettings"
+	"github.com/influenzanet/user-management-service/pkg/metrics"
 	"github.com/influenzanet/user-management-service/pkg/models"
+	"github.com/influenzanet/user-management-service/pkg/scim"
 	"github.com/influenzanet/user-management-service/pkg/timer_event"
+	"github.com/influenzanet/user-management-service/pkg/tlsconfig"
+	"github.com/influenzanet/user-management-service/pkg/tracing"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
 )
 
-const userManagementTimerEventFrequency = 90 * 60 // seconds
-
 func main() {
-	conf := config.InitConfig()
+	configFile := flag.String("config", os.Getenv(config.ENV_CONFIG_FILE), "YAML or JSON config file, environment variables override its settings")
+	checkConfig := flag.Bool("check-config", false, "print the effective configuration with secrets redacted and exit")
+	flag.Parse()
+
+	conf, err := config.Load(*configFile)
+	if *checkConfig {
+		os.Exit(printConfig(conf, err))
+	}
+	if err != nil {
+		logger.Error.Fatal(err)
+	}
 
 	logger.SetLevel(conf.LogLevel)
 
+	// cancelled on SIGINT or SIGTERM, stopping all servers and background jobs
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	go func() {
+		<-ctx.Done()
+		logger.Info.Println("shutting down, repeat the signal to exit immediately")
+		stop()
+	}()
+
+	shutdownTracing, err := tracing.Init(ctx, conf.Tracing)
+	if err != nil {
+		logger.Error.Fatalf("failed to initialize tracing: %v", err)
+	}
+	defer shutdownTracing(context.Background())
+
 	clients := &models.APIClients{}
 
-	messagingClient, close := gc.ConnectToMessagingService(conf.ServiceURLs.MessagingService)
+	messagingClient, close := gc.ConnectToMessagingService(conf.ServiceURLs.MessagingService, mustClientTLSConfig(ctx, "messaging service", conf.ServiceTLS.MessagingService))
 	defer close()
 	clients.MessagingService = messagingClient
 
-	loggingClient, close := gc.ConnectToLoggingService(conf.ServiceURLs.LoggingService)
+	loggingClient, close := gc.ConnectToLoggingService(conf.ServiceURLs.LoggingService, mustClientTLSConfig(ctx, "logging service", conf.ServiceTLS.LoggingService))
 	defer close()
-	clients.LoggingService = loggingClient
 
 	var studyClient api.StudyServiceApiClient
-	if shouldConnectToStudyService(conf.DeleteAccountAfterNotifyingUser) {
-		studyClient, close = gc.ConnectToStudyService(conf.ServiceURLs.StudyService)
+	if shouldConnectToStudyService(conf) {
+		studyClient, close = gc.ConnectToStudyService(conf.ServiceURLs.StudyService, mustClientTLSConfig(ctx, "study service", conf.ServiceTLS.StudyService))
 		defer close()
 	}
 	clients.StudyService = studyClient
 
 	userDBService := userdb.NewUserDBService(conf.UserDBConfig)
+	if conf.FieldKeys != nil {
+		userDBService.EnableFieldEncryption(conf.FieldKeys)
+	}
 	globalDBService := globaldb.NewGlobalDBService(conf.GlobalDBConfig)
 
-	// Read instance ID list
-	instanceIDObjects, err := globalDBService.GetAllInstances()
-	if err != nil {
+	// Log events are written to an outbox and delivered in the background
+	clients.LoggingService = auditlog.NewOutboxClient(loggingClient, globalDBService)
+	// Emails too, so that they are retried while the messaging service is unavailable
+	clients.MessagingService = emailoutbox.NewOutboxClient(messagingClient, userDBService)
+
+	// Read instance ID list, kept up to date in the background
+	instanceRegistry := instances.NewRegistry(globalDBService)
+	instanceRegistry.OnAdded(func(instanceID string) { ensureDBIndexes(instanceID, userDBService) })
+	instanceRegistry.OnRemoved(metrics.RemoveInstance)
+	if err := instanceRegistry.Refresh(); err != nil {
 		logger.Error.Fatalf("Couldn't read instance IDs: %v", err)
 	}
-	if len(instanceIDObjects) == 0 {
-		logger.Error.Fatal("No instance ID found in the database.")
-	}
-	instanceIDs := []string{}
-	for _, instanceIDObject := range instanceIDObjects {
-		instanceIDs = append(instanceIDs, instanceIDObject.InstanceID)
+	if len(instanceRegistry.IDs()) == 0 {
+		logger.Warning.Println("No instance ID found in the database, waiting for instances to be added.")
 	}
 
-	// Ensure indexes
-	ensureDBIndexes(instanceIDs, userDBService)
+	// background tasks using the DBs, waited for before disconnecting
+	var background sync.WaitGroup
+	runInBackground(&background, func() { instanceRegistry.Run(ctx) })
+	// a server failing shuts the service down, its error is reported once the background tasks stopped
+	serverErrs := make(chan error, 4)
+	runServer := func(name string, run func() error) {
+		runInBackground(&background, func() {
+			if err := run(); err != nil {
+				serverErrs <- fmt.Errorf("%s: %w", name, err)
+				stop()
+			}
+		})
+	}
 
-	ctx := context.Background()
+	instanceSettings := instancesettings.NewStore(globalDBService, conf.InstanceSettingsDefaults())
+	if err := instanceSettings.Refresh(); err != nil {
+		logger.Error.Fatalf("Couldn't read instance settings: %v", err)
+	}
+	runInBackground(&background, func() { instanceSettings.Run(ctx) })
+	runInBackground(&background, func() { auditlog.NewDispatcher(loggingClient, globalDBService).Run(ctx) })
+	runInBackground(&background, func() { emailoutbox.NewDispatcher(messagingClient, userDBService, instanceRegistry).Run(ctx) })
 
 	// Start timer thread
 	if !conf.DisableTimerTask {
 		userTimerService := timer_event.NewUserManagmentTimerService(
-			userManagementTimerEventFrequency,
+			conf.Jobs,
 			globalDBService,
 			userDBService,
 			clients,
-			conf.CleanUpUnverifiedUsersAfter,
-			conf.ReminderToUnverifiedAccountsAfter,
-			conf.NotifyInactiveUsersAfter,
-			conf.DeleteAccountAfterNotifyingUser,
+			instanceRegistry,
+			instanceSettings,
 		)
 		userTimerService.Run(ctx)
+		runInBackground(&background, userTimerService.Wait)
 	} else {
 		logger.Info.Println("Timer task is disabled")
 	}
 
+	// Start SCIM provisioning endpoint
+	if conf.ScimPort != "" {
+		scimServer := scim.NewServer(
+			clients,
+			userDBService,
+			globalDBService,
+			instanceSettings,
+			instanceRegistry,
+		)
+		runServer("SCIM endpoint", func() error { return scim.RunServer(ctx, conf.ScimPort, scimServer) })
+	} else {
+		logger.Info.Println("SCIM endpoint is disabled")
+	}
+
+	// Start Prometheus metrics endpoint
+	if conf.MetricsPort != "" {
+		runServer("metrics endpoint", func() error {
+			return metrics.RunServer(ctx, conf.MetricsPort, instanceRegistry.IDs, userDBService.CountUsers)
+		})
+	} else {
+		logger.Info.Println("Metrics endpoint is disabled")
+	}
+
+	var callerAuth *callerauth.Authenticator
+	if conf.CallerPolicy != nil {
+		callerAuth = callerauth.NewAuthenticator(*conf.CallerPolicy, conf.ServiceTokenKey)
+	}
+
+	// Start HTTP/JSON gateway, forwarding to the gRPC server
+	var inProcessListener *bufconn.Listener
+	if conf.GatewayPort != "" {
+		inProcessListener = service.NewInProcessListener()
+		gatewayDialOpts := []grpc.DialOption{service.InProcessDialOption(inProcessListener)}
+		if len(conf.ServiceTokenKey) > 0 {
+			token, err := callerauth.NewServiceToken(conf.ServiceTokenKey, callerauth.CallerAPIGateway, 0)
+			if err != nil {
+				logger.Error.Fatal(err)
+			}
+			gatewayDialOpts = append(gatewayDialOpts, grpc.WithPerRPCCredentials(callerauth.NewTokenCredentials(token, false)))
+		} else if callerAuth != nil {
+			logger.Warning.Println("no service token key set, calls through the HTTP gateway will be rejected")
+		}
+		runServer("HTTP gateway", func() error {
+			return gateway.RunServer(ctx, conf.GatewayPort, service.InProcessAddress, gatewayDialOpts...)
+		})
+	} else {
+		logger.Info.Println("HTTP gateway is disabled")
+	}
+
+	var serverTLSConf *tls.Config
+	if conf.ServerTLS.Enabled() {
+		serverTLSConf, err = tlsconfig.NewServerTLSConfig(ctx, conf.ServerTLS)
+		if err != nil {
+			logger.Error.Fatalf("gRPC server TLS: %v", err)
+		}
+	} else {
+		logger.Warning.Println("gRPC server TLS is disabled")
+	}
+
 	// Start server thread
 	if err := service.RunServer(
 		ctx,
@@ -83,25 +207,90 @@ func main() {
 		clients,
 		userDBService,
 		globalDBService,
-		conf.Intervals,
-		conf.NewUserCountLimit,
-		conf.WeekDayStrategy,
-		instanceIDs,
+		instanceSettings,
+		instanceRegistry,
+		authbackend.NewRegistry(conf.AuthBackends),
+		conf.Jobs,
+		callerAuth,
+		service.GRPCWebConfig{
+			Port:           conf.GRPCWeb.Port,
+			AllowedOrigins: conf.GRPCWeb.AllowedOrigins,
+		},
+		conf.EnableGRPCReflection,
+		serverTLSConf,
+		inProcessListener,
+		conf.ShutdownTimeout,
 	); err != nil {
+		serverErrs <- fmt.Errorf("gRPC server: %w", err)
+		stop()
+	}
+
+	logger.Info.Println("waiting for background jobs to stop...")
+	background.Wait()
+	if err := userDBService.Close(); err != nil {
+		logger.Error.Printf("failed to disconnect from user DB: %v", err)
+	}
+	if err := globalDBService.Close(); err != nil {
+		logger.Error.Printf("failed to disconnect from global DB: %v", err)
+	}
+	select {
+	case err := <-serverErrs:
 		logger.Error.Fatal(err)
+	default:
+	}
+	logger.Info.Println("shutdown complete")
+}
+
+func runInBackground(wg *sync.WaitGroup, task func()) {
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		task()
+	}()
+}
+
+func ensureDBIndexes(instanceID string, udb *userdb.UserDBService) {
+	logger.Debug.Printf("ensuring indexes for instance %s", instanceID)
+
+	if err := udb.CreateIndexForRenewTokens(instanceID); err != nil {
+		logger.Error.Printf("renew tokens: failed to create indexes for %s: %v", instanceID, err)
+	}
+	if err := udb.CreateIndexForUser(instanceID); err != nil {
+		logger.Error.Printf("users: failed to create indexes for %s: %v", instanceID, err)
+	}
+	if err := udb.CreateIndexForOutgoingEmails(instanceID, emailoutbox.SentEmailsTTL); err != nil {
+		logger.Error.Printf("email outbox: failed to create indexes for %s: %v", instanceID, err)
+	}
+	if err := udb.CreateIndexForErasures(instanceID, erasure.FinishedErasuresTTL); err != nil {
+		logger.Error.Printf("erasures: failed to create indexes for %s: %v", instanceID, err)
 	}
 }
 
-func ensureDBIndexes(instanceIDs []string, udb *userdb.UserDBService) {
-	for _, i := range instanceIDs {
-		logger.Debug.Printf("ensuring indexes for instance %s", i)
+// printConfig writes the configuration to stdout and its problems to stderr, returns the exit code
+func printConfig(conf config.Config, configErr error) int {
+	out, err := conf.RedactedYAML()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	fmt.Print(string(out))
+	if configErr != nil {
+		fmt.Fprintln(os.Stderr, configErr)
+		return 1
+	}
+	return 0
+}
 
-		udb.CreateIndexForRenewTokens(i)
-		udb.CreateIndexForUser(i)
-		// TODO: ensure index for users collection as well
+func mustClientTLSConfig(ctx context.Context, name string, conf tlsconfig.ClientConfig) *tls.Config {
+	tlsConf, err := tlsconfig.NewClientTLSConfig(ctx, conf)
+	if err != nil {
+		logger.Error.Fatalf("TLS of %s connection: %v", name, err)
 	}
+	return tlsConf
 }
 
-func shouldConnectToStudyService(deleteAccountAfterNotifyingUser int64) bool {
-	return deleteAccountAfterNotifyingUser > 0
+// shouldConnectToStudyService tells if the study service is notified of deleted accounts, which admins and the jobs
+// may both delete
+func shouldConnectToStudyService(conf config.Config) bool {
+	return conf.ServiceURLs.StudyService != ""
 }
@@ -1,13 +1,19 @@
 package config
 
 import (
+	b64 "encoding/base64"
 	"fmt"
-	"os"
-	"strconv"
 	"time"
 
 	"github.com/coneno/logger"
+	"github.com/influenzanet/user-management-service/pkg/authbackend"
+	"github.com/influenzanet/user-management-service/pkg/callerauth"
+	"github.com/influenzanet/user-management-service/pkg/fieldcrypt"
+	"github.com/influenzanet/user-management-service/pkg/instancesettings"
+	"github.com/influenzanet/user-management-service/pkg/jobs"
 	"github.com/influenzanet/user-management-service/pkg/models"
+	"github.com/influenzanet/user-management-service/pkg/tlsconfig"
+	"github.com/influenzanet/user-management-service/pkg/tracing"
 	"github.com/influenzanet/user-management-service/pkg/utils"
 )
 
@@ -15,11 +21,24 @@ import (
 type Config struct {
 	LogLevel    logger.LogLevel
 	Port        string
+	ScimPort    string
+	GatewayPort string
+	MetricsPort string
+	GRPCWeb     struct {
+		Port           string
+		AllowedOrigins []string
+	}
 	ServiceURLs struct {
 		MessagingService string
 		LoggingService   string
 		StudyService     string
 	}
+	ServiceTLS struct {
+		MessagingService tlsconfig.ClientConfig
+		LoggingService   tlsconfig.ClientConfig
+		StudyService     tlsconfig.ClientConfig
+	}
+	ServerTLS                         tlsconfig.ServerConfig
 	UserDBConfig                      models.DBConfig
 	GlobalDBConfig                    models.DBConfig
 	Intervals                         models.Intervals
@@ -28,120 +47,260 @@ type Config struct {
 	ReminderToUnverifiedAccountsAfter int64
 	NotifyInactiveUsersAfter          int64
 	DeleteAccountAfterNotifyingUser   int64
+	AccountDeletionGracePeriod        int64
 
 	WeekDayStrategy utils.WeekDayStrategy
+	// WeekdayAssignationWeights the strategy was created from, empty for random weekdays
+	WeekdayAssignationWeights string
 
 	DisableTimerTask bool
+	// Jobs are all disabled if the timer task is disabled
+	Jobs []jobs.Config
+
+	// ShutdownTimeout is how long in-flight RPCs may take to complete after SIGTERM
+	ShutdownTimeout time.Duration
+
+	EnableGRPCReflection bool
+
+	AuthBackends authbackend.Config
+
+	// FieldKeys encrypt the email addresses in the user DB, nil if they are stored in plaintext
+	FieldKeys *fieldcrypt.KeyRing
+
+	// CallerPolicy is nil if caller authentication is disabled
+	CallerPolicy    *callerauth.Policy
+	ServiceTokenKey []byte
+
+	Tracing tracing.Config
+
+	// settings the config was created from
+	settings Settings
 }
 
-func InitConfig() Config {
-	conf := Config{}
-	conf.Port = os.Getenv(ENV_USER_MANAGEMENT_LISTEN_PORT)
-	conf.ServiceURLs.MessagingService = os.Getenv(ENV_ADDR_MESSAGING_SERVICE)
-	conf.ServiceURLs.LoggingService = os.Getenv(ENV_ADDR_LOGGING_SERVICE)
-	conf.ServiceURLs.StudyService = os.Getenv(ENV_ADDR_STUDY_SERVICE)
+// toConfig validates the settings and converts them, problems are added to errs
+func (s Settings) toConfig(errs *Errors) Config {
+	conf := Config{settings: s}
+
+	logLevel, err := parseLogLevel(s.LogLevel)
+	if err != nil {
+		errs.add("logLevel (%s): %v", ENV_LOG_LEVEL, err)
+	}
+	conf.LogLevel = logLevel
+
+	conf.Port = s.Ports.GRPC
+	if conf.Port == "" {
+		errs.add("ports.grpc (%s): must be set", ENV_USER_MANAGEMENT_LISTEN_PORT)
+	}
+	conf.ScimPort = s.Ports.Scim
+	conf.GatewayPort = s.Ports.Gateway
+	conf.MetricsPort = s.Ports.Metrics
+	conf.GRPCWeb.Port = s.Ports.GRPCWeb
+	conf.GRPCWeb.AllowedOrigins = s.GRPCWebAllowedOrigins
+	conf.EnableGRPCReflection = s.GRPCReflection
+	conf.ShutdownTimeout = positiveDuration(s.ShutdownTimeout, "shutdownTimeout", ENV_SHUTDOWN_TIMEOUT, errs)
+
+	conf.ServiceURLs.MessagingService = s.Services.Messaging.Address
+	if conf.ServiceURLs.MessagingService == "" {
+		errs.add("services.messaging.address (%s): must be set", ENV_ADDR_MESSAGING_SERVICE)
+	}
+	conf.ServiceURLs.LoggingService = s.Services.Logging.Address
+	if conf.ServiceURLs.LoggingService == "" {
+		errs.add("services.logging.address (%s): must be set", ENV_ADDR_LOGGING_SERVICE)
+	}
+	conf.ServiceURLs.StudyService = s.Services.Study.Address
 	if conf.ServiceURLs.StudyService == "" {
 		logger.Warning.Printf("Address of study service: not provided, can not connect to study service")
 	}
+	conf.ServiceTLS.MessagingService = s.Services.Messaging.clientTLSConfig()
+	conf.ServiceTLS.LoggingService = s.Services.Logging.clientTLSConfig()
+	conf.ServiceTLS.StudyService = s.Services.Study.clientTLSConfig()
+	conf.ServerTLS = tlsconfig.ServerConfig{
+		CertFile:          s.ServerTLS.CertFile,
+		KeyFile:           s.ServerTLS.KeyFile,
+		ClientCAFile:      s.ServerTLS.ClientCAFile,
+		RequireClientCert: s.ServerTLS.RequireClientCert,
+	}
 
-	conf.LogLevel = getLogLevel()
-	conf.UserDBConfig = GetUserDBConfig()
-	conf.GlobalDBConfig = GetGlobalDBConfig()
-	conf.Intervals = getIntervalsConfig()
+	validateDBClient(s.DB, errs)
+	conf.UserDBConfig = dbConfig("userDB", "USER_DB", s.UserDB, s.DB, errs)
+	conf.GlobalDBConfig = dbConfig("globalDB", "GLOBAL_DB", s.GlobalDB, s.DB, errs)
 
-	rl, err := strconv.Atoi(os.Getenv(ENV_NEW_USER_RATE_LIMIT))
-	if err != nil {
-		logger.Error.Fatal(ENV_NEW_USER_RATE_LIMIT, ":"+err.Error())
+	conf.Intervals = models.Intervals{
+		TokenExpiryInterval:              positiveDuration(s.Intervals.TokenExpiration, "intervals.tokenExpiration", ENV_TOKEN_EXPIRATION_MIN, errs),
+		VerificationCodeLifetime:         seconds(positiveDuration(s.Intervals.VerificationCodeLifetime, "intervals.verificationCodeLifetime", ENV_VERIFICATION_CODE_LIFETIME, errs)),
+		InvitationTokenLifetime:          positiveDuration(s.Intervals.InvitationTokenLifetime, "intervals.invitationTokenLifetime", ENV_TOKEN_INVITATION_LIFETIME, errs),
+		ContactVerificationTokenLifetime: positiveDuration(s.Intervals.ContactVerificationTokenLifetime, "intervals.contactVerificationTokenLifetime", ENV_TOKEN_CONTACT_VERIFICATION_LIFETIME, errs),
 	}
-	conf.NewUserCountLimit = int64(rl)
 
-	cleanUpThreshold, err := strconv.Atoi(os.Getenv(ENV_CLEAN_UP_UNVERIFIED_USERS_AFTER))
-	if err != nil {
-		logger.Error.Fatal(ENV_CLEAN_UP_UNVERIFIED_USERS_AFTER, ":"+err.Error())
+	conf.NewUserCountLimit = s.NewUserRateLimit
+	if conf.NewUserCountLimit < 1 {
+		errs.add("newUserRateLimit (%s): must be a positive number", ENV_NEW_USER_RATE_LIMIT)
 	}
-	conf.CleanUpUnverifiedUsersAfter = int64(cleanUpThreshold)
 
-	reminderToUnverifiedAccountsAfter, err := strconv.Atoi(os.Getenv(ENV_SEND_REMINDER_TO_UNVERIFIED_USERS_AFTER))
-	if err != nil {
-		logger.Error.Fatal(ENV_SEND_REMINDER_TO_UNVERIFIED_USERS_AFTER + ": " + err.Error())
+	conf.DisableTimerTask = s.TimerTasks.Disabled
+	if !conf.DisableTimerTask {
+		conf.CleanUpUnverifiedUsersAfter = seconds(positiveDuration(s.TimerTasks.CleanUpUnverifiedUsersAfter, "timerTasks.cleanUpUnverifiedUsersAfter", ENV_CLEAN_UP_UNVERIFIED_USERS_AFTER, errs))
+		conf.ReminderToUnverifiedAccountsAfter = seconds(positiveDuration(s.TimerTasks.ReminderToUnverifiedAccountsAfter, "timerTasks.reminderToUnverifiedAccountsAfter", ENV_SEND_REMINDER_TO_UNVERIFIED_USERS_AFTER, errs))
+	}
+	conf.NotifyInactiveUsersAfter = seconds(time.Duration(s.TimerTasks.NotifyInactiveUsersAfter))
+	conf.DeleteAccountAfterNotifyingUser = seconds(time.Duration(s.TimerTasks.DeleteAccountAfterNotifyingUser))
+	if conf.NotifyInactiveUsersAfter < 0 || conf.DeleteAccountAfterNotifyingUser < 0 {
+		errs.add("timerTasks.notifyInactiveUsersAfter (%s) and timerTasks.deleteAccountAfterNotifyingUser (%s): must not be negative", ENV_NOTIFY_INACTIVE_USERS_AFTER, ENV_DELETE_ACCOUNT_AFTER_NOTIFYING_USER)
+	} else if (conf.NotifyInactiveUsersAfter > 0) != (conf.DeleteAccountAfterNotifyingUser > 0) {
+		logger.Info.Printf("%s and %s: both must be set, inactive users will be ignored", ENV_NOTIFY_INACTIVE_USERS_AFTER, ENV_DELETE_ACCOUNT_AFTER_NOTIFYING_USER)
 	}
-	conf.ReminderToUnverifiedAccountsAfter = int64(reminderToUnverifiedAccountsAfter)
 
-	notifyInactiveUsersAfter, err := strconv.Atoi(os.Getenv(ENV_NOTIFY_INACTIVE_USERS_AFTER))
-	if err != nil {
-		logger.Info.Printf(ENV_NOTIFY_INACTIVE_USERS_AFTER + ": not provided, inactive users will be ignored")
-		conf.NotifyInactiveUsersAfter = defaultNotifyInactiveUsersAfter
+	conf.AccountDeletionGracePeriod = seconds(positiveDuration(s.TimerTasks.AccountDeletionGracePeriod, "timerTasks.accountDeletionGracePeriod", ENV_ACCOUNT_DELETION_GRACE_PERIOD, errs))
+
+	conf.Jobs = s.jobConfigs(errs)
+
+	conf.WeekDayStrategy = utils.CreateWeekdayDefaultStrategy()
+	conf.WeekdayAssignationWeights = s.WeekdayAssignationWeights
+	if s.WeekdayAssignationWeights != "" {
+		w, err := utils.ParseWeeklyWeight(s.WeekdayAssignationWeights)
+		if err != nil {
+			errs.add("weekdayAssignationWeights (%s): %v", ENV_WEEKDAY_ASSIGNATION_WEIGHTS, err)
+		} else {
+			conf.WeekDayStrategy = utils.CreateWeekdayWeightedStrategy(w)
+		}
 	}
-	conf.NotifyInactiveUsersAfter = int64(notifyInactiveUsersAfter)
 
-	deleteAccountAfterNotifyingUser, err := strconv.Atoi(os.Getenv(ENV_DELETE_ACCOUNT_AFTER_NOTIFYING_USER))
+	authBackends, err := authbackend.LoadConfig(s.AuthBackendsFile)
 	if err != nil {
-		logger.Info.Printf(ENV_DELETE_ACCOUNT_AFTER_NOTIFYING_USER + ": not provided, inactive users will be ignored")
-		conf.DeleteAccountAfterNotifyingUser = defaultDeleteAccountAfterNotifyingUser
+		errs.add("authBackendsConfigFile (%s): %v", ENV_AUTH_BACKENDS_CONFIG_FILE, err)
 	}
-	conf.DeleteAccountAfterNotifyingUser = int64(deleteAccountAfterNotifyingUser)
-
-	conf.WeekDayStrategy = GetWeekDayStrategy()
+	conf.AuthBackends = authBackends
 
-	conf.DisableTimerTask = os.Getenv(ENV_DISABLE_TIMER_TASK) == "true"
-	return conf
-}
+	if s.FieldEncryptionFile != "" {
+		keys, err := fieldcrypt.LoadKeyRing(s.FieldEncryptionFile)
+		if err != nil {
+			errs.add("fieldEncryptionKeysFile (%s): %v", ENV_FIELD_ENCRYPTION_KEYS_FILE, err)
+		}
+		conf.FieldKeys = keys
+	}
 
-// Get Weekday attribution strategy
-func GetWeekDayStrategy() utils.WeekDayStrategy {
+	conf.CallerPolicy, conf.ServiceTokenKey = s.callerAuthConfig(errs)
 
-	wday := os.Getenv(ENV_WEEKDAY_ASSIGNATION_WEIGHTS)
-	if wday == "" {
-		return utils.CreateWeekdayDefaultStrategy()
+	conf.Tracing = tracing.Config{
+		Exporter:    s.Tracing.Exporter,
+		SampleRatio: s.Tracing.SampleRatio,
 	}
-	w, err := utils.ParseWeeklyWeight(wday)
-	if err != nil {
-		logger.Error.Fatalf("%s : %s", ENV_WEEKDAY_ASSIGNATION_WEIGHTS, err)
+	switch conf.Tracing.Exporter {
+	case "", tracing.ExporterOTLP, tracing.ExporterStdout:
+	default:
+		errs.add("tracing.exporter (%s): must be %s, %s or empty", ENV_TRACING_EXPORTER, tracing.ExporterOTLP, tracing.ExporterStdout)
+	}
+	if conf.Tracing.SampleRatio < 0 || conf.Tracing.SampleRatio > 1 {
+		errs.add("tracing.sampleRatio (%s): must be a number between 0 and 1", ENV_TRACING_SAMPLE_RATIO)
 	}
+	return conf
+}
 
-	strategy := utils.CreateWeekdayWeightedStrategy(w)
-	fmt.Println("Weekday Strategy: ", strategy.String())
-	return strategy
+// InstanceSettingsDefaults are the settings of the instances without settings of their own
+func (c Config) InstanceSettingsDefaults() instancesettings.Settings {
+	return instancesettings.Settings{
+		Intervals:                         c.Intervals,
+		NewUserCountLimit:                 c.NewUserCountLimit,
+		MaxProfiles:                       instancesettings.DefaultMaxProfiles,
+		WeekdayAssignationWeights:         c.WeekdayAssignationWeights,
+		WeekdayStrategy:                   c.WeekDayStrategy,
+		SecondFactorPolicy:                instancesettings.SecondFactorOptional,
+		CleanUpUnverifiedUsersAfter:       c.CleanUpUnverifiedUsersAfter,
+		ReminderToUnverifiedAccountsAfter: c.ReminderToUnverifiedAccountsAfter,
+		NotifyInactiveUsersAfter:          c.NotifyInactiveUsersAfter,
+		DeleteAccountAfterNotifyingUser:   c.DeleteAccountAfterNotifyingUser,
+		AccountDeletionGracePeriod:        c.AccountDeletionGracePeriod,
+		RetentionAction:                   instancesettings.RetentionActionDelete,
+	}
 }
 
-func getLogLevel() logger.LogLevel {
-	switch os.Getenv(ENV_LOG_LEVEL) {
+func parseLogLevel(level string) (logger.LogLevel, error) {
+	switch level {
 	case "debug":
-		return logger.LEVEL_DEBUG
-	case "info":
-		return logger.LEVEL_INFO
+		return logger.LEVEL_DEBUG, nil
+	case "info", "":
+		return logger.LEVEL_INFO, nil
 	case "error":
-		return logger.LEVEL_ERROR
+		return logger.LEVEL_ERROR, nil
 	case "warning":
-		return logger.LEVEL_WARNING
+		return logger.LEVEL_WARNING, nil
 	default:
-		return logger.LEVEL_INFO
+		return logger.LEVEL_INFO, fmt.Errorf("unknown level '%s', expected debug, info, warning or error", level)
 	}
 }
 
-func getIntervalsConfig() models.Intervals {
-	intervals := models.Intervals{
-		TokenExpiryInterval:      time.Minute * time.Duration(defaultTokenExpirationMin),
-		VerificationCodeLifetime: defaultVerificationCodeLifetime,
+func positiveDuration(d Duration, name string, env string, errs *Errors) time.Duration {
+	if d <= 0 {
+		errs.add("%s (%s): must be a positive duration", name, env)
 	}
+	return time.Duration(d)
+}
 
-	accessTokenExpiration, err := strconv.Atoi(os.Getenv(ENV_TOKEN_EXPIRATION_MIN))
-	if err != nil {
-		logger.Info.Printf("using default token expiration: %s", intervals.TokenExpiryInterval)
-	} else {
-		intervals.TokenExpiryInterval = time.Minute * time.Duration(accessTokenExpiration)
+func seconds(d time.Duration) int64 {
+	return int64(d / time.Second)
+}
+
+func (s Settings) callerAuthConfig(errs *Errors) (*callerauth.Policy, []byte) {
+	var tokenKey []byte
+	if s.ServiceTokenKey != "" {
+		key, err := b64.StdEncoding.DecodeString(s.ServiceTokenKey)
+		if err != nil {
+			errs.add("serviceTokenKey (%s): %v", ENV_SERVICE_TOKEN_KEY, err)
+		} else if len(key) < 32 {
+			errs.add("serviceTokenKey (%s): key must be at least 32 bytes long", ENV_SERVICE_TOKEN_KEY)
+		} else {
+			tokenKey = key
+		}
 	}
 
-	newVerificationCodeLifetime, err := strconv.Atoi(os.Getenv(ENV_VERIFICATION_CODE_LIFETIME))
+	if s.CallerPolicyFile == "" {
+		logger.Warning.Println(ENV_CALLER_POLICY_FILE + ": not provided, callers of the gRPC API are not authenticated")
+		return nil, tokenKey
+	}
+	policy, err := callerauth.LoadPolicy(s.CallerPolicyFile)
 	if err != nil {
-		logger.Info.Println("using default verification code lifetime")
-	} else {
-		intervals.VerificationCodeLifetime = int64(newVerificationCodeLifetime)
+		errs.add("callerPolicyFile (%s): %v", ENV_CALLER_POLICY_FILE, err)
+		return nil, tokenKey
 	}
+	return &policy, tokenKey
+}
 
-	intervals.InvitationTokenLifetime = parseEnvDuration(ENV_TOKEN_INVITATION_LIFETIME, defaultInvitationTokenLifetime, "m")
+// jobConfigs parses the schedules of the jobs
+func (s Settings) jobConfigs(errs *Errors) []jobs.Config {
+	j := s.TimerTasks.Jobs
+	settings := []struct {
+		job       string
+		key       string
+		envPrefix string
+		JobSettings
+	}{
+		{jobs.CleanUpUnverifiedUsers, "cleanUpUnverifiedUsers", ENV_PREFIX_JOB_CLEAN_UP_UNVERIFIED_USERS, j.CleanUpUnverifiedUsers},
+		{jobs.ReminderToConfirmAccount, "reminderToConfirmAccount", ENV_PREFIX_JOB_REMINDER_TO_CONFIRM_ACCOUNT, j.ReminderToConfirmAccount},
+		{jobs.DetectAndNotifyInactiveUsers, "notifyInactiveUsers", ENV_PREFIX_JOB_NOTIFY_INACTIVE_USERS, j.NotifyInactiveUsers},
+		{jobs.CleanupUsersMarkedForDeletion, "cleanUpUsersMarkedForDeletion", ENV_PREFIX_JOB_CLEAN_UP_USERS_MARKED_FOR_DELETION, j.CleanUpUsersMarkedForDeletion},
+		{jobs.CleanUpExpiredTempTokens, "cleanUpExpiredTempTokens", ENV_PREFIX_JOB_CLEAN_UP_EXPIRED_TEMP_TOKENS, j.CleanUpExpiredTempTokens},
+		{jobs.CompleteAccountDeletions, "completeAccountDeletions", ENV_PREFIX_JOB_COMPLETE_ACCOUNT_DELETIONS, j.CompleteAccountDeletions},
+		{jobs.ResumeAccountErasures, "resumeAccountErasures", ENV_PREFIX_JOB_RESUME_ACCOUNT_ERASURES, j.ResumeAccountErasures},
+	}
 
-	intervals.ContactVerificationTokenLifetime = parseEnvDuration(ENV_TOKEN_CONTACT_VERIFICATION_LIFETIME, defaultContactVerificationTokenLifetime, "m")
+	configs := []jobs.Config{}
+	for _, js := range settings {
+		c, err := jobs.NewConfig(js.job, js.Schedule, js.Enabled && !s.TimerTasks.Disabled)
+		if err != nil {
+			errs.add("timerTasks.jobs.%s.schedule (%s%s): %v", js.key, js.envPrefix, ENV_SUFFIX_JOB_SCHEDULE, err)
+			continue
+		}
+		configs = append(configs, c)
+	}
+	return configs
+}
 
-	return intervals
+func (s ServiceSettings) clientTLSConfig() tlsconfig.ClientConfig {
+	return tlsconfig.ClientConfig{
+		Enabled:    s.TLS.Enabled,
+		CAFile:     s.TLS.CAFile,
+		CertFile:   s.TLS.CertFile,
+		KeyFile:    s.TLS.KeyFile,
+		ServerName: s.TLS.ServerName,
+	}
 }
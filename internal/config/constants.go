@@ -3,6 +3,9 @@ package config
 import "time"
 
 const (
+	// ENV_CONFIG_FILE is the YAML or JSON config file, if the --config flag is not given
+	ENV_CONFIG_FILE = "CONFIG_FILE"
+
 	ENV_VERIFICATION_CODE_LIFETIME          = "VERIFICATION_CODE_LIFETIME"
 	ENV_TOKEN_EXPIRATION_MIN                = "TOKEN_EXPIRATION_MIN"
 	ENV_TOKEN_INVITATION_LIFETIME           = "INVITATION_TOKEN_LIFETIME"
@@ -12,10 +15,19 @@ const (
 	ENV_SEND_REMINDER_TO_UNVERIFIED_USERS_AFTER = "SEND_REMINDER_TO_UNVERIFIED_USERS_AFTER"
 	ENV_NOTIFY_INACTIVE_USERS_AFTER             = "NOTIFY_INACTIVE_USERS_AFTER"
 	ENV_DELETE_ACCOUNT_AFTER_NOTIFYING_USER     = "DELETE_ACCOUNT_AFTER_NOTIFYING_USER"
+	ENV_ACCOUNT_DELETION_GRACE_PERIOD           = "ACCOUNT_DELETION_GRACE_PERIOD"
 
 	ENV_WEEKDAY_ASSIGNATION_WEIGHTS = "WEEKDAY_ASSIGNATION_WEIGHTS"
 
 	ENV_USER_MANAGEMENT_LISTEN_PORT = "USER_MANAGEMENT_LISTEN_PORT"
+	ENV_SCIM_LISTEN_PORT            = "SCIM_LISTEN_PORT"
+	ENV_GATEWAY_LISTEN_PORT         = "GATEWAY_LISTEN_PORT"
+	ENV_GRPC_WEB_LISTEN_PORT        = "GRPC_WEB_LISTEN_PORT"
+	ENV_GRPC_WEB_ALLOWED_ORIGINS    = "GRPC_WEB_ALLOWED_ORIGINS"
+	ENV_GRPC_REFLECTION_ENABLED     = "GRPC_REFLECTION_ENABLED"
+	ENV_METRICS_LISTEN_PORT         = "METRICS_LISTEN_PORT"
+	ENV_TRACING_EXPORTER            = "TRACING_EXPORTER"
+	ENV_TRACING_SAMPLE_RATIO        = "TRACING_SAMPLE_RATIO"
 	ENV_ADDR_MESSAGING_SERVICE      = "ADDR_MESSAGING_SERVICE"
 	ENV_ADDR_LOGGING_SERVICE        = "ADDR_LOGGING_SERVICE"
 	ENV_ADDR_STUDY_SERVICE          = "ADDR_STUDY_SERVICE"
@@ -25,6 +37,41 @@ const (
 
 	ENV_DISABLE_TIMER_TASK = "DISABLE_TIMER_TASK"
 
+	// settings of the background jobs are prefixed with the job, e.g. JOB_CLEAN_UP_UNVERIFIED_USERS_SCHEDULE
+	ENV_PREFIX_JOB_CLEAN_UP_UNVERIFIED_USERS          = "JOB_CLEAN_UP_UNVERIFIED_USERS"
+	ENV_PREFIX_JOB_REMINDER_TO_CONFIRM_ACCOUNT        = "JOB_REMINDER_TO_CONFIRM_ACCOUNT"
+	ENV_PREFIX_JOB_NOTIFY_INACTIVE_USERS              = "JOB_NOTIFY_INACTIVE_USERS"
+	ENV_PREFIX_JOB_CLEAN_UP_USERS_MARKED_FOR_DELETION = "JOB_CLEAN_UP_USERS_MARKED_FOR_DELETION"
+	ENV_PREFIX_JOB_CLEAN_UP_EXPIRED_TEMP_TOKENS       = "JOB_CLEAN_UP_EXPIRED_TEMP_TOKENS"
+	ENV_PREFIX_JOB_COMPLETE_ACCOUNT_DELETIONS         = "JOB_COMPLETE_ACCOUNT_DELETIONS"
+	ENV_PREFIX_JOB_RESUME_ACCOUNT_ERASURES            = "JOB_RESUME_ACCOUNT_ERASURES"
+	ENV_SUFFIX_JOB_SCHEDULE                           = "_SCHEDULE"
+	ENV_SUFFIX_JOB_ENABLED                            = "_ENABLED"
+
+	ENV_SHUTDOWN_TIMEOUT = "SHUTDOWN_TIMEOUT"
+
+	ENV_AUTH_BACKENDS_CONFIG_FILE = "AUTH_BACKENDS_CONFIG_FILE"
+
+	ENV_FIELD_ENCRYPTION_KEYS_FILE = "FIELD_ENCRYPTION_KEYS_FILE"
+
+	ENV_CALLER_POLICY_FILE = "CALLER_POLICY_FILE"
+	ENV_SERVICE_TOKEN_KEY  = "SERVICE_TOKEN_KEY"
+
+	ENV_GRPC_TLS_CERT_FILE           = "GRPC_TLS_CERT_FILE"
+	ENV_GRPC_TLS_KEY_FILE            = "GRPC_TLS_KEY_FILE"
+	ENV_GRPC_TLS_CLIENT_CA_FILE      = "GRPC_TLS_CLIENT_CA_FILE"
+	ENV_GRPC_TLS_REQUIRE_CLIENT_CERT = "GRPC_TLS_REQUIRE_CLIENT_CERT"
+
+	// TLS settings of the connections to other services are prefixed with the service, e.g. MESSAGING_SERVICE_TLS_CA_FILE
+	ENV_PREFIX_MESSAGING_SERVICE = "MESSAGING_SERVICE"
+	ENV_PREFIX_LOGGING_SERVICE   = "LOGGING_SERVICE"
+	ENV_PREFIX_STUDY_SERVICE     = "STUDY_SERVICE"
+	ENV_SUFFIX_TLS_ENABLED       = "_TLS_ENABLED"
+	ENV_SUFFIX_TLS_CA_FILE       = "_TLS_CA_FILE"
+	ENV_SUFFIX_TLS_CERT_FILE     = "_TLS_CERT_FILE"
+	ENV_SUFFIX_TLS_KEY_FILE      = "_TLS_KEY_FILE"
+	ENV_SUFFIX_TLS_SERVER_NAME   = "_TLS_SERVER_NAME"
+
 	ENV_LOG_LEVEL = "LOG_LEVEL"
 )
 
@@ -35,4 +82,15 @@ const (
 	defaultContactVerificationTokenLifetime = time.Hour * 24 * 30
 	defaultNotifyInactiveUsersAfter         = 0
 	defaultDeleteAccountAfterNotifyingUser  = 0
+	defaultTracingSampleRatio               = 1.0
+	defaultShutdownTimeout                  = 30 * time.Second
+	defaultNewUserRateLimit                 = 100
+	defaultDBTimeout                        = 30
+	defaultDBIdleConnTimeout                = 45
+	defaultDBMaxPoolSize                    = 8
+	// defaultJobSchedule is the fixed frequency the jobs ran at before they had schedules
+	defaultJobSchedule              = "@every 90m"
+	defaultTempTokenCleanupSchedule = "@hourly"
+	defaultAccountDeletionSchedule  = "@hourly"
+	defaultErasureResumeSchedule    = "@every 10m"
 )
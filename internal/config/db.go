@@ -2,84 +2,55 @@ package config
 
 import (
 	"fmt"
-	"os"
-	"strconv"
+	"reflect"
 
 	"github.com/coneno/logger"
 	"github.com/influenzanet/user-management-service/pkg/models"
 )
 
+// GetUserDBConfig reads the user DB settings from the environment variables, for the tools
 func GetUserDBConfig() models.DBConfig {
-	connStr := os.Getenv("USER_DB_CONNECTION_STR")
-	username := os.Getenv("USER_DB_USERNAME")
-	password := os.Getenv("USER_DB_PASSWORD")
-	prefix := os.Getenv("USER_DB_CONNECTION_PREFIX") // Used in test mode
-	if connStr == "" || username == "" || password == "" {
-		logger.Error.Fatal("Couldn't read DB credentials.")
-	}
-	URI := fmt.Sprintf(`mongodb%s://%s:%s@%s`, prefix, username, password, connStr)
-
-	var err error
-	Timeout, err := strconv.Atoi(os.Getenv("DB_TIMEOUT"))
-	if err != nil {
-		logger.Error.Fatal("DB_TIMEOUT: " + err.Error())
-	}
-	IdleConnTimeout, err := strconv.Atoi(os.Getenv("DB_IDLE_CONN_TIMEOUT"))
-	if err != nil {
-		logger.Error.Fatal("DB_IDLE_CONN_TIMEOUT" + err.Error())
-	}
-	mps, err := strconv.Atoi(os.Getenv("DB_MAX_POOL_SIZE"))
-	MaxPoolSize := uint64(mps)
-	if err != nil {
-		logger.Error.Fatal("DB_MAX_POOL_SIZE: " + err.Error())
-	}
+	return getDBConfigFromEnv("userDB", "USER_DB", func(s *Settings) *DBSettings { return &s.UserDB })
+}
 
-	noCursorTimeout := os.Getenv(ENV_USE_NO_CURSOR_TIMEOUT) == "true"
+// GetGlobalDBConfig reads the global DB settings from the environment variables, for the tools
+func GetGlobalDBConfig() models.DBConfig {
+	return getDBConfigFromEnv("globalDB", "GLOBAL_DB", func(s *Settings) *DBSettings { return &s.GlobalDB })
+}
 
-	DBNamePrefix := os.Getenv("DB_DB_NAME_PREFIX")
+func getDBConfigFromEnv(name string, envPrefix string, db func(s *Settings) *DBSettings) models.DBConfig {
+	s := defaultSettings()
+	errs := Errors{}
+	applyEnv(reflect.ValueOf(db(&s)).Elem(), envPrefix, &errs)
+	applyEnv(reflect.ValueOf(&s.DB).Elem(), "", &errs)
+	validateDBClient(s.DB, &errs)
+	conf := dbConfig(name, envPrefix, *db(&s), s.DB, &errs)
+	if err := errs.err(); err != nil {
+		logger.Error.Fatal(err)
+	}
+	return conf
+}
 
+// dbConfig validates the settings of a DB, problems are added to errs
+func dbConfig(name string, envPrefix string, db DBSettings, client DBClient, errs *Errors) models.DBConfig {
+	if db.ConnectionStr == "" || db.Username == "" || db.Password == "" {
+		errs.add("%s (%s_CONNECTION_STR, %s_USERNAME, %s_PASSWORD): couldn't read DB credentials", name, envPrefix, envPrefix, envPrefix)
+	}
 	return models.DBConfig{
-		URI:             URI,
-		Timeout:         Timeout,
-		IdleConnTimeout: IdleConnTimeout,
-		NoCursorTimeout: noCursorTimeout,
-		MaxPoolSize:     MaxPoolSize,
-		DBNamePrefix:    DBNamePrefix,
+		URI:             fmt.Sprintf(`mongodb%s://%s:%s@%s`, db.ConnectionPrefix, db.Username, db.Password, db.ConnectionStr),
+		Timeout:         client.Timeout,
+		IdleConnTimeout: client.IdleConnTimeout,
+		NoCursorTimeout: client.NoCursorTimeout,
+		MaxPoolSize:     client.MaxPoolSize,
+		DBNamePrefix:    client.DBNamePrefix,
 	}
 }
 
-func GetGlobalDBConfig() models.DBConfig {
-	connStr := os.Getenv("GLOBAL_DB_CONNECTION_STR")
-	username := os.Getenv("GLOBAL_DB_USERNAME")
-	password := os.Getenv("GLOBAL_DB_PASSWORD")
-	prefix := os.Getenv("GLOBAL_DB_CONNECTION_PREFIX") // Used in test mode
-	if connStr == "" || username == "" || password == "" {
-		logger.Error.Fatal("Couldn't read DB credentials.")
-	}
-	URI := fmt.Sprintf(`mongodb%s://%s:%s@%s`, prefix, username, password, connStr)
-
-	var err error
-	Timeout, err := strconv.Atoi(os.Getenv("DB_TIMEOUT"))
-	if err != nil {
-		logger.Error.Fatal("DB_TIMEOUT: " + err.Error())
-	}
-	IdleConnTimeout, err := strconv.Atoi(os.Getenv("DB_IDLE_CONN_TIMEOUT"))
-	if err != nil {
-		logger.Error.Fatal("DB_IDLE_CONN_TIMEOUT" + err.Error())
+func validateDBClient(client DBClient, errs *Errors) {
+	if client.Timeout < 1 {
+		errs.add("db.timeout (DB_TIMEOUT): must be a positive number of seconds")
 	}
-	mps, err := strconv.Atoi(os.Getenv("DB_MAX_POOL_SIZE"))
-	MaxPoolSize := uint64(mps)
-	if err != nil {
-		logger.Error.Fatal("DB_MAX_POOL_SIZE: " + err.Error())
-	}
-
-	DBNamePrefix := os.Getenv("DB_DB_NAME_PREFIX")
-
-	return models.DBConfig{
-		URI:             URI,
-		Timeout:         Timeout,
-		IdleConnTimeout: IdleConnTimeout,
-		MaxPoolSize:     MaxPoolSize,
-		DBNamePrefix:    DBNamePrefix,
+	if client.IdleConnTimeout < 0 {
+		errs.add("db.idleConnTimeout (DB_IDLE_CONN_TIMEOUT): must not be negative")
 	}
 }
@@ -0,0 +1,172 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const redacted = "<redacted>"
+
+// Errors collects all problems of a configuration, so that they can be reported at once
+type Errors []string
+
+func (e Errors) Error() string {
+	return "invalid configuration:\n  " + strings.Join(e, "\n  ")
+}
+
+func (e *Errors) add(format string, args ...interface{}) {
+	*e = append(*e, fmt.Sprintf(format, args...))
+}
+
+// err returns nil if there is no problem
+func (e Errors) err() error {
+	if len(e) == 0 {
+		return nil
+	}
+	return e
+}
+
+// Load reads the config file at path, which is optional, and overrides its settings with the environment variables.
+// If the configuration is invalid, the returned error is of type Errors and lists every problem. The returned
+// Config holds the settings that could be read.
+func Load(path string) (Config, error) {
+	s := defaultSettings()
+	if path != "" {
+		if err := readSettingsFile(path, &s); err != nil {
+			return Config{settings: s}, Errors{fmt.Sprintf("%s: %v", path, err)}
+		}
+	}
+
+	errs := Errors{}
+	applyEnv(reflect.ValueOf(&s).Elem(), "", &errs)
+	conf := s.toConfig(&errs)
+	return conf, errs.err()
+}
+
+// readSettingsFile decodes the YAML or JSON file into s, keeping the values of missing settings. Unknown settings are
+// rejected, so that typos don't go unnoticed.
+func readSettingsFile(path string, s *Settings) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	dec := yaml.NewDecoder(f)
+	dec.KnownFields(true)
+	if err := dec.Decode(s); err != nil && !errors.Is(err, io.EOF) {
+		return err
+	}
+	return nil
+}
+
+var durationType = reflect.TypeOf(Duration(0))
+
+// applyEnv sets the fields of v from the environment variables named in their env tag. Empty variables are ignored.
+func applyEnv(v reflect.Value, prefix string, errs *Errors) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name := strings.ReplaceAll(field.Tag.Get("env"), "{}", prefix)
+		if field.Type.Kind() == reflect.Struct {
+			if name == "" {
+				name = prefix
+			}
+			applyEnv(v.Field(i), name, errs)
+			continue
+		}
+		if name == "" {
+			continue
+		}
+		value := os.Getenv(name)
+		if value == "" {
+			continue
+		}
+		if err := setValue(v.Field(i), value, field.Tag.Get("unit")); err != nil {
+			errs.add("%s: %v", name, err)
+		}
+	}
+}
+
+func setValue(v reflect.Value, value string, unit string) error {
+	if v.Type() == durationType {
+		d, err := parseDuration(value, unit)
+		if err != nil {
+			return err
+		}
+		v.SetInt(int64(d))
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(value)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("invalid boolean '%s', expected true or false", value)
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int64:
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid integer '%s'", value)
+		}
+		v.SetInt(n)
+	case reflect.Uint64:
+		n, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid positive integer '%s'", value)
+		}
+		v.SetUint(n)
+	case reflect.Float64:
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return fmt.Errorf("invalid number '%s'", value)
+		}
+		v.SetFloat(f)
+	case reflect.Slice:
+		v.Set(reflect.ValueOf(parseList(value)))
+	default:
+		return fmt.Errorf("unsupported setting type %s", v.Type())
+	}
+	return nil
+}
+
+// RedactedYAML returns the effective settings in the config file format, secrets are replaced
+func (c Config) RedactedYAML() ([]byte, error) {
+	s := c.settings
+	redact(reflect.ValueOf(&s).Elem())
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(s); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func redact(v reflect.Value) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		switch {
+		case field.Type.Kind() == reflect.Struct:
+			redact(v.Field(i))
+		case field.Tag.Get("secret") == "true" && v.Field(i).String() != "":
+			v.Field(i).SetString(redacted)
+		}
+	}
+}
@@ -0,0 +1,231 @@
+package config
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/influenzanet/user-management-service/pkg/jobs"
+)
+
+const exampleConfigFile = "../../build/docker/example/user-management-config.yaml"
+
+// setRequiredEnv sets the settings without default value
+func setRequiredEnv(t *testing.T) {
+	t.Setenv("USER_DB_PASSWORD", "user-db-secret")
+	t.Setenv("GLOBAL_DB_PASSWORD", "global-db-secret")
+}
+
+func writeConfigFile(t *testing.T, name string, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoad(t *testing.T) {
+	t.Run("example file", func(t *testing.T) {
+		setRequiredEnv(t)
+		conf, err := Load(exampleConfigFile)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if conf.Port != "5002" || conf.ServiceURLs.MessagingService != "localhost:5004" {
+			t.Errorf("unexpected ports: %s %s", conf.Port, conf.ServiceURLs.MessagingService)
+		}
+		if conf.CleanUpUnverifiedUsersAfter != 36*60*60 {
+			t.Errorf("unexpected clean up threshold: %d", conf.CleanUpUnverifiedUsersAfter)
+		}
+		if conf.Intervals.TokenExpiryInterval != 55*time.Minute || conf.Intervals.VerificationCodeLifetime != 15*60 {
+			t.Errorf("unexpected intervals: %+v", conf.Intervals)
+		}
+		if !strings.Contains(conf.UserDBConfig.URI, ":user-db-secret@") {
+			t.Errorf("password not taken from environment: %s", conf.UserDBConfig.URI)
+		}
+	})
+
+	t.Run("environment overrides file", func(t *testing.T) {
+		setRequiredEnv(t)
+		t.Setenv("USER_MANAGEMENT_LISTEN_PORT", "6002")
+		t.Setenv("MESSAGING_SERVICE_TLS_ENABLED", "true")
+		t.Setenv("GRPC_WEB_ALLOWED_ORIGINS", "https://a.example.org, https://b.example.org")
+		t.Setenv("CLEAN_UP_UNVERIFIED_USERS_AFTER", "129000")
+		t.Setenv("TOKEN_EXPIRATION_MIN", "5")
+		t.Setenv("JOB_CLEAN_UP_EXPIRED_TEMP_TOKENS_SCHEDULE", "*/15 * * * *")
+		t.Setenv("JOB_NOTIFY_INACTIVE_USERS_ENABLED", "false")
+		conf, err := Load(exampleConfigFile)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if conf.Port != "6002" {
+			t.Errorf("unexpected port: %s", conf.Port)
+		}
+		if !conf.ServiceTLS.MessagingService.Enabled || conf.ServiceTLS.LoggingService.Enabled {
+			t.Errorf("unexpected service TLS: %+v", conf.ServiceTLS)
+		}
+		if len(conf.GRPCWeb.AllowedOrigins) != 2 {
+			t.Errorf("unexpected origins: %v", conf.GRPCWeb.AllowedOrigins)
+		}
+		if conf.CleanUpUnverifiedUsersAfter != 129000 {
+			t.Errorf("plain number should be seconds: %d", conf.CleanUpUnverifiedUsersAfter)
+		}
+		if conf.Intervals.TokenExpiryInterval != 5*time.Minute {
+			t.Errorf("plain number should be minutes: %s", conf.Intervals.TokenExpiryInterval)
+		}
+		if job, _ := jobs.Find(conf.Jobs, jobs.CleanUpExpiredTempTokens); job.Schedule != "*/15 * * * *" || !job.Enabled {
+			t.Errorf("unexpected job: %+v", job)
+		}
+		if job, _ := jobs.Find(conf.Jobs, jobs.DetectAndNotifyInactiveUsers); job.Enabled {
+			t.Errorf("job should be disabled: %+v", job)
+		}
+	})
+
+	t.Run("JSON file", func(t *testing.T) {
+		setRequiredEnv(t)
+		path := writeConfigFile(t, "config.json", `{
+			"ports": {"grpc": "5002"},
+			"services": {"messaging": {"address": "messaging:5004"}, "logging": {"address": "logging:5006"}},
+			"userDB": {"connectionStr": "db", "username": "user"},
+			"globalDB": {"connectionStr": "db", "username": "user"},
+			"timerTasks": {"disabled": true}
+		}`)
+		conf, err := Load(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if conf.ServiceURLs.LoggingService != "logging:5006" || !conf.DisableTimerTask {
+			t.Errorf("unexpected config: %+v", conf.ServiceURLs)
+		}
+		if conf.NewUserCountLimit != defaultNewUserRateLimit || conf.UserDBConfig.Timeout != defaultDBTimeout {
+			t.Error("defaults expected for missing settings")
+		}
+	})
+
+	t.Run("unknown setting", func(t *testing.T) {
+		path := writeConfigFile(t, "config.yaml", "ports:\n  grcp: \"5002\"\n")
+		_, err := Load(path)
+		if err == nil || !strings.Contains(err.Error(), "grcp") {
+			t.Errorf("unknown setting should be reported: %v", err)
+		}
+	})
+
+	t.Run("duration without unit in file", func(t *testing.T) {
+		path := writeConfigFile(t, "config.yaml", "shutdownTimeout: 30\n")
+		_, err := Load(path)
+		if err == nil || !strings.Contains(err.Error(), "invalid duration") {
+			t.Errorf("duration without unit should be reported: %v", err)
+		}
+	})
+
+	t.Run("all problems reported", func(t *testing.T) {
+		setRequiredEnv(t)
+		t.Setenv("NOTIFY_INACTIVE_USERS_AFTER", "soon")
+		t.Setenv("DISABLE_TIMER_TASK", "yes")
+		t.Setenv("LOG_LEVEL", "verbose")
+		t.Setenv("TRACING_SAMPLE_RATIO", "2")
+		_, err := Load(exampleConfigFile)
+		errs, ok := err.(Errors)
+		if !ok {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		for _, name := range []string{"NOTIFY_INACTIVE_USERS_AFTER", "DISABLE_TIMER_TASK", "LOG_LEVEL", "TRACING_SAMPLE_RATIO"} {
+			if !strings.Contains(errs.Error(), name) {
+				t.Errorf("%s not reported: %v", name, errs)
+			}
+		}
+		if len(errs) != 4 {
+			t.Errorf("unexpected number of errors: %v", errs)
+		}
+	})
+
+	t.Run("invalid job schedule", func(t *testing.T) {
+		setRequiredEnv(t)
+		t.Setenv("JOB_CLEAN_UP_UNVERIFIED_USERS_SCHEDULE", "every day")
+		_, err := Load(exampleConfigFile)
+		if err == nil || !strings.Contains(err.Error(), "JOB_CLEAN_UP_UNVERIFIED_USERS_SCHEDULE") {
+			t.Errorf("invalid schedule should be reported: %v", err)
+		}
+	})
+
+	t.Run("invalid value is not replaced by default", func(t *testing.T) {
+		setRequiredEnv(t)
+		t.Setenv("DELETE_ACCOUNT_AFTER_NOTIFYING_USER", "-5")
+		_, err := Load(exampleConfigFile)
+		if err == nil || !strings.Contains(err.Error(), "DELETE_ACCOUNT_AFTER_NOTIFYING_USER") {
+			t.Errorf("negative value should be reported: %v", err)
+		}
+	})
+}
+
+func TestRedactedYAML(t *testing.T) {
+	setRequiredEnv(t)
+	t.Setenv("SERVICE_TOKEN_KEY", "c2VjcmV0LWtleS1vZi1hdC1sZWFzdC0zMi1ieXRlcy1sZW5ndGg=")
+	conf, err := Load(exampleConfigFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := conf.RedactedYAML()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{"user-db-secret", "global-db-secret", "c2VjcmV0"} {
+		if strings.Contains(string(out), secret) {
+			t.Errorf("secret %s printed", secret)
+		}
+	}
+	if !strings.Contains(string(out), "password: "+redacted) {
+		t.Errorf("password should be redacted:\n%s", out)
+	}
+
+	// the output is a valid config file
+	setRequiredEnv(t)
+	if _, err := Load(writeConfigFile(t, "effective.yaml", string(out))); err != nil && !strings.Contains(err.Error(), "serviceTokenKey") {
+		t.Errorf("printed config can't be loaded: %v", err)
+	}
+}
+
+// TestEnvListCovered checks that the variables of the example env list can be set in the config file
+func TestEnvListCovered(t *testing.T) {
+	names := map[string]bool{}
+	collectEnvNames(reflect.TypeOf(Settings{}), "", names)
+
+	f, err := os.Open("../../build/docker/example/user-management-env.list")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	// the file itself and the variables read by other packages
+	notInConfig := map[string]bool{ENV_CONFIG_FILE: true, "JWT_TOKEN_KEY": true, "ARGON2_MEMORY": true, "ARGON2_ITERATIONS": true, "ARGON2_PARALLELISM": true}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		name := strings.SplitN(line, "=", 2)[0]
+		if !names[name] && !notInConfig[name] {
+			t.Errorf("%s is not a setting", name)
+		}
+	}
+}
+
+func collectEnvNames(t reflect.Type, prefix string, names map[string]bool) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name := strings.ReplaceAll(field.Tag.Get("env"), "{}", prefix)
+		if field.Type.Kind() == reflect.Struct {
+			if name == "" {
+				name = prefix
+			}
+			collectEnvNames(field.Type, name, names)
+		} else if name != "" {
+			names[name] = true
+		}
+	}
+}
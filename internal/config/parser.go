@@ -2,11 +2,9 @@ package config
 
 import (
 	"fmt"
-	"os"
 	"strconv"
+	"strings"
 	"time"
-
-	"github.com/coneno/logger"
 )
 
 // parseDuration
@@ -23,17 +21,14 @@ func parseDuration(value string, defaultUnit string) (time.Duration, error) {
 	return d, nil
 }
 
-func parseEnvDuration(name string, defaultValue time.Duration, defaultUnit string) time.Duration {
-	value := os.Getenv(name)
-	if value == "" {
-		logger.Info.Printf("%s : not provided using default value %s", name, defaultValue)
-		return defaultValue
-	}
-	d, err := parseDuration(value, defaultUnit)
-	if err != nil {
-		logger.Error.Printf("%s : unexpected error - default value used, %s", name, err.Error())
-		return defaultValue
+// parseList splits a comma separated value, empty entries are skipped
+func parseList(value string) []string {
+	list := []string{}
+	for _, v := range strings.Split(value, ",") {
+		v = strings.TrimSpace(v)
+		if v != "" {
+			list = append(list, v)
+		}
 	}
-	logger.Info.Printf("%s : using value %s", name, d)
-	return d
+	return list
 }
@@ -35,18 +35,12 @@ func TestParseDuration(t *testing.T) {
 	})
 }
 
-func test_env_duration(t *testing.T, name string, value string, defaultDuration time.Duration, defaultUnit string, expect time.Duration) {
-	t.Run(fmt.Sprintf("parse duration %s", value), func(t *testing.T) {
-		t.Setenv(name, value)
-		d := parseEnvDuration(name, defaultDuration, defaultUnit)
-		if d != expect {
-			t.Errorf("Invalid time got '%s' expect '%s'", d.String(), expect.String())
-		}
-	})
-}
-
-func TestParseEnvDuration(t *testing.T) {
-	test_env_duration(t, "test_env", "123", time.Minute, "h", 123*time.Hour)
-	test_env_duration(t, "test_env", "", 18*time.Minute, "h", 18*time.Minute)
-	test_env_duration(t, "test_env", "5h", 18*time.Minute, "h", 5*time.Hour)
+func TestParseList(t *testing.T) {
+	list := parseList(" https://admin.example.org, ,https://other.example.org ")
+	if len(list) != 2 || list[0] != "https://admin.example.org" || list[1] != "https://other.example.org" {
+		t.Errorf("unexpected list: %v", list)
+	}
+	if len(parseList("")) != 0 {
+		t.Error("empty value should give an empty list")
+	}
 }
@@ -0,0 +1,170 @@
+package config
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/influenzanet/user-management-service/pkg/instancesettings"
+	"gopkg.in/yaml.v3"
+)
+
+// Settings is the schema of the config file (YAML or JSON).
+//
+// The env tag names the environment variable overriding a setting. On a nested struct it is a prefix, replacing
+// the {} in the tags of its fields. Durations are written with their unit (e.g. "90s"), the unit tag is used for
+// plain numbers in environment variables. Settings tagged secret are redacted when printed.
+type Settings struct {
+	LogLevel string `yaml:"logLevel" env:"LOG_LEVEL"`
+
+	Ports struct {
+		GRPC    string `yaml:"grpc" env:"USER_MANAGEMENT_LISTEN_PORT"`
+		GRPCWeb string `yaml:"grpcWeb" env:"GRPC_WEB_LISTEN_PORT"`
+		Gateway string `yaml:"gateway" env:"GATEWAY_LISTEN_PORT"`
+		Scim    string `yaml:"scim" env:"SCIM_LISTEN_PORT"`
+		Metrics string `yaml:"metrics" env:"METRICS_LISTEN_PORT"`
+	} `yaml:"ports"`
+	GRPCWebAllowedOrigins []string        `yaml:"grpcWebAllowedOrigins" env:"GRPC_WEB_ALLOWED_ORIGINS"`
+	GRPCReflection        bool            `yaml:"grpcReflection" env:"GRPC_REFLECTION_ENABLED"`
+	ShutdownTimeout       Duration        `yaml:"shutdownTimeout" env:"SHUTDOWN_TIMEOUT" unit:"s"`
+	ServerTLS             TLSSettings     `yaml:"serverTLS"`
+	CallerPolicyFile      string          `yaml:"callerPolicyFile" env:"CALLER_POLICY_FILE"`
+	ServiceTokenKey       string          `yaml:"serviceTokenKey" env:"SERVICE_TOKEN_KEY" secret:"true"`
+	AuthBackendsFile      string          `yaml:"authBackendsConfigFile" env:"AUTH_BACKENDS_CONFIG_FILE"`
+	FieldEncryptionFile   string          `yaml:"fieldEncryptionKeysFile" env:"FIELD_ENCRYPTION_KEYS_FILE"`
+	Tracing               TracingSettings `yaml:"tracing"`
+
+	Services struct {
+		Messaging ServiceSettings `yaml:"messaging" env:"MESSAGING_SERVICE"`
+		Logging   ServiceSettings `yaml:"logging" env:"LOGGING_SERVICE"`
+		Study     ServiceSettings `yaml:"study" env:"STUDY_SERVICE"`
+	} `yaml:"services"`
+
+	UserDB   DBSettings `yaml:"userDB" env:"USER_DB"`
+	GlobalDB DBSettings `yaml:"globalDB" env:"GLOBAL_DB"`
+	DB       DBClient   `yaml:"db"`
+
+	Intervals struct {
+		TokenExpiration                  Duration `yaml:"tokenExpiration" env:"TOKEN_EXPIRATION_MIN" unit:"m"`
+		VerificationCodeLifetime         Duration `yaml:"verificationCodeLifetime" env:"VERIFICATION_CODE_LIFETIME" unit:"s"`
+		InvitationTokenLifetime          Duration `yaml:"invitationTokenLifetime" env:"INVITATION_TOKEN_LIFETIME" unit:"m"`
+		ContactVerificationTokenLifetime Duration `yaml:"contactVerificationTokenLifetime" env:"CONTACT_VERIFICATION_TOKEN_LIFETIME" unit:"m"`
+	} `yaml:"intervals"`
+	NewUserRateLimit          int64  `yaml:"newUserRateLimit" env:"NEW_USER_RATE_LIMIT"`
+	WeekdayAssignationWeights string `yaml:"weekdayAssignationWeights" env:"WEEKDAY_ASSIGNATION_WEIGHTS"`
+
+	TimerTasks struct {
+		Disabled                          bool     `yaml:"disabled" env:"DISABLE_TIMER_TASK"`
+		CleanUpUnverifiedUsersAfter       Duration `yaml:"cleanUpUnverifiedUsersAfter" env:"CLEAN_UP_UNVERIFIED_USERS_AFTER" unit:"s"`
+		ReminderToUnverifiedAccountsAfter Duration `yaml:"reminderToUnverifiedAccountsAfter" env:"SEND_REMINDER_TO_UNVERIFIED_USERS_AFTER" unit:"s"`
+		NotifyInactiveUsersAfter          Duration `yaml:"notifyInactiveUsersAfter" env:"NOTIFY_INACTIVE_USERS_AFTER" unit:"s"`
+		DeleteAccountAfterNotifyingUser   Duration `yaml:"deleteAccountAfterNotifyingUser" env:"DELETE_ACCOUNT_AFTER_NOTIFYING_USER" unit:"s"`
+		AccountDeletionGracePeriod        Duration `yaml:"accountDeletionGracePeriod" env:"ACCOUNT_DELETION_GRACE_PERIOD" unit:"s"`
+
+		Jobs struct {
+			CleanUpUnverifiedUsers        JobSettings `yaml:"cleanUpUnverifiedUsers" env:"JOB_CLEAN_UP_UNVERIFIED_USERS"`
+			ReminderToConfirmAccount      JobSettings `yaml:"reminderToConfirmAccount" env:"JOB_REMINDER_TO_CONFIRM_ACCOUNT"`
+			NotifyInactiveUsers           JobSettings `yaml:"notifyInactiveUsers" env:"JOB_NOTIFY_INACTIVE_USERS"`
+			CleanUpUsersMarkedForDeletion JobSettings `yaml:"cleanUpUsersMarkedForDeletion" env:"JOB_CLEAN_UP_USERS_MARKED_FOR_DELETION"`
+			CleanUpExpiredTempTokens      JobSettings `yaml:"cleanUpExpiredTempTokens" env:"JOB_CLEAN_UP_EXPIRED_TEMP_TOKENS"`
+			CompleteAccountDeletions      JobSettings `yaml:"completeAccountDeletions" env:"JOB_COMPLETE_ACCOUNT_DELETIONS"`
+			ResumeAccountErasures         JobSettings `yaml:"resumeAccountErasures" env:"JOB_RESUME_ACCOUNT_ERASURES"`
+		} `yaml:"jobs"`
+	} `yaml:"timerTasks"`
+}
+
+// TLSSettings of the gRPC server
+type TLSSettings struct {
+	CertFile          string `yaml:"certFile" env:"GRPC_TLS_CERT_FILE"`
+	KeyFile           string `yaml:"keyFile" env:"GRPC_TLS_KEY_FILE"`
+	ClientCAFile      string `yaml:"clientCAFile" env:"GRPC_TLS_CLIENT_CA_FILE"`
+	RequireClientCert bool   `yaml:"requireClientCert" env:"GRPC_TLS_REQUIRE_CLIENT_CERT"`
+}
+
+// ServiceSettings of the connection to another service
+type ServiceSettings struct {
+	Address string `yaml:"address" env:"ADDR_{}"`
+	TLS     struct {
+		Enabled    bool   `yaml:"enabled" env:"{}_TLS_ENABLED"`
+		CAFile     string `yaml:"caFile" env:"{}_TLS_CA_FILE"`
+		CertFile   string `yaml:"certFile" env:"{}_TLS_CERT_FILE"`
+		KeyFile    string `yaml:"keyFile" env:"{}_TLS_KEY_FILE"`
+		ServerName string `yaml:"serverName" env:"{}_TLS_SERVER_NAME"`
+	} `yaml:"tls"`
+}
+
+// JobSettings of a background job. The schedule is a cron expression with five fields or a descriptor like
+// "@hourly" or "@every 90m".
+type JobSettings struct {
+	Schedule string `yaml:"schedule" env:"{}_SCHEDULE"`
+	Enabled  bool   `yaml:"enabled" env:"{}_ENABLED"`
+}
+
+// DBSettings are the connection infos of a DB
+type DBSettings struct {
+	ConnectionStr string `yaml:"connectionStr" env:"{}_CONNECTION_STR"`
+	// ConnectionPrefix is appended to the mongodb scheme, e.g. +srv
+	ConnectionPrefix string `yaml:"connectionPrefix" env:"{}_CONNECTION_PREFIX"`
+	Username         string `yaml:"username" env:"{}_USERNAME"`
+	Password         string `yaml:"password" env:"{}_PASSWORD" secret:"true"`
+}
+
+// DBClient settings shared by both DBs
+type DBClient struct {
+	Timeout         int    `yaml:"timeout" env:"DB_TIMEOUT"`                   // seconds
+	IdleConnTimeout int    `yaml:"idleConnTimeout" env:"DB_IDLE_CONN_TIMEOUT"` // seconds
+	MaxPoolSize     uint64 `yaml:"maxPoolSize" env:"DB_MAX_POOL_SIZE"`
+	DBNamePrefix    string `yaml:"dbNamePrefix" env:"DB_DB_NAME_PREFIX"`
+	NoCursorTimeout bool   `yaml:"noCursorTimeout" env:"USE_NO_CURSOR_TIMEOUT"`
+}
+
+// TracingSettings of the OpenTelemetry exporter
+type TracingSettings struct {
+	Exporter    string  `yaml:"exporter" env:"TRACING_EXPORTER"`
+	SampleRatio float64 `yaml:"sampleRatio" env:"TRACING_SAMPLE_RATIO"`
+}
+
+// defaultSettings are used for everything not set in the config file or the environment
+func defaultSettings() Settings {
+	s := Settings{
+		LogLevel:         "info",
+		ShutdownTimeout:  Duration(defaultShutdownTimeout),
+		NewUserRateLimit: defaultNewUserRateLimit,
+		DB: DBClient{
+			Timeout:         defaultDBTimeout,
+			IdleConnTimeout: defaultDBIdleConnTimeout,
+			MaxPoolSize:     defaultDBMaxPoolSize,
+		},
+		Tracing: TracingSettings{SampleRatio: defaultTracingSampleRatio},
+	}
+	s.Intervals.TokenExpiration = Duration(time.Minute * defaultTokenExpirationMin)
+	s.Intervals.VerificationCodeLifetime = Duration(time.Second * defaultVerificationCodeLifetime)
+	s.Intervals.InvitationTokenLifetime = Duration(defaultInvitationTokenLifetime)
+	s.Intervals.ContactVerificationTokenLifetime = Duration(defaultContactVerificationTokenLifetime)
+	s.TimerTasks.NotifyInactiveUsersAfter = Duration(time.Second * defaultNotifyInactiveUsersAfter)
+	s.TimerTasks.DeleteAccountAfterNotifyingUser = Duration(time.Second * defaultDeleteAccountAfterNotifyingUser)
+	s.TimerTasks.AccountDeletionGracePeriod = Duration(time.Second * instancesettings.DefaultAccountDeletionGracePeriod)
+	s.TimerTasks.Jobs.CleanUpUnverifiedUsers = JobSettings{Schedule: defaultJobSchedule, Enabled: true}
+	s.TimerTasks.Jobs.ReminderToConfirmAccount = JobSettings{Schedule: defaultJobSchedule, Enabled: true}
+	s.TimerTasks.Jobs.NotifyInactiveUsers = JobSettings{Schedule: defaultJobSchedule, Enabled: true}
+	s.TimerTasks.Jobs.CleanUpUsersMarkedForDeletion = JobSettings{Schedule: defaultJobSchedule, Enabled: true}
+	s.TimerTasks.Jobs.CleanUpExpiredTempTokens = JobSettings{Schedule: defaultTempTokenCleanupSchedule, Enabled: true}
+	s.TimerTasks.Jobs.CompleteAccountDeletions = JobSettings{Schedule: defaultAccountDeletionSchedule, Enabled: true}
+	s.TimerTasks.Jobs.ResumeAccountErasures = JobSettings{Schedule: defaultErasureResumeSchedule, Enabled: true}
+	return s
+}
+
+// Duration is written like "90s" or "36h" in the config file
+type Duration time.Duration
+
+func (d Duration) MarshalYAML() (interface{}, error) {
+	return time.Duration(d).String(), nil
+}
+
+func (d *Duration) UnmarshalYAML(value *yaml.Node) error {
+	v, err := time.ParseDuration(value.Value)
+	if err != nil {
+		return fmt.Errorf("line %d: invalid duration '%s', expected a number with unit, e.g. 90s or 36h", value.Line, value.Value)
+	}
+	*d = Duration(v)
+	return nil
+}
@@ -37,6 +37,8 @@ type UserManagementApiClient interface {
 	VerifyContact(ctx context.Context, in *TempToken, opts ...grpc.CallOption) (*User, error)
 	ResendContactVerification(ctx context.Context, in *ResendContactVerificationReq, opts ...grpc.CallOption) (*ServiceStatus, error)
 	ValidateAppToken(ctx context.Context, in *AppTokenRequest, opts ...grpc.CallOption) (*AppTokenValidation, error)
+	LinkExternalIdentity(ctx context.Context, in *ExternalIdentityMsg, opts ...grpc.CallOption) (*User, error)
+	UnlinkExternalIdentity(ctx context.Context, in *ExternalIdentityMsg, opts ...grpc.CallOption) (*User, error)
 	// Temporary Tokens handling:
 	GetOrCreateTemptoken(ctx context.Context, in *api_types.TempTokenInfo, opts ...grpc.CallOption) (*TempToken, error)
 	GenerateTempToken(ctx context.Context, in *api_types.TempTokenInfo, opts ...grpc.CallOption) (*TempToken, error)
@@ -49,6 +51,7 @@ type UserManagementApiClient interface {
 	ChangePassword(ctx context.Context, in *PasswordChangeMsg, opts ...grpc.CallOption) (*ServiceStatus, error)
 	ChangeAccountIDEmail(ctx context.Context, in *EmailChangeMsg, opts ...grpc.CallOption) (*User, error)
 	DeleteAccount(ctx context.Context, in *UserReference, opts ...grpc.CallOption) (*ServiceStatus, error)
+	RestoreAccount(ctx context.Context, in *TempToken, opts ...grpc.CallOption) (*ServiceStatus, error)
 	ChangePreferredLanguage(ctx context.Context, in *LanguageChangeMsg, opts ...grpc.CallOption) (*User, error)
 	// PW reset:
 	InitiatePasswordReset(ctx context.Context, in *InitiateResetPasswordMsg, opts ...grpc.CallOption) (*ServiceStatus, error)
@@ -57,6 +60,8 @@ type UserManagementApiClient interface {
 	// Profile methods:
 	SaveProfile(ctx context.Context, in *ProfileRequest, opts ...grpc.CallOption) (*User, error)
 	RemoveProfile(ctx context.Context, in *ProfileRequest, opts ...grpc.CallOption) (*User, error)
+	RecordConsent(ctx context.Context, in *ConsentMsg, opts ...grpc.CallOption) (*User, error)
+	WithdrawConsent(ctx context.Context, in *ConsentMsg, opts ...grpc.CallOption) (*User, error)
 	// Contacts:
 	UseUnsubscribeToken(ctx context.Context, in *TempToken, opts ...grpc.CallOption) (*ServiceStatus, error)
 	UpdateContactPreferences(ctx context.Context, in *ContactPreferencesMsg, opts ...grpc.CallOption) (*User, error)
@@ -68,6 +73,22 @@ type UserManagementApiClient interface {
 	RemoveRoleForUser(ctx context.Context, in *RoleMsg, opts ...grpc.CallOption) (*User, error)
 	FindNonParticipantUsers(ctx context.Context, in *FindNonParticipantUsersMsg, opts ...grpc.CallOption) (*UserListMsg, error)
 	StreamUsers(ctx context.Context, in *StreamUsersMsg, opts ...grpc.CallOption) (UserManagementApi_StreamUsersClient, error)
+	AnonymizeAccount(ctx context.Context, in *UserReference, opts ...grpc.CallOption) (*ServiceStatus, error)
+	// Outgoing email outbox:
+	GetFailedEmails(ctx context.Context, in *FailedEmailsReq, opts ...grpc.CallOption) (*OutgoingEmailList, error)
+	ResendFailedEmail(ctx context.Context, in *ResendFailedEmailReq, opts ...grpc.CallOption) (*ServiceStatus, error)
+	// Instance settings:
+	GetInstanceSettings(ctx context.Context, in *InstanceSettingsReq, opts ...grpc.CallOption) (*InstanceSettingsResp, error)
+	UpdateInstanceSettings(ctx context.Context, in *UpdateInstanceSettingsReq, opts ...grpc.CallOption) (*InstanceSettingsResp, error)
+	// Background jobs:
+	GetJobs(ctx context.Context, in *JobsReq, opts ...grpc.CallOption) (*JobList, error)
+	GetJobRuns(ctx context.Context, in *JobRunsReq, opts ...grpc.CallOption) (*JobRunList, error)
+	TriggerJob(ctx context.Context, in *TriggerJobReq, opts ...grpc.CallOption) (*JobRun, error)
+	DryRunJob(ctx context.Context, in *DryRunJobReq, opts ...grpc.CallOption) (*DryRunReport, error)
+	// Data export:
+	ExportMyData(ctx context.Context, in *DataExportReq, opts ...grpc.CallOption) (*DataExport, error)
+	ExportUserData(ctx context.Context, in *DataExportReq, opts ...grpc.CallOption) (*DataExport, error)
+	DownloadDataExport(ctx context.Context, in *TempToken, opts ...grpc.CallOption) (*DataExport, error)
 }
 
 type userManagementApiClient struct {
@@ -186,6 +207,24 @@ func (c *userManagementApiClient) ValidateAppToken(ctx context.Context, in *AppT
 	return out, nil
 }
 
+func (c *userManagementApiClient) LinkExternalIdentity(ctx context.Context, in *ExternalIdentityMsg, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/LinkExternalIdentity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) UnlinkExternalIdentity(ctx context.Context, in *ExternalIdentityMsg, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/UnlinkExternalIdentity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userManagementApiClient) GetOrCreateTemptoken(ctx context.Context, in *api_types.TempTokenInfo, opts ...grpc.CallOption) (*TempToken, error) {
 	out := new(TempToken)
 	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/GetOrCreateTemptoken", in, out, opts...)
@@ -267,6 +306,15 @@ func (c *userManagementApiClient) DeleteAccount(ctx context.Context, in *UserRef
 	return out, nil
 }
 
+func (c *userManagementApiClient) RestoreAccount(ctx context.Context, in *TempToken, opts ...grpc.CallOption) (*ServiceStatus, error) {
+	out := new(ServiceStatus)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/RestoreAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userManagementApiClient) ChangePreferredLanguage(ctx context.Context, in *LanguageChangeMsg, opts ...grpc.CallOption) (*User, error) {
 	out := new(User)
 	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/ChangePreferredLanguage", in, out, opts...)
@@ -321,6 +369,24 @@ func (c *userManagementApiClient) RemoveProfile(ctx context.Context, in *Profile
 	return out, nil
 }
 
+func (c *userManagementApiClient) RecordConsent(ctx context.Context, in *ConsentMsg, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/RecordConsent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) WithdrawConsent(ctx context.Context, in *ConsentMsg, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/WithdrawConsent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userManagementApiClient) UseUnsubscribeToken(ctx context.Context, in *TempToken, opts ...grpc.CallOption) (*ServiceStatus, error) {
 	out := new(ServiceStatus)
 	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/UseUnsubscribeToken", in, out, opts...)
@@ -425,6 +491,114 @@ func (x *userManagementApiStreamUsersClient) Recv() (*User, error) {
 	return m, nil
 }
 
+func (c *userManagementApiClient) AnonymizeAccount(ctx context.Context, in *UserReference, opts ...grpc.CallOption) (*ServiceStatus, error) {
+	out := new(ServiceStatus)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/AnonymizeAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) GetFailedEmails(ctx context.Context, in *FailedEmailsReq, opts ...grpc.CallOption) (*OutgoingEmailList, error) {
+	out := new(OutgoingEmailList)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/GetFailedEmails", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) ResendFailedEmail(ctx context.Context, in *ResendFailedEmailReq, opts ...grpc.CallOption) (*ServiceStatus, error) {
+	out := new(ServiceStatus)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/ResendFailedEmail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) GetInstanceSettings(ctx context.Context, in *InstanceSettingsReq, opts ...grpc.CallOption) (*InstanceSettingsResp, error) {
+	out := new(InstanceSettingsResp)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/GetInstanceSettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) UpdateInstanceSettings(ctx context.Context, in *UpdateInstanceSettingsReq, opts ...grpc.CallOption) (*InstanceSettingsResp, error) {
+	out := new(InstanceSettingsResp)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/UpdateInstanceSettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) GetJobs(ctx context.Context, in *JobsReq, opts ...grpc.CallOption) (*JobList, error) {
+	out := new(JobList)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/GetJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) GetJobRuns(ctx context.Context, in *JobRunsReq, opts ...grpc.CallOption) (*JobRunList, error) {
+	out := new(JobRunList)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/GetJobRuns", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) TriggerJob(ctx context.Context, in *TriggerJobReq, opts ...grpc.CallOption) (*JobRun, error) {
+	out := new(JobRun)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/TriggerJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) DryRunJob(ctx context.Context, in *DryRunJobReq, opts ...grpc.CallOption) (*DryRunReport, error) {
+	out := new(DryRunReport)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/DryRunJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) ExportMyData(ctx context.Context, in *DataExportReq, opts ...grpc.CallOption) (*DataExport, error) {
+	out := new(DataExport)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/ExportMyData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) ExportUserData(ctx context.Context, in *DataExportReq, opts ...grpc.CallOption) (*DataExport, error) {
+	out := new(DataExport)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/ExportUserData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) DownloadDataExport(ctx context.Context, in *TempToken, opts ...grpc.CallOption) (*DataExport, error) {
+	out := new(DataExport)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/DownloadDataExport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserManagementApiServer is the server API for UserManagementApi service.
 // All implementations must embed UnimplementedUserManagementApiServer
 // for forward compatibility
@@ -442,6 +616,8 @@ type UserManagementApiServer interface {
 	VerifyContact(context.Context, *TempToken) (*User, error)
 	ResendContactVerification(context.Context, *ResendContactVerificationReq) (*ServiceStatus, error)
 	ValidateAppToken(context.Context, *AppTokenRequest) (*AppTokenValidation, error)
+	LinkExternalIdentity(context.Context, *ExternalIdentityMsg) (*User, error)
+	UnlinkExternalIdentity(context.Context, *ExternalIdentityMsg) (*User, error)
 	// Temporary Tokens handling:
 	GetOrCreateTemptoken(context.Context, *api_types.TempTokenInfo) (*TempToken, error)
 	GenerateTempToken(context.Context, *api_types.TempTokenInfo) (*TempToken, error)
@@ -454,6 +630,7 @@ type UserManagementApiServer interface {
 	ChangePassword(context.Context, *PasswordChangeMsg) (*ServiceStatus, error)
 	ChangeAccountIDEmail(context.Context, *EmailChangeMsg) (*User, error)
 	DeleteAccount(context.Context, *UserReference) (*ServiceStatus, error)
+	RestoreAccount(context.Context, *TempToken) (*ServiceStatus, error)
 	ChangePreferredLanguage(context.Context, *LanguageChangeMsg) (*User, error)
 	// PW reset:
 	InitiatePasswordReset(context.Context, *InitiateResetPasswordMsg) (*ServiceStatus, error)
@@ -462,6 +639,8 @@ type UserManagementApiServer interface {
 	// Profile methods:
 	SaveProfile(context.Context, *ProfileRequest) (*User, error)
 	RemoveProfile(context.Context, *ProfileRequest) (*User, error)
+	RecordConsent(context.Context, *ConsentMsg) (*User, error)
+	WithdrawConsent(context.Context, *ConsentMsg) (*User, error)
 	// Contacts:
 	UseUnsubscribeToken(context.Context, *TempToken) (*ServiceStatus, error)
 	UpdateContactPreferences(context.Context, *ContactPreferencesMsg) (*User, error)
@@ -473,6 +652,22 @@ type UserManagementApiServer interface {
 	RemoveRoleForUser(context.Context, *RoleMsg) (*User, error)
 	FindNonParticipantUsers(context.Context, *FindNonParticipantUsersMsg) (*UserListMsg, error)
 	StreamUsers(*StreamUsersMsg, UserManagementApi_StreamUsersServer) error
+	AnonymizeAccount(context.Context, *UserReference) (*ServiceStatus, error)
+	// Outgoing email outbox:
+	GetFailedEmails(context.Context, *FailedEmailsReq) (*OutgoingEmailList, error)
+	ResendFailedEmail(context.Context, *ResendFailedEmailReq) (*ServiceStatus, error)
+	// Instance settings:
+	GetInstanceSettings(context.Context, *InstanceSettingsReq) (*InstanceSettingsResp, error)
+	UpdateInstanceSettings(context.Context, *UpdateInstanceSettingsReq) (*InstanceSettingsResp, error)
+	// Background jobs:
+	GetJobs(context.Context, *JobsReq) (*JobList, error)
+	GetJobRuns(context.Context, *JobRunsReq) (*JobRunList, error)
+	TriggerJob(context.Context, *TriggerJobReq) (*JobRun, error)
+	DryRunJob(context.Context, *DryRunJobReq) (*DryRunReport, error)
+	// Data export:
+	ExportMyData(context.Context, *DataExportReq) (*DataExport, error)
+	ExportUserData(context.Context, *DataExportReq) (*DataExport, error)
+	DownloadDataExport(context.Context, *TempToken) (*DataExport, error)
 	mustEmbedUnimplementedUserManagementApiServer()
 }
 
@@ -516,6 +711,12 @@ func (UnimplementedUserManagementApiServer) ResendContactVerification(context.Co
 func (UnimplementedUserManagementApiServer) ValidateAppToken(context.Context, *AppTokenRequest) (*AppTokenValidation, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ValidateAppToken not implemented")
 }
+func (UnimplementedUserManagementApiServer) LinkExternalIdentity(context.Context, *ExternalIdentityMsg) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkExternalIdentity not implemented")
+}
+func (UnimplementedUserManagementApiServer) UnlinkExternalIdentity(context.Context, *ExternalIdentityMsg) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlinkExternalIdentity not implemented")
+}
 func (UnimplementedUserManagementApiServer) GetOrCreateTemptoken(context.Context, *api_types.TempTokenInfo) (*TempToken, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetOrCreateTemptoken not implemented")
 }
@@ -543,6 +744,9 @@ func (UnimplementedUserManagementApiServer) ChangeAccountIDEmail(context.Context
 func (UnimplementedUserManagementApiServer) DeleteAccount(context.Context, *UserReference) (*ServiceStatus, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteAccount not implemented")
 }
+func (UnimplementedUserManagementApiServer) RestoreAccount(context.Context, *TempToken) (*ServiceStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreAccount not implemented")
+}
 func (UnimplementedUserManagementApiServer) ChangePreferredLanguage(context.Context, *LanguageChangeMsg) (*User, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ChangePreferredLanguage not implemented")
 }
@@ -561,6 +765,12 @@ func (UnimplementedUserManagementApiServer) SaveProfile(context.Context, *Profil
 func (UnimplementedUserManagementApiServer) RemoveProfile(context.Context, *ProfileRequest) (*User, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveProfile not implemented")
 }
+func (UnimplementedUserManagementApiServer) RecordConsent(context.Context, *ConsentMsg) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordConsent not implemented")
+}
+func (UnimplementedUserManagementApiServer) WithdrawConsent(context.Context, *ConsentMsg) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawConsent not implemented")
+}
 func (UnimplementedUserManagementApiServer) UseUnsubscribeToken(context.Context, *TempToken) (*ServiceStatus, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UseUnsubscribeToken not implemented")
 }
@@ -588,6 +798,42 @@ func (UnimplementedUserManagementApiServer) FindNonParticipantUsers(context.Cont
 func (UnimplementedUserManagementApiServer) StreamUsers(*StreamUsersMsg, UserManagementApi_StreamUsersServer) error {
 	return status.Errorf(codes.Unimplemented, "method StreamUsers not implemented")
 }
+func (UnimplementedUserManagementApiServer) AnonymizeAccount(context.Context, *UserReference) (*ServiceStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnonymizeAccount not implemented")
+}
+func (UnimplementedUserManagementApiServer) GetFailedEmails(context.Context, *FailedEmailsReq) (*OutgoingEmailList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFailedEmails not implemented")
+}
+func (UnimplementedUserManagementApiServer) ResendFailedEmail(context.Context, *ResendFailedEmailReq) (*ServiceStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResendFailedEmail not implemented")
+}
+func (UnimplementedUserManagementApiServer) GetInstanceSettings(context.Context, *InstanceSettingsReq) (*InstanceSettingsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInstanceSettings not implemented")
+}
+func (UnimplementedUserManagementApiServer) UpdateInstanceSettings(context.Context, *UpdateInstanceSettingsReq) (*InstanceSettingsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateInstanceSettings not implemented")
+}
+func (UnimplementedUserManagementApiServer) GetJobs(context.Context, *JobsReq) (*JobList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobs not implemented")
+}
+func (UnimplementedUserManagementApiServer) GetJobRuns(context.Context, *JobRunsReq) (*JobRunList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobRuns not implemented")
+}
+func (UnimplementedUserManagementApiServer) TriggerJob(context.Context, *TriggerJobReq) (*JobRun, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TriggerJob not implemented")
+}
+func (UnimplementedUserManagementApiServer) DryRunJob(context.Context, *DryRunJobReq) (*DryRunReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DryRunJob not implemented")
+}
+func (UnimplementedUserManagementApiServer) ExportMyData(context.Context, *DataExportReq) (*DataExport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportMyData not implemented")
+}
+func (UnimplementedUserManagementApiServer) ExportUserData(context.Context, *DataExportReq) (*DataExport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportUserData not implemented")
+}
+func (UnimplementedUserManagementApiServer) DownloadDataExport(context.Context, *TempToken) (*DataExport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DownloadDataExport not implemented")
+}
 func (UnimplementedUserManagementApiServer) mustEmbedUnimplementedUserManagementApiServer() {}
 
 // UnsafeUserManagementApiServer may be embedded to opt out of forward compatibility for this service.
@@ -817,6 +1063,42 @@ func _UserManagementApi_ValidateAppToken_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserManagementApi_LinkExternalIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExternalIdentityMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserManagementApiServer).LinkExternalIdentity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/LinkExternalIdentity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementApiServer).LinkExternalIdentity(ctx, req.(*ExternalIdentityMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserManagementApi_UnlinkExternalIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExternalIdentityMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserManagementApiServer).UnlinkExternalIdentity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/UnlinkExternalIdentity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementApiServer).UnlinkExternalIdentity(ctx, req.(*ExternalIdentityMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserManagementApi_GetOrCreateTemptoken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(api_types.TempTokenInfo)
 	if err := dec(in); err != nil {
@@ -979,6 +1261,24 @@ func _UserManagementApi_DeleteAccount_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserManagementApi_RestoreAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TempToken)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserManagementApiServer).RestoreAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/RestoreAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementApiServer).RestoreAccount(ctx, req.(*TempToken))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserManagementApi_ChangePreferredLanguage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(LanguageChangeMsg)
 	if err := dec(in); err != nil {
@@ -1087,6 +1387,42 @@ func _UserManagementApi_RemoveProfile_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserManagementApi_RecordConsent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConsentMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserManagementApiServer).RecordConsent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/RecordConsent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementApiServer).RecordConsent(ctx, req.(*ConsentMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserManagementApi_WithdrawConsent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConsentMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserManagementApiServer).WithdrawConsent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/WithdrawConsent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementApiServer).WithdrawConsent(ctx, req.(*ConsentMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserManagementApi_UseUnsubscribeToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TempToken)
 	if err := dec(in); err != nil {
@@ -1252,6 +1588,222 @@ func (x *userManagementApiStreamUsersServer) Send(m *User) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _UserManagementApi_AnonymizeAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserReference)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserManagementApiServer).AnonymizeAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/AnonymizeAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementApiServer).AnonymizeAccount(ctx, req.(*UserReference))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserManagementApi_GetFailedEmails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FailedEmailsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserManagementApiServer).GetFailedEmails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/GetFailedEmails",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementApiServer).GetFailedEmails(ctx, req.(*FailedEmailsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserManagementApi_ResendFailedEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResendFailedEmailReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserManagementApiServer).ResendFailedEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/ResendFailedEmail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementApiServer).ResendFailedEmail(ctx, req.(*ResendFailedEmailReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserManagementApi_GetInstanceSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstanceSettingsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserManagementApiServer).GetInstanceSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/GetInstanceSettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementApiServer).GetInstanceSettings(ctx, req.(*InstanceSettingsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserManagementApi_UpdateInstanceSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateInstanceSettingsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserManagementApiServer).UpdateInstanceSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/UpdateInstanceSettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementApiServer).UpdateInstanceSettings(ctx, req.(*UpdateInstanceSettingsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserManagementApi_GetJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserManagementApiServer).GetJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/GetJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementApiServer).GetJobs(ctx, req.(*JobsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserManagementApi_GetJobRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobRunsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserManagementApiServer).GetJobRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/GetJobRuns",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementApiServer).GetJobRuns(ctx, req.(*JobRunsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserManagementApi_TriggerJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TriggerJobReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserManagementApiServer).TriggerJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/TriggerJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementApiServer).TriggerJob(ctx, req.(*TriggerJobReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserManagementApi_DryRunJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DryRunJobReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserManagementApiServer).DryRunJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/DryRunJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementApiServer).DryRunJob(ctx, req.(*DryRunJobReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserManagementApi_ExportMyData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DataExportReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserManagementApiServer).ExportMyData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/ExportMyData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementApiServer).ExportMyData(ctx, req.(*DataExportReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserManagementApi_ExportUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DataExportReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserManagementApiServer).ExportUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/ExportUserData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementApiServer).ExportUserData(ctx, req.(*DataExportReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserManagementApi_DownloadDataExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TempToken)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserManagementApiServer).DownloadDataExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/DownloadDataExport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementApiServer).DownloadDataExport(ctx, req.(*TempToken))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserManagementApi_ServiceDesc is the grpc.ServiceDesc for UserManagementApi service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1307,6 +1859,14 @@ var UserManagementApi_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ValidateAppToken",
 			Handler:    _UserManagementApi_ValidateAppToken_Handler,
 		},
+		{
+			MethodName: "LinkExternalIdentity",
+			Handler:    _UserManagementApi_LinkExternalIdentity_Handler,
+		},
+		{
+			MethodName: "UnlinkExternalIdentity",
+			Handler:    _UserManagementApi_UnlinkExternalIdentity_Handler,
+		},
 		{
 			MethodName: "GetOrCreateTemptoken",
 			Handler:    _UserManagementApi_GetOrCreateTemptoken_Handler,
@@ -1343,6 +1903,10 @@ var UserManagementApi_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteAccount",
 			Handler:    _UserManagementApi_DeleteAccount_Handler,
 		},
+		{
+			MethodName: "RestoreAccount",
+			Handler:    _UserManagementApi_RestoreAccount_Handler,
+		},
 		{
 			MethodName: "ChangePreferredLanguage",
 			Handler:    _UserManagementApi_ChangePreferredLanguage_Handler,
@@ -1367,6 +1931,14 @@ var UserManagementApi_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveProfile",
 			Handler:    _UserManagementApi_RemoveProfile_Handler,
 		},
+		{
+			MethodName: "RecordConsent",
+			Handler:    _UserManagementApi_RecordConsent_Handler,
+		},
+		{
+			MethodName: "WithdrawConsent",
+			Handler:    _UserManagementApi_WithdrawConsent_Handler,
+		},
 		{
 			MethodName: "UseUnsubscribeToken",
 			Handler:    _UserManagementApi_UseUnsubscribeToken_Handler,
@@ -1399,6 +1971,54 @@ var UserManagementApi_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FindNonParticipantUsers",
 			Handler:    _UserManagementApi_FindNonParticipantUsers_Handler,
 		},
+		{
+			MethodName: "AnonymizeAccount",
+			Handler:    _UserManagementApi_AnonymizeAccount_Handler,
+		},
+		{
+			MethodName: "GetFailedEmails",
+			Handler:    _UserManagementApi_GetFailedEmails_Handler,
+		},
+		{
+			MethodName: "ResendFailedEmail",
+			Handler:    _UserManagementApi_ResendFailedEmail_Handler,
+		},
+		{
+			MethodName: "GetInstanceSettings",
+			Handler:    _UserManagementApi_GetInstanceSettings_Handler,
+		},
+		{
+			MethodName: "UpdateInstanceSettings",
+			Handler:    _UserManagementApi_UpdateInstanceSettings_Handler,
+		},
+		{
+			MethodName: "GetJobs",
+			Handler:    _UserManagementApi_GetJobs_Handler,
+		},
+		{
+			MethodName: "GetJobRuns",
+			Handler:    _UserManagementApi_GetJobRuns_Handler,
+		},
+		{
+			MethodName: "TriggerJob",
+			Handler:    _UserManagementApi_TriggerJob_Handler,
+		},
+		{
+			MethodName: "DryRunJob",
+			Handler:    _UserManagementApi_DryRunJob_Handler,
+		},
+		{
+			MethodName: "ExportMyData",
+			Handler:    _UserManagementApi_ExportMyData_Handler,
+		},
+		{
+			MethodName: "ExportUserData",
+			Handler:    _UserManagementApi_ExportUserData_Handler,
+		},
+		{
+			MethodName: "DownloadDataExport",
+			Handler:    _UserManagementApi_DownloadDataExport_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -25,13 +25,14 @@ type User struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id                 string              `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Account            *User_Account       `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
-	Roles              []string            `protobuf:"bytes,3,rep,name=roles,proto3" json:"roles,omitempty"`
-	Timestamps         *User_Timestamps    `protobuf:"bytes,4,opt,name=timestamps,proto3" json:"timestamps,omitempty"`
-	Profiles           []*Profile          `protobuf:"bytes,5,rep,name=profiles,proto3" json:"profiles,omitempty"`
-	ContactPreferences *ContactPreferences `protobuf:"bytes,6,opt,name=contact_preferences,json=contactPreferences,proto3" json:"contact_preferences,omitempty"`
-	ContactInfos       []*ContactInfo      `protobuf:"bytes,7,rep,name=contact_infos,json=contactInfos,proto3" json:"contact_infos,omitempty"`
+	Id                  string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Account             *User_Account        `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	Roles               []string             `protobuf:"bytes,3,rep,name=roles,proto3" json:"roles,omitempty"`
+	Timestamps          *User_Timestamps     `protobuf:"bytes,4,opt,name=timestamps,proto3" json:"timestamps,omitempty"`
+	Profiles            []*Profile           `protobuf:"bytes,5,rep,name=profiles,proto3" json:"profiles,omitempty"`
+	ContactPreferences  *ContactPreferences  `protobuf:"bytes,6,opt,name=contact_preferences,json=contactPreferences,proto3" json:"contact_preferences,omitempty"`
+	ContactInfos        []*ContactInfo       `protobuf:"bytes,7,rep,name=contact_infos,json=contactInfos,proto3" json:"contact_infos,omitempty"`
+	FederatedIdentities []*FederatedIdentity `protobuf:"bytes,8,rep,name=federated_identities,json=federatedIdentities,proto3" json:"federated_identities,omitempty"`
 }
 
 func (x *User) Reset() {
@@ -115,6 +116,13 @@ func (x *User) GetContactInfos() []*ContactInfo {
 	return nil
 }
 
+func (x *User) GetFederatedIdentities() []*FederatedIdentity {
+	if x != nil {
+		return x.FederatedIdentities
+	}
+	return nil
+}
+
 type ContactInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -301,9 +309,10 @@ type Profile struct {
 	// identify the profile
 	ConsentConfirmedAt int64 `protobuf:"varint,3,opt,name=consent_confirmed_at,json=consentConfirmedAt,proto3" json:"consent_confirmed_at,omitempty"` // when the user confirm that he/she has the
 	// consent to enter data for this indivildual
-	AvatarId    string `protobuf:"bytes,4,opt,name=avatar_id,json=avatarId,proto3" json:"avatar_id,omitempty"`           // id of predifined avatar
-	CreatedAt   int64  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`       // timestamp of profile creation
-	MainProfile bool   `protobuf:"varint,6,opt,name=main_profile,json=mainProfile,proto3" json:"main_profile,omitempty"` // if this is the user's main profile
+	AvatarId       string           `protobuf:"bytes,4,opt,name=avatar_id,json=avatarId,proto3" json:"avatar_id,omitempty"`                   // id of predifined avatar
+	CreatedAt      int64            `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`               // timestamp of profile creation
+	MainProfile    bool             `protobuf:"varint,6,opt,name=main_profile,json=mainProfile,proto3" json:"main_profile,omitempty"`         // if this is the user's main profile
+	ConsentRecords []*ConsentRecord `protobuf:"bytes,7,rep,name=consent_records,json=consentRecords,proto3" json:"consent_records,omitempty"` // consents given for the profile, the
 }
 
 func (x *Profile) Reset() {
@@ -380,6 +389,155 @@ func (x *Profile) GetMainProfile() bool {
 	return false
 }
 
+func (x *Profile) GetConsentRecords() []*ConsentRecord {
+	if x != nil {
+		return x.ConsentRecords
+	}
+	return nil
+}
+
+type ConsentRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConsentType string `protobuf:"bytes,1,opt,name=consent_type,json=consentType,proto3" json:"consent_type,omitempty"` // e.g. "participation"
+	Version     string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`                            // version of the consent text that was accepted
+	AcceptedAt  int64  `protobuf:"varint,3,opt,name=accepted_at,json=acceptedAt,proto3" json:"accepted_at,omitempty"`
+	WithdrawnAt int64  `protobuf:"varint,4,opt,name=withdrawn_at,json=withdrawnAt,proto3" json:"withdrawn_at,omitempty"` // 0 while the consent is given
+	Channel     string `protobuf:"bytes,5,opt,name=channel,proto3" json:"channel,omitempty"`                             // where the consent was given, e.g. "web" or "app"
+}
+
+func (x *ConsentRecord) Reset() {
+	*x = ConsentRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_management_user_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConsentRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsentRecord) ProtoMessage() {}
+
+func (x *ConsentRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_user_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsentRecord.ProtoReflect.Descriptor instead.
+func (*ConsentRecord) Descriptor() ([]byte, []int) {
+	return file_user_management_user_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ConsentRecord) GetConsentType() string {
+	if x != nil {
+		return x.ConsentType
+	}
+	return ""
+}
+
+func (x *ConsentRecord) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ConsentRecord) GetAcceptedAt() int64 {
+	if x != nil {
+		return x.AcceptedAt
+	}
+	return 0
+}
+
+func (x *ConsentRecord) GetWithdrawnAt() int64 {
+	if x != nil {
+		return x.WithdrawnAt
+	}
+	return 0
+}
+
+func (x *ConsentRecord) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+type FederatedIdentity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Issuer   string `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`   // identity provider issuer, e.g. OIDC iss claim
+	Subject  string `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"` // user identifier at the identity provider
+	LinkedAt int64  `protobuf:"varint,3,opt,name=linked_at,json=linkedAt,proto3" json:"linked_at,omitempty"`
+}
+
+func (x *FederatedIdentity) Reset() {
+	*x = FederatedIdentity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_management_user_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FederatedIdentity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FederatedIdentity) ProtoMessage() {}
+
+func (x *FederatedIdentity) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_user_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FederatedIdentity.ProtoReflect.Descriptor instead.
+func (*FederatedIdentity) Descriptor() ([]byte, []int) {
+	return file_user_management_user_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *FederatedIdentity) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *FederatedIdentity) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *FederatedIdentity) GetLinkedAt() int64 {
+	if x != nil {
+		return x.LinkedAt
+	}
+	return 0
+}
+
 type User_Account struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -394,7 +552,7 @@ type User_Account struct {
 func (x *User_Account) Reset() {
 	*x = User_Account{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_user_management_user_proto_msgTypes[4]
+		mi := &file_user_management_user_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -407,7 +565,7 @@ func (x *User_Account) String() string {
 func (*User_Account) ProtoMessage() {}
 
 func (x *User_Account) ProtoReflect() protoreflect.Message {
-	mi := &file_user_management_user_proto_msgTypes[4]
+	mi := &file_user_management_user_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -466,7 +624,7 @@ type User_Timestamps struct {
 func (x *User_Timestamps) Reset() {
 	*x = User_Timestamps{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_user_management_user_proto_msgTypes[5]
+		mi := &file_user_management_user_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -479,7 +637,7 @@ func (x *User_Timestamps) String() string {
 func (*User_Timestamps) ProtoMessage() {}
 
 func (x *User_Timestamps) ProtoReflect() protoreflect.Message {
-	mi := &file_user_management_user_proto_msgTypes[5]
+	mi := &file_user_management_user_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -535,7 +693,7 @@ var File_user_management_user_proto protoreflect.FileDescriptor
 var file_user_management_user_proto_rawDesc = []byte{
 	0x0a, 0x1a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e,
 	0x74, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x69, 0x6e,
-	0x66, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x22, 0xbf, 0x05, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12,
+	0x66, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x22, 0x8f, 0x06, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12,
 	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
 	0x30, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x16, 0x2e, 0x69, 0x6e, 0x66, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72,
@@ -556,7 +714,12 @@ var file_user_management_user_proto_rawDesc = []byte{
 	0x12, 0x3a, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x5f, 0x69, 0x6e, 0x66, 0x6f,
 	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69, 0x6e, 0x66, 0x2e, 0x75, 0x73,
 	0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c,
-	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x1a, 0x9d, 0x01, 0x0a,
+	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x12, 0x4e, 0x0a, 0x14,
+	0x66, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x6e, 0x66,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x13, 0x66, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x1a, 0x9d, 0x01, 0x0a,
 	0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
 	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
@@ -604,7 +767,7 @@ var file_user_management_user_proto_rawDesc = []byte{
 	0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x61, 0x79, 0x5f, 0x6f, 0x66, 0x5f, 0x77, 0x65, 0x65,
 	0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x1d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
 	0x57, 0x65, 0x65, 0x6b, 0x6c, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x44, 0x61, 0x79,
-	0x4f, 0x66, 0x57, 0x65, 0x65, 0x6b, 0x22, 0xc0, 0x01, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x4f, 0x66, 0x57, 0x65, 0x65, 0x6b, 0x22, 0x82, 0x02, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x66, 0x69,
 	0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
 	0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x73,
@@ -616,11 +779,32 @@ var file_user_management_user_proto_rawDesc = []byte{
 	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65,
 	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x70,
 	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6d, 0x61,
-	0x69, 0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a,
-	0x61, 0x6e, 0x65, 0x74, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2d, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x6b, 0x67,
-	0x2f, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x69, 0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x40, 0x0a, 0x0f, 0x63, 0x6f, 0x6e,
+	0x73, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x6e, 0x66, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x43, 0x6f,
+	0x6e, 0x73, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x0e, 0x63, 0x6f, 0x6e,
+	0x73, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0xaa, 0x01, 0x0a, 0x0d,
+	0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x21, 0x0a,
+	0x0c, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x77,
+	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x6e, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x6e, 0x41, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x62, 0x0a, 0x11, 0x46, 0x65, 0x64, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x16, 0x0a,
+	0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69,
+	0x73, 0x73, 0x75, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x42, 0x39, 0x5a, 0x37,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x66, 0x6c, 0x75,
+	0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2d, 0x6d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f,
+	0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -635,26 +819,30 @@ func file_user_management_user_proto_rawDescGZIP() []byte {
 	return file_user_management_user_proto_rawDescData
 }
 
-var file_user_management_user_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_user_management_user_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_user_management_user_proto_goTypes = []interface{}{
 	(*User)(nil),               // 0: inf.user.User
 	(*ContactInfo)(nil),        // 1: inf.user.ContactInfo
 	(*ContactPreferences)(nil), // 2: inf.user.ContactPreferences
 	(*Profile)(nil),            // 3: inf.user.Profile
-	(*User_Account)(nil),       // 4: inf.user.User.Account
-	(*User_Timestamps)(nil),    // 5: inf.user.User.Timestamps
+	(*ConsentRecord)(nil),      // 4: inf.user.ConsentRecord
+	(*FederatedIdentity)(nil),  // 5: inf.user.FederatedIdentity
+	(*User_Account)(nil),       // 6: inf.user.User.Account
+	(*User_Timestamps)(nil),    // 7: inf.user.User.Timestamps
 }
 var file_user_management_user_proto_depIdxs = []int32{
-	4, // 0: inf.user.User.account:type_name -> inf.user.User.Account
-	5, // 1: inf.user.User.timestamps:type_name -> inf.user.User.Timestamps
+	6, // 0: inf.user.User.account:type_name -> inf.user.User.Account
+	7, // 1: inf.user.User.timestamps:type_name -> inf.user.User.Timestamps
 	3, // 2: inf.user.User.profiles:type_name -> inf.user.Profile
 	2, // 3: inf.user.User.contact_preferences:type_name -> inf.user.ContactPreferences
 	1, // 4: inf.user.User.contact_infos:type_name -> inf.user.ContactInfo
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	5, // 5: inf.user.User.federated_identities:type_name -> inf.user.FederatedIdentity
+	4, // 6: inf.user.Profile.consent_records:type_name -> inf.user.ConsentRecord
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_user_management_user_proto_init() }
@@ -712,7 +900,7 @@ func file_user_management_user_proto_init() {
 			}
 		}
 		file_user_management_user_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*User_Account); i {
+			switch v := v.(*ConsentRecord); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -724,6 +912,30 @@ func file_user_management_user_proto_init() {
 			}
 		}
 		file_user_management_user_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FederatedIdentity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*User_Account); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*User_Timestamps); i {
 			case 0:
 				return &v.state
@@ -746,7 +958,7 @@ func file_user_management_user_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_user_management_user_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
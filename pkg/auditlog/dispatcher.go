@@ -0,0 +1,100 @@
+package auditlog
+
+import (
+	"context"
+	"time"
+
+	"github.com/coneno/logger"
+	loggingAPI "github.com/influenzanet/logging-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/dbs/globaldb"
+	"github.com/influenzanet/user-management-service/pkg/metrics"
+	"github.com/influenzanet/user-management-service/pkg/models"
+	"github.com/influenzanet/user-management-service/pkg/outbox"
+)
+
+var outboxConfig = outbox.Config{
+	Name:             "audit outbox",
+	DispatchInterval: 5 * time.Second,
+	DeliveryTimeout:  10 * time.Second,
+	ClaimLease:       time.Minute,
+	MinRetryDelay:    10 * time.Second,
+	MaxRetryDelay:    time.Hour,
+	// events are kept until the logging service is back, MaxAttempts is not set
+}
+
+// DeliveredEntriesTTL is how long delivered entries are kept in the outbox
+const DeliveredEntriesTTL = 7 * 24 * time.Hour
+
+// Dispatcher delivers the outbox entries to the logging service
+type Dispatcher struct {
+	client          loggingAPI.LoggingServiceApiClient
+	globalDBService *globaldb.GlobalDBService
+}
+
+// NewDispatcher creates a dispatcher sending with client, which must not be an OutboxClient
+func NewDispatcher(client loggingAPI.LoggingServiceApiClient, globalDBService *globaldb.GlobalDBService) *Dispatcher {
+	return &Dispatcher{
+		client:          client,
+		globalDBService: globalDBService,
+	}
+}
+
+// Run delivers pending entries until ctx is done
+func (d *Dispatcher) Run(ctx context.Context) {
+	if err := d.globalDBService.CreateIndexForAuditOutbox(DeliveredEntriesTTL); err != nil {
+		logger.Error.Printf("audit outbox: failed to create indexes: %v", err)
+	}
+	outbox.Run(ctx, outboxConfig, func() []outbox.Queue[models.AuditOutboxEntry] {
+		return []outbox.Queue[models.AuditOutboxEntry]{queue{d}}
+	})
+}
+
+// queue is the audit outbox of the global DB
+type queue struct {
+	*Dispatcher
+}
+
+func (q queue) Claim(now int64, lease time.Duration) (models.AuditOutboxEntry, error) {
+	return q.globalDBService.ClaimAuditOutboxEntry(now, lease)
+}
+
+func (q queue) Deliver(ctx context.Context, entry models.AuditOutboxEntry) error {
+	_, err := q.client.SaveLogEvent(ctx, &loggingAPI.NewLogEvent{
+		Origin:     entry.Origin,
+		InstanceId: entry.InstanceID,
+		UserId:     entry.UserID,
+		EventType:  loggingAPI.LogEventType(entry.EventType),
+		EventName:  entry.EventName,
+		Msg:        entry.Msg,
+	})
+	return err
+}
+
+func (q queue) MarkDelivered(entry models.AuditOutboxEntry) error {
+	return q.globalDBService.MarkAuditOutboxEntryDelivered(entry)
+}
+
+func (q queue) MarkFailed(entry models.AuditOutboxEntry, nextAttemptAt int64, reason string, deadLetter bool) error {
+	return q.globalDBService.MarkAuditOutboxEntryFailed(entry, nextAttemptAt, reason)
+}
+
+func (q queue) Describe(entry models.AuditOutboxEntry) string {
+	return "event " + entry.ID.Hex()
+}
+
+func (q queue) Attempts(entry models.AuditOutboxEntry) int {
+	return entry.Attempts
+}
+
+func (q queue) DeliveryFailed() {
+	metrics.AuditEventDeliveryFailed()
+}
+
+func (q queue) UpdateBacklog() {
+	count, err := q.globalDBService.CountPendingAuditOutboxEntries()
+	if err != nil {
+		logger.Error.Printf("audit outbox: %v", err)
+		return
+	}
+	metrics.SetAuditOutboxBacklog(count)
+}
@@ -0,0 +1,53 @@
+// Package auditlog delivers log events to the logging service through an outbox collection of
+// the global DB, so that events are not lost while the logging service is unavailable.
+package auditlog
+
+import (
+	"context"
+	"time"
+
+	"github.com/coneno/logger"
+	"github.com/influenzanet/go-utils/pkg/api_types"
+	loggingAPI "github.com/influenzanet/logging-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/dbs/globaldb"
+	"github.com/influenzanet/user-management-service/pkg/models"
+	"google.golang.org/grpc"
+)
+
+// OutboxClient is a logging service client storing log events in the outbox instead of sending them.
+// Other calls go to the logging service directly.
+type OutboxClient struct {
+	loggingAPI.LoggingServiceApiClient
+	globalDBService *globaldb.GlobalDBService
+}
+
+// NewOutboxClient wraps the client of the logging service
+func NewOutboxClient(client loggingAPI.LoggingServiceApiClient, globalDBService *globaldb.GlobalDBService) *OutboxClient {
+	return &OutboxClient{
+		LoggingServiceApiClient: client,
+		globalDBService:         globalDBService,
+	}
+}
+
+// SaveLogEvent queues the event for delivery. If the outbox can't be written, the event is sent directly.
+func (c *OutboxClient) SaveLogEvent(ctx context.Context, in *loggingAPI.NewLogEvent, opts ...grpc.CallOption) (*api_types.ServiceStatus, error) {
+	now := time.Now().Unix()
+	entry := models.AuditOutboxEntry{
+		Origin:        in.Origin,
+		InstanceID:    in.InstanceId,
+		UserID:        in.UserId,
+		EventType:     int32(in.EventType),
+		EventName:     in.EventName,
+		Msg:           in.Msg,
+		CreatedAt:     now,
+		NextAttemptAt: now,
+	}
+	if err := c.globalDBService.AddAuditOutboxEntry(entry); err != nil {
+		logger.Error.Printf("audit outbox: failed to queue event %s, sending it directly: %v", in.EventName, err)
+		return c.LoggingServiceApiClient.SaveLogEvent(ctx, in, opts...)
+	}
+	return &api_types.ServiceStatus{
+		Status: api_types.ServiceStatus_NORMAL,
+		Msg:    "event queued",
+	}, nil
+}
@@ -0,0 +1,143 @@
+package authbackend
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/influenzanet/go-utils/pkg/constants"
+	"github.com/influenzanet/user-management-service/pkg/models"
+)
+
+var (
+	// ErrInvalidCredentials is returned if the account is unknown to the backend or the password does not match
+	ErrInvalidCredentials = errors.New("invalid credentials")
+	// ErrNoRoleMapped is returned if the credentials are valid, but none of the account's groups maps to a role
+	ErrNoRoleMapped = errors.New("no role mapped for account")
+)
+
+// Result holds the infos about an account returned by a successful authentication
+type Result struct {
+	// Roles resolved from the backend's group membership. Nil if the backend does not manage roles.
+	Roles []string
+}
+
+// Backend verifies the credentials of an account against an external source
+type Backend interface {
+	Name() string
+	Authenticate(accountID string, password string) (Result, error)
+}
+
+// Config holds the authentication backend settings for each instance
+type Config struct {
+	Instances map[string]InstanceConfig `json:"instances"`
+}
+
+// InstanceConfig holds the authentication backend settings of one instance
+type InstanceConfig struct {
+	// AccountTypes using the backend (e.g. "ldap", "email"). Participant-only accounts always use their local password.
+	AccountTypes []string `json:"accountTypes"`
+	// CreateUsers creates accounts of type "ldap" at the first successful login of unknown users
+	CreateUsers bool        `json:"createUsers"`
+	LDAP        *LDAPConfig `json:"ldap"`
+}
+
+// LoadConfig reads the JSON file at path. An empty path returns an empty config.
+func LoadConfig(path string) (Config, error) {
+	conf := Config{Instances: map[string]InstanceConfig{}}
+	if path == "" {
+		return conf, nil
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return conf, err
+	}
+	if err := json.Unmarshal(content, &conf); err != nil {
+		return conf, fmt.Errorf("%s: %v", path, err)
+	}
+	for instanceID, ic := range conf.Instances {
+		if ic.LDAP == nil {
+			return conf, fmt.Errorf("%s: instance %s has no backend configured", path, instanceID)
+		}
+		if err := ic.LDAP.validate(); err != nil {
+			return conf, fmt.Errorf("%s: instance %s: %v", path, instanceID, err)
+		}
+	}
+	return conf, nil
+}
+
+type instanceBackend struct {
+	accountTypes map[string]bool
+	createUsers  bool
+	backend      Backend
+}
+
+// Registry resolves the backend to use for an account. A nil Registry only uses local passwords.
+type Registry struct {
+	instances map[string]instanceBackend
+}
+
+// NewRegistry creates the backends from the config
+func NewRegistry(conf Config) *Registry {
+	r := &Registry{instances: map[string]instanceBackend{}}
+	for instanceID, ic := range conf.Instances {
+		if ic.LDAP == nil {
+			continue
+		}
+		r.Register(instanceID, NewLDAPBackend(*ic.LDAP), ic.AccountTypes, ic.CreateUsers)
+	}
+	return r
+}
+
+// Register sets the backend used by the given account types of an instance
+func (r *Registry) Register(instanceID string, backend Backend, accountTypes []string, createUsers bool) {
+	ib := instanceBackend{
+		accountTypes: map[string]bool{},
+		createUsers:  createUsers,
+		backend:      backend,
+	}
+	for _, t := range accountTypes {
+		ib.accountTypes[t] = true
+	}
+	if len(accountTypes) == 0 {
+		ib.accountTypes[models.ACCOUNT_TYPE_LDAP] = true
+	}
+	r.instances[instanceID] = ib
+}
+
+// BackendForUser returns the backend to verify the password of the user with, or nil if the local password is used
+func (r *Registry) BackendForUser(instanceID string, user models.User) Backend {
+	if r == nil {
+		return nil
+	}
+	ib, ok := r.instances[instanceID]
+	if !ok || !ib.accountTypes[user.Account.Type] {
+		return nil
+	}
+	if user.Account.Type != models.ACCOUNT_TYPE_LDAP && !hasNonParticipantRole(user) {
+		return nil
+	}
+	return ib.backend
+}
+
+// BackendForNewUser returns the backend able to create accounts for unknown users of the instance, or nil
+func (r *Registry) BackendForNewUser(instanceID string) Backend {
+	if r == nil {
+		return nil
+	}
+	ib, ok := r.instances[instanceID]
+	if !ok || !ib.createUsers || !ib.accountTypes[models.ACCOUNT_TYPE_LDAP] {
+		return nil
+	}
+	return ib.backend
+}
+
+func hasNonParticipantRole(user models.User) bool {
+	for _, r := range user.Roles {
+		if r != constants.USER_ROLE_PARTICIPANT {
+			return true
+		}
+	}
+	return false
+}
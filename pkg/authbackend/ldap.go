@@ -0,0 +1,192 @@
+package authbackend
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/coneno/logger"
+	"github.com/go-ldap/ldap/v3"
+)
+
+const (
+	defaultLDAPTimeout        = 10 // seconds
+	defaultLDAPGroupAttribute = "memberOf"
+)
+
+// LDAPConfig holds the connection and mapping settings of an LDAP directory
+type LDAPConfig struct {
+	URL                string `json:"url"` // e.g. ldaps://ldap.example.org:636
+	StartTLS           bool   `json:"startTLS"`
+	InsecureSkipVerify bool   `json:"insecureSkipVerify"`
+	Timeout            int    `json:"timeout"` // seconds
+
+	// Service account used to search the user entry, anonymous search if empty
+	BindDN       string `json:"bindDN"`
+	BindPassword string `json:"bindPassword"`
+
+	BaseDN string `json:"baseDN"`
+	// UserFilter to find the entry of an account, %s is replaced by the escaped account ID, e.g. (&(objectClass=person)(mail=%s))
+	UserFilter string `json:"userFilter"`
+	// GroupAttribute of the user entry listing the groups, defaults to memberOf
+	GroupAttribute string `json:"groupAttribute"`
+	// GroupRoleMapping maps group DNs to roles. If empty, roles are not managed by the directory.
+	GroupRoleMapping map[string]string `json:"groupRoleMapping"`
+}
+
+func (c LDAPConfig) validate() error {
+	if c.URL == "" {
+		return errors.New("ldap url missing")
+	}
+	if c.BaseDN == "" {
+		return errors.New("ldap baseDN missing")
+	}
+	if strings.Count(c.UserFilter, "%s") != 1 {
+		return errors.New("ldap userFilter must contain %s exactly once")
+	}
+	return nil
+}
+
+// LDAPBackend authenticates accounts by searching the user entry and binding with its DN
+type LDAPBackend struct {
+	conf         LDAPConfig
+	groupMapping map[string]string
+}
+
+// NewLDAPBackend creates a backend for the directory
+func NewLDAPBackend(conf LDAPConfig) *LDAPBackend {
+	if conf.Timeout <= 0 {
+		conf.Timeout = defaultLDAPTimeout
+	}
+	if conf.GroupAttribute == "" {
+		conf.GroupAttribute = defaultLDAPGroupAttribute
+	}
+	groupMapping := map[string]string{}
+	for dn, role := range conf.GroupRoleMapping {
+		groupMapping[normalizeDN(dn)] = role
+	}
+	return &LDAPBackend{
+		conf:         conf,
+		groupMapping: groupMapping,
+	}
+}
+
+func (b *LDAPBackend) Name() string {
+	return "ldap"
+}
+
+// Authenticate does a search-then-bind: the entry is looked up with the service account, then the
+// password is verified by binding as the entry's DN.
+func (b *LDAPBackend) Authenticate(accountID string, password string) (Result, error) {
+	if accountID == "" || password == "" {
+		// an empty password would be an unauthenticated bind, which most servers accept
+		return Result{}, ErrInvalidCredentials
+	}
+
+	conn, err := b.connect()
+	if err != nil {
+		return Result{}, err
+	}
+	defer conn.Close()
+
+	if b.conf.BindDN != "" {
+		if err := conn.Bind(b.conf.BindDN, b.conf.BindPassword); err != nil {
+			return Result{}, fmt.Errorf("ldap service bind: %v", err)
+		}
+	}
+
+	searchReq := ldap.NewSearchRequest(
+		b.conf.BaseDN,
+		ldap.ScopeWholeSubtree,
+		ldap.NeverDerefAliases,
+		2,
+		b.conf.Timeout,
+		false,
+		fmt.Sprintf(b.conf.UserFilter, ldap.EscapeFilter(accountID)),
+		[]string{"dn", b.conf.GroupAttribute},
+		nil,
+	)
+	sr, err := conn.Search(searchReq)
+	if err != nil {
+		if ldap.IsErrorWithCode(err, ldap.LDAPResultSizeLimitExceeded) {
+			logger.Warning.Printf("ldap: account id %s matches multiple entries", accountID)
+			return Result{}, ErrInvalidCredentials
+		}
+		return Result{}, fmt.Errorf("ldap search: %v", err)
+	}
+	if len(sr.Entries) != 1 {
+		if len(sr.Entries) > 1 {
+			logger.Warning.Printf("ldap: account id %s matches multiple entries", accountID)
+		}
+		return Result{}, ErrInvalidCredentials
+	}
+	entry := sr.Entries[0]
+
+	if err := conn.Bind(entry.DN, password); err != nil {
+		if ldap.IsErrorWithCode(err, ldap.LDAPResultInvalidCredentials) {
+			return Result{}, ErrInvalidCredentials
+		}
+		return Result{}, fmt.Errorf("ldap user bind: %v", err)
+	}
+
+	if len(b.groupMapping) == 0 {
+		return Result{}, nil
+	}
+	roles := b.mapGroups(entry.GetAttributeValues(b.conf.GroupAttribute))
+	if len(roles) == 0 {
+		return Result{}, ErrNoRoleMapped
+	}
+	return Result{Roles: roles}, nil
+}
+
+func (b *LDAPBackend) connect() (*ldap.Conn, error) {
+	timeout := time.Duration(b.conf.Timeout) * time.Second
+	tlsConfig := &tls.Config{InsecureSkipVerify: b.conf.InsecureSkipVerify}
+	conn, err := ldap.DialURL(
+		b.conf.URL,
+		ldap.DialWithDialer(&net.Dialer{Timeout: timeout}),
+		ldap.DialWithTLSConfig(tlsConfig),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("ldap connect: %v", err)
+	}
+	conn.SetTimeout(timeout)
+
+	if b.conf.StartTLS {
+		if u, err := url.Parse(b.conf.URL); err == nil {
+			tlsConfig.ServerName = u.Hostname()
+		}
+		if err := conn.StartTLS(tlsConfig); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("ldap start tls: %v", err)
+		}
+	}
+	return conn, nil
+}
+
+func (b *LDAPBackend) mapGroups(groups []string) []string {
+	roles := []string{}
+	seen := map[string]bool{}
+	for _, g := range groups {
+		role, ok := b.groupMapping[normalizeDN(g)]
+		if !ok || seen[role] {
+			continue
+		}
+		seen[role] = true
+		roles = append(roles, role)
+	}
+	return roles
+}
+
+// normalizeDN makes DNs comparable regardless of case and spacing after separators
+func normalizeDN(dn string) string {
+	parts := strings.Split(dn, ",")
+	for i, p := range parts {
+		parts[i] = strings.ToLower(strings.TrimSpace(p))
+	}
+	return strings.Join(parts, ",")
+}
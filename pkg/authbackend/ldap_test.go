@@ -0,0 +1,226 @@
+package authbackend
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/influenzanet/user-management-service/pkg/models"
+	"github.com/influenzanet/user-management-service/test/ldapserver"
+)
+
+const testUserFilter = "(&(objectClass=person)(mail=%s))"
+
+func startTestDirectory(t *testing.T) *ldapserver.Server {
+	entries := []ldapserver.Entry{
+		{DN: "cn=service,dc=test", Password: "service-secret"},
+		{
+			DN:       "uid=admin,ou=people,dc=test",
+			Password: "admin-secret",
+			Attributes: map[string][]string{
+				"mail":     {"admin@test.com"},
+				"memberOf": {"CN=Admins, OU=Groups,DC=test", "cn=other,ou=groups,dc=test"},
+			},
+		},
+		{
+			DN:       "uid=researcher,ou=people,dc=test",
+			Password: "researcher-secret",
+			Attributes: map[string][]string{
+				"mail":     {"researcher@test.com"},
+				"memberOf": {"cn=researchers,ou=groups,dc=test"},
+			},
+		},
+		{
+			DN:       "uid=nogroup,ou=people,dc=test",
+			Password: "nogroup-secret",
+			Attributes: map[string][]string{
+				"mail": {"nogroup@test.com"},
+			},
+		},
+	}
+	srv, err := ldapserver.Start(entries, func(filter string, e ldapserver.Entry) bool {
+		for _, mail := range e.Attributes["mail"] {
+			if strings.EqualFold(filter, strings.Replace(testUserFilter, "%s", mail, 1)) {
+				return true
+			}
+		}
+		return false
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return srv
+}
+
+func testLDAPConfig(url string) LDAPConfig {
+	return LDAPConfig{
+		URL:          url,
+		Timeout:      2,
+		BindDN:       "cn=service,dc=test",
+		BindPassword: "service-secret",
+		BaseDN:       "ou=people,dc=test",
+		UserFilter:   testUserFilter,
+		GroupRoleMapping: map[string]string{
+			"cn=admins,ou=groups,dc=test":      "ADMIN",
+			"cn=researchers,ou=groups,dc=test": "RESEARCHER",
+		},
+	}
+}
+
+func TestLDAPBackend(t *testing.T) {
+	srv := startTestDirectory(t)
+	defer srv.Close()
+
+	backend := NewLDAPBackend(testLDAPConfig(srv.URL()))
+
+	t.Run("with correct password", func(t *testing.T) {
+		res, err := backend.Authenticate("admin@test.com", "admin-secret")
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if len(res.Roles) != 1 || res.Roles[0] != "ADMIN" {
+			t.Errorf("unexpected roles: %v", res.Roles)
+		}
+		binds := srv.Binds()
+		if len(binds) < 2 || binds[len(binds)-2] != "cn=service,dc=test" || binds[len(binds)-1] != "uid=admin,ou=people,dc=test" {
+			t.Errorf("expected service bind followed by user bind: %v", binds)
+		}
+	})
+
+	t.Run("with wrong password", func(t *testing.T) {
+		_, err := backend.Authenticate("researcher@test.com", "admin-secret")
+		if err != ErrInvalidCredentials {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("with empty password", func(t *testing.T) {
+		_, err := backend.Authenticate("researcher@test.com", "")
+		if err != ErrInvalidCredentials {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("with unknown account", func(t *testing.T) {
+		_, err := backend.Authenticate("unknown@test.com", "admin-secret")
+		if err != ErrInvalidCredentials {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("with filter injection", func(t *testing.T) {
+		_, err := backend.Authenticate("*", "admin-secret")
+		if err != ErrInvalidCredentials {
+			t.Errorf("unexpected error: %v", err)
+		}
+		searches := srv.Searches()
+		if !strings.Contains(searches[len(searches)-1], `\2a`) {
+			t.Errorf("account id not escaped: %s", searches[len(searches)-1])
+		}
+	})
+
+	t.Run("without mapped group", func(t *testing.T) {
+		_, err := backend.Authenticate("nogroup@test.com", "nogroup-secret")
+		if err != ErrNoRoleMapped {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("without group mapping", func(t *testing.T) {
+		conf := testLDAPConfig(srv.URL())
+		conf.GroupRoleMapping = nil
+		res, err := NewLDAPBackend(conf).Authenticate("nogroup@test.com", "nogroup-secret")
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if res.Roles != nil {
+			t.Errorf("roles should not be managed: %v", res.Roles)
+		}
+	})
+
+	t.Run("with wrong service account password", func(t *testing.T) {
+		conf := testLDAPConfig(srv.URL())
+		conf.BindPassword = "wrong"
+		_, err := NewLDAPBackend(conf).Authenticate("admin@test.com", "admin-secret")
+		if err == nil || err == ErrInvalidCredentials {
+			t.Errorf("expected configuration error, got: %v", err)
+		}
+	})
+}
+
+func TestRegistry(t *testing.T) {
+	backend := NewLDAPBackend(testLDAPConfig("ldap://localhost:389"))
+	r := NewRegistry(Config{})
+	r.Register("inst1", backend, []string{models.ACCOUNT_TYPE_LDAP, models.ACCOUNT_TYPE_EMAIL}, true)
+	r.Register("inst2", backend, nil, false)
+
+	researcher := models.User{Account: models.Account{Type: models.ACCOUNT_TYPE_EMAIL}, Roles: []string{"PARTICIPANT", "RESEARCHER"}}
+	participant := models.User{Account: models.Account{Type: models.ACCOUNT_TYPE_EMAIL}, Roles: []string{"PARTICIPANT"}}
+	ldapUser := models.User{Account: models.Account{Type: models.ACCOUNT_TYPE_LDAP}}
+
+	if r.BackendForUser("inst1", researcher) == nil {
+		t.Error("researcher with email account should use the backend")
+	}
+	if r.BackendForUser("inst1", participant) != nil {
+		t.Error("participants should use local passwords")
+	}
+	if r.BackendForUser("inst2", researcher) != nil {
+		t.Error("email accounts not enabled for inst2")
+	}
+	if r.BackendForUser("inst2", ldapUser) == nil {
+		t.Error("ldap accounts should be enabled by default")
+	}
+	if r.BackendForUser("other", ldapUser) != nil {
+		t.Error("instance without config should use local passwords")
+	}
+	if r.BackendForNewUser("inst1") == nil || r.BackendForNewUser("inst2") != nil {
+		t.Error("unexpected user creation settings")
+	}
+
+	var nilRegistry *Registry
+	if nilRegistry.BackendForUser("inst1", ldapUser) != nil || nilRegistry.BackendForNewUser("inst1") != nil {
+		t.Error("nil registry should not return backends")
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	t.Run("without path", func(t *testing.T) {
+		conf, err := LoadConfig("")
+		if err != nil || len(conf.Instances) != 0 {
+			t.Errorf("unexpected result: %v %v", conf, err)
+		}
+	})
+
+	t.Run("with valid file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "auth.json")
+		content := `{"instances": {"default": {"accountTypes": ["ldap"], "createUsers": true, "ldap": {
+			"url": "ldaps://ldap.test:636", "baseDN": "dc=test", "userFilter": "(mail=%s)",
+			"groupRoleMapping": {"cn=admins,dc=test": "ADMIN"}}}}}`
+		if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+		conf, err := LoadConfig(path)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		ic, ok := conf.Instances["default"]
+		if !ok || !ic.CreateUsers || ic.LDAP == nil || ic.LDAP.GroupRoleMapping["cn=admins,dc=test"] != "ADMIN" {
+			t.Errorf("unexpected config: %+v", conf)
+		}
+	})
+
+	t.Run("with invalid user filter", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "auth.json")
+		content := `{"instances": {"default": {"ldap": {"url": "ldap://ldap.test", "baseDN": "dc=test", "userFilter": "(mail=x)"}}}}`
+		if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := LoadConfig(path); err == nil {
+			t.Error("should fail")
+		}
+	})
+}
@@ -0,0 +1,131 @@
+// Package callerauth authenticates the processes calling the gRPC API and checks them against a
+// per-method policy. Callers are identified by a signed service token, by the common name of their
+// mTLS client certificate, or as one of the servers running in-process, such as the gRPC-Web endpoint.
+package callerauth
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/coneno/logger"
+	"github.com/influenzanet/user-management-service/pkg/api"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// Callers running in-process
+const (
+	CallerGRPCWeb    = "grpc-web"
+	CallerAPIGateway = "api-gateway"
+)
+
+var errNoCallerIdentity = errors.New("caller not authenticated")
+
+type internalCallerKey struct{}
+
+// WithInternalCaller marks the requests of a server running in-process, e.g. by setting the
+// context of its HTTP requests
+func WithInternalCaller(ctx context.Context, caller string) context.Context {
+	return context.WithValue(ctx, internalCallerKey{}, caller)
+}
+
+// Authenticator resolves callers and applies the policy. A nil Authenticator allows every call.
+type Authenticator struct {
+	policy   Policy
+	tokenKey []byte
+}
+
+// NewAuthenticator creates an authenticator. Without tokenKey, callers can only be identified by certificates.
+func NewAuthenticator(policy Policy, tokenKey []byte) *Authenticator {
+	return &Authenticator{
+		policy:   policy,
+		tokenKey: tokenKey,
+	}
+}
+
+// UnaryServerInterceptor rejects calls not allowed by the policy
+func (a *Authenticator) UnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := a.authorize(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// StreamServerInterceptor rejects calls not allowed by the policy
+func (a *Authenticator) StreamServerInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := a.authorize(ss.Context(), info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+func (a *Authenticator) authorize(ctx context.Context, fullMethod string) error {
+	method, ok := userManagementMethod(fullMethod)
+	if !ok {
+		// health checks and reflection stay open
+		return nil
+	}
+
+	caller, err := a.resolveCaller(ctx)
+	if err != nil {
+		logger.Warning.Printf("SECURITY WARNING: unauthenticated call of %s from %s: %v", method, peerAddr(ctx), err)
+		return status.Error(codes.Unauthenticated, errNoCallerIdentity.Error())
+	}
+	if !a.policy.IsAllowed(method, caller) {
+		logger.Warning.Printf("SECURITY WARNING: caller %s is not allowed to call %s", caller, method)
+		return status.Error(codes.PermissionDenied, "caller not allowed to call this method")
+	}
+	return nil
+}
+
+// resolveCaller prefers the in-process marker, then a service token, then the client certificate
+func (a *Authenticator) resolveCaller(ctx context.Context) (string, error) {
+	if caller, ok := ctx.Value(internalCallerKey{}).(string); ok && caller != "" {
+		return caller, nil
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if tokens := md.Get(TokenMetadataKey); len(tokens) > 0 {
+			if len(tokens) > 1 {
+				return "", errors.New("multiple service tokens")
+			}
+			if len(a.tokenKey) == 0 {
+				return "", errors.New("service tokens are not accepted")
+			}
+			return parseServiceToken(a.tokenKey, tokens[0])
+		}
+	}
+
+	if p, ok := peer.FromContext(ctx); ok {
+		if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+			// only chains verified against the client CA count
+			if len(tlsInfo.State.VerifiedChains) > 0 && len(tlsInfo.State.VerifiedChains[0]) > 0 {
+				if cn := tlsInfo.State.VerifiedChains[0][0].Subject.CommonName; cn != "" {
+					return cn, nil
+				}
+			}
+		}
+	}
+	return "", errNoCallerIdentity
+}
+
+// userManagementMethod returns the method name if fullMethod belongs to the UserManagementApi
+func userManagementMethod(fullMethod string) (string, bool) {
+	prefix := "/" + api.UserManagementApi_ServiceDesc.ServiceName + "/"
+	if !strings.HasPrefix(fullMethod, prefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(fullMethod, prefix), true
+}
+
+func peerAddr(ctx context.Context) string {
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		return p.Addr.String()
+	}
+	return "unknown"
+}
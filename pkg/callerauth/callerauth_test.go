@@ -0,0 +1,276 @@
+package callerauth
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	jwt "github.com/golang-jwt/jwt/v4"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+var testKey = []byte("0123456789abcdef0123456789abcdef")
+
+const methodPrefix = "/influenzanet.user_management_api.UserManagementApi/"
+
+func testPolicy() Policy {
+	return Policy{Rules: map[string][]string{
+		AnyMethod:     {CallerAPIGateway, CallerGRPCWeb},
+		"CreateUser":  {"admin-tools"},
+		"StreamUsers": {"messaging-service", "study-service"},
+		"Status":      {AnyCaller},
+	}}
+}
+
+func TestPolicy(t *testing.T) {
+	p := testPolicy()
+	cases := []struct {
+		method  string
+		caller  string
+		allowed bool
+	}{
+		{method: "LoginWithEmail", caller: CallerAPIGateway, allowed: true},
+		{method: "LoginWithEmail", caller: "study-service", allowed: false},
+		{method: "CreateUser", caller: "admin-tools", allowed: true},
+		{method: "CreateUser", caller: CallerAPIGateway, allowed: false},
+		{method: "Status", caller: "anyone", allowed: true},
+	}
+	for _, c := range cases {
+		if p.IsAllowed(c.method, c.caller) != c.allowed {
+			t.Errorf("%s by %s: expected allowed=%v", c.method, c.caller, c.allowed)
+		}
+	}
+	if (Policy{}).IsAllowed("Status", CallerAPIGateway) {
+		t.Error("empty policy should deny")
+	}
+}
+
+func TestLoadPolicy(t *testing.T) {
+	t.Run("with valid file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "policy.json")
+		if err := os.WriteFile(path, []byte(`{"rules": {"*": ["api-gateway"], "CreateUser": ["admin-tools"]}}`), 0600); err != nil {
+			t.Fatal(err)
+		}
+		p, err := LoadPolicy(path)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if !p.IsAllowed("CreateUser", "admin-tools") {
+			t.Errorf("unexpected policy: %+v", p)
+		}
+	})
+
+	t.Run("with unknown method", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "policy.json")
+		if err := os.WriteFile(path, []byte(`{"rules": {"CreateUsers": ["admin-tools"]}}`), 0600); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := LoadPolicy(path); err == nil {
+			t.Error("should fail")
+		}
+	})
+}
+
+// adminMethods can only be called by the admin tools, never through the public endpoints
+var adminMethods = []string{
+	"CreateUser", "AddRoleForUser", "RemoveRoleForUser", "FindNonParticipantUsers", "GetFailedEmails", "ResendFailedEmail",
+	"GetInstanceSettings", "UpdateInstanceSettings",
+	"GetJobs", "GetJobRuns", "TriggerJob",
+	"DryRunJob",
+	"ExportUserData",
+	"AnonymizeAccount",
+}
+
+// publicMethods are called by participants through the public endpoints
+var publicMethods = []string{
+	"LoginWithEmail", "GetUser",
+	"RestoreAccount",
+	"ExportMyData", "DownloadDataExport",
+	"RecordConsent", "WithdrawConsent",
+}
+
+func TestExamplePolicy(t *testing.T) {
+	p, err := LoadPolicy(filepath.Join("..", "..", "build", "docker", "example", "caller-policy.json"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := p.Rules[AnyMethod]; ok {
+		t.Error("example policy should deny unlisted methods")
+	}
+	for _, method := range adminMethods {
+		if !p.IsAllowed(method, "admin-tools") {
+			t.Errorf("%s should be allowed for admin-tools", method)
+		}
+		if len(p.Rules[method]) != 1 {
+			t.Errorf("%s should only be allowed for admin-tools: %v", method, p.Rules[method])
+		}
+	}
+	for _, method := range publicMethods {
+		if !p.IsAllowed(method, CallerAPIGateway) || !p.IsAllowed(method, CallerGRPCWeb) {
+			t.Errorf("%s should be allowed for the public endpoints", method)
+		}
+	}
+}
+
+func TestServiceToken(t *testing.T) {
+	token, err := NewServiceToken(testKey, "study-service", time.Hour)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	t.Run("with correct key", func(t *testing.T) {
+		caller, err := parseServiceToken(testKey, token)
+		if err != nil || caller != "study-service" {
+			t.Errorf("unexpected result: %s %v", caller, err)
+		}
+	})
+
+	t.Run("with wrong key", func(t *testing.T) {
+		if _, err := parseServiceToken([]byte("fedcba9876543210fedcba9876543210"), token); err == nil {
+			t.Error("should fail")
+		}
+	})
+
+	t.Run("without expiry", func(t *testing.T) {
+		token, err := NewServiceToken(testKey, "admin-tools", 0)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if caller, err := parseServiceToken(testKey, token); err != nil || caller != "admin-tools" {
+			t.Errorf("unexpected result: %s %v", caller, err)
+		}
+	})
+
+	t.Run("with expired token", func(t *testing.T) {
+		expired, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+			Subject:   "study-service",
+			Audience:  jwt.ClaimStrings{tokenAudience},
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(-time.Minute)),
+		}).SignedString(testKey)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, err := parseServiceToken(testKey, expired); err == nil {
+			t.Error("should fail")
+		}
+	})
+
+	t.Run("for other audience", func(t *testing.T) {
+		other, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+			Subject:  "study-service",
+			Audience: jwt.ClaimStrings{"study-service"},
+		}).SignedString(testKey)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, err := parseServiceToken(testKey, other); err == nil {
+			t.Error("should fail")
+		}
+	})
+
+	t.Run("with short key", func(t *testing.T) {
+		if _, err := NewServiceToken([]byte("short"), "study-service", 0); err == nil {
+			t.Error("should fail")
+		}
+	})
+}
+
+func TestInterceptor(t *testing.T) {
+	a := NewAuthenticator(testPolicy(), testKey)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+	call := func(ctx context.Context, method string) codes.Code {
+		_, err := a.UnaryServerInterceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		return status.Code(err)
+	}
+	tokenCtx := func(caller string) context.Context {
+		token, err := NewServiceToken(testKey, caller, time.Hour)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs(TokenMetadataKey, token))
+	}
+	certCtx := func(cn string) context.Context {
+		cert := &x509.Certificate{Subject: pkix.Name{CommonName: cn}}
+		return peer.NewContext(context.Background(), &peer.Peer{
+			AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}}},
+		})
+	}
+
+	t.Run("without identity", func(t *testing.T) {
+		if c := call(context.Background(), methodPrefix+"LoginWithEmail"); c != codes.Unauthenticated {
+			t.Errorf("unexpected code: %s", c)
+		}
+	})
+
+	t.Run("with invalid token", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(TokenMetadataKey, "invalid"))
+		if c := call(ctx, methodPrefix+"LoginWithEmail"); c != codes.Unauthenticated {
+			t.Errorf("unexpected code: %s", c)
+		}
+	})
+
+	t.Run("with multiple tokens", func(t *testing.T) {
+		token, _ := NewServiceToken(testKey, "admin-tools", 0)
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(TokenMetadataKey, token, TokenMetadataKey, token))
+		if c := call(ctx, methodPrefix+"CreateUser"); c != codes.Unauthenticated {
+			t.Errorf("unexpected code: %s", c)
+		}
+	})
+
+	t.Run("with allowed token caller", func(t *testing.T) {
+		if c := call(tokenCtx("admin-tools"), methodPrefix+"CreateUser"); c != codes.OK {
+			t.Errorf("unexpected code: %s", c)
+		}
+	})
+
+	t.Run("with forbidden token caller", func(t *testing.T) {
+		if c := call(tokenCtx(CallerAPIGateway), methodPrefix+"CreateUser"); c != codes.PermissionDenied {
+			t.Errorf("unexpected code: %s", c)
+		}
+	})
+
+	t.Run("with client certificate", func(t *testing.T) {
+		if c := call(certCtx("study-service"), methodPrefix+"StreamUsers"); c != codes.OK {
+			t.Errorf("unexpected code: %s", c)
+		}
+		if c := call(certCtx("study-service"), methodPrefix+"CreateUser"); c != codes.PermissionDenied {
+			t.Errorf("unexpected code: %s", c)
+		}
+	})
+
+	t.Run("with unverified client certificate", func(t *testing.T) {
+		cert := &x509.Certificate{Subject: pkix.Name{CommonName: "admin-tools"}}
+		ctx := peer.NewContext(context.Background(), &peer.Peer{
+			AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}}},
+		})
+		if c := call(ctx, methodPrefix+"CreateUser"); c != codes.Unauthenticated {
+			t.Errorf("unexpected code: %s", c)
+		}
+	})
+
+	t.Run("with internal caller", func(t *testing.T) {
+		ctx := WithInternalCaller(context.Background(), CallerGRPCWeb)
+		if c := call(ctx, methodPrefix+"LoginWithEmail"); c != codes.OK {
+			t.Errorf("unexpected code: %s", c)
+		}
+	})
+
+	t.Run("other services", func(t *testing.T) {
+		if c := call(context.Background(), "/grpc.health.v1.Health/Check"); c != codes.OK {
+			t.Errorf("unexpected code: %s", c)
+		}
+	})
+}
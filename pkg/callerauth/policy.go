@@ -0,0 +1,68 @@
+package callerauth
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/influenzanet/user-management-service/pkg/api"
+)
+
+const (
+	// AnyMethod is the rule key for methods without their own rule
+	AnyMethod = "*"
+	// AnyCaller in a rule allows every authenticated caller
+	AnyCaller = "*"
+)
+
+// Policy lists the callers allowed to invoke each method of the UserManagementApi
+type Policy struct {
+	// Rules maps method names (e.g. "CreateUser") to caller names. A method's own rule replaces the "*" rule.
+	Rules map[string][]string `json:"rules"`
+}
+
+// LoadPolicy reads the JSON file at path and checks that its rules refer to existing methods
+func LoadPolicy(path string) (Policy, error) {
+	policy := Policy{}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return policy, err
+	}
+	if err := json.Unmarshal(content, &policy); err != nil {
+		return policy, fmt.Errorf("%s: %v", path, err)
+	}
+	if err := policy.validate(); err != nil {
+		return policy, fmt.Errorf("%s: %v", path, err)
+	}
+	return policy, nil
+}
+
+func (p Policy) validate() error {
+	methods := map[string]bool{AnyMethod: true}
+	for _, m := range api.UserManagementApi_ServiceDesc.Methods {
+		methods[m.MethodName] = true
+	}
+	for _, s := range api.UserManagementApi_ServiceDesc.Streams {
+		methods[s.StreamName] = true
+	}
+	for method := range p.Rules {
+		if !methods[method] {
+			return fmt.Errorf("unknown method %s", method)
+		}
+	}
+	return nil
+}
+
+// IsAllowed checks whether caller may invoke the method
+func (p Policy) IsAllowed(method string, caller string) bool {
+	callers, ok := p.Rules[method]
+	if !ok {
+		callers = p.Rules[AnyMethod]
+	}
+	for _, c := range callers {
+		if c == AnyCaller || c == caller {
+			return true
+		}
+	}
+	return false
+}
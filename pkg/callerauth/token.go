@@ -0,0 +1,77 @@
+package callerauth
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	jwt "github.com/golang-jwt/jwt/v4"
+)
+
+const (
+	// TokenMetadataKey is the request metadata holding the service token
+	TokenMetadataKey = "x-service-token"
+
+	tokenAudience = "user-management-service"
+	minKeyLength  = 32
+)
+
+// NewServiceToken signs a token identifying the caller. With a validity of zero, the token does not expire.
+func NewServiceToken(key []byte, caller string, validity time.Duration) (string, error) {
+	if len(key) < minKeyLength {
+		return "", errors.New("service token key too short")
+	}
+	if caller == "" {
+		return "", errors.New("caller name missing")
+	}
+	claims := jwt.RegisteredClaims{
+		Subject:  caller,
+		Audience: jwt.ClaimStrings{tokenAudience},
+		IssuedAt: jwt.NewNumericDate(time.Now()),
+	}
+	if validity > 0 {
+		claims.ExpiresAt = jwt.NewNumericDate(time.Now().Add(validity))
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(key)
+}
+
+// parseServiceToken verifies the token and returns the caller name
+func parseServiceToken(key []byte, token string) (string, error) {
+	claims := jwt.RegisteredClaims{}
+	_, err := jwt.ParseWithClaims(token, &claims, func(t *jwt.Token) (interface{}, error) {
+		if t.Method != jwt.SigningMethodHS256 {
+			return nil, errors.New("unexpected signing method")
+		}
+		return key, nil
+	})
+	if err != nil {
+		return "", err
+	}
+	if !claims.VerifyAudience(tokenAudience, true) {
+		return "", errors.New("token not issued for this service")
+	}
+	if claims.Subject == "" {
+		return "", errors.New("token without caller name")
+	}
+	return claims.Subject, nil
+}
+
+// TokenCredentials adds a service token to every call of a client connection
+type TokenCredentials struct {
+	token string
+	// requireTLS should be set unless the connection stays on the same host
+	requireTLS bool
+}
+
+// NewTokenCredentials creates the per-RPC credentials for grpc.WithPerRPCCredentials
+func NewTokenCredentials(token string, requireTLS bool) TokenCredentials {
+	return TokenCredentials{token: token, requireTLS: requireTLS}
+}
+
+func (c TokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{TokenMetadataKey: c.token}, nil
+}
+
+func (c TokenCredentials) RequireTransportSecurity() bool {
+	return c.requireTLS
+}
@@ -0,0 +1,273 @@
+// Package dataexport puts together the data the service holds about a user, as a JSON archive participants can
+// request for themselves.
+package dataexport
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	loggingAPI "github.com/influenzanet/logging-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/dbs/globaldb"
+	"github.com/influenzanet/user-management-service/pkg/dbs/userdb"
+	"github.com/influenzanet/user-management-service/pkg/models"
+)
+
+const (
+	ContentType = "application/json"
+
+	// securityEventsLimit is the number of latest security events in the archive
+	securityEventsLimit = 100
+)
+
+// Archive is the exported data of a user. Passwords, verification codes and token values are left out.
+type Archive struct {
+	ExportedAt          int64               `json:"exportedAt"`
+	InstanceID          string              `json:"instanceId"`
+	UserID              string              `json:"userId"`
+	Account             Account             `json:"account"`
+	Roles               []string            `json:"roles"`
+	Timestamps          Timestamps          `json:"timestamps"`
+	Profiles            []Profile           `json:"profiles"`
+	ContactInfos        []ContactInfo       `json:"contactInfos"`
+	ContactPreferences  ContactPreferences  `json:"contactPreferences"`
+	FederatedIdentities []FederatedIdentity `json:"federatedIdentities"`
+	// Sessions are the logins that can still be renewed
+	Sessions          []Session   `json:"sessions"`
+	PendingTempTokens []TempToken `json:"pendingTempTokens"`
+	// SecurityEvents are the latest ones, they are kept by the service only for a few days
+	SecurityEvents []SecurityEvent `json:"securityEvents"`
+}
+
+type Account struct {
+	Type                  string  `json:"type"`
+	AccountID             string  `json:"accountId"`
+	AccountConfirmedAt    int64   `json:"accountConfirmedAt"`
+	AuthType              string  `json:"authType,omitempty"`
+	PreferredLanguage     string  `json:"preferredLanguage"`
+	DeactivatedAt         int64   `json:"deactivatedAt,omitempty"`
+	ProvisionedBy         string  `json:"provisionedBy,omitempty"`
+	DeletionScheduledAt   int64   `json:"deletionScheduledAt,omitempty"`
+	FailedLoginAttempts   []int64 `json:"failedLoginAttempts"`
+	PasswordResetTriggers []int64 `json:"passwordResetTriggers"`
+}
+
+type Timestamps struct {
+	CreatedAt               int64 `json:"createdAt"`
+	UpdatedAt               int64 `json:"updatedAt"`
+	LastLogin               int64 `json:"lastLogin"`
+	LastTokenRefresh        int64 `json:"lastTokenRefresh"`
+	LastPasswordChange      int64 `json:"lastPasswordChange"`
+	ReminderToConfirmSentAt int64 `json:"reminderToConfirmSentAt"`
+	MarkedForDeletion       int64 `json:"markedForDeletion"`
+}
+
+type Profile struct {
+	ID                 string `json:"id"`
+	Alias              string `json:"alias"`
+	AvatarID           string `json:"avatarId"`
+	MainProfile        bool   `json:"mainProfile"`
+	ConsentConfirmedAt int64  `json:"consentConfirmedAt"`
+	CreatedAt          int64  `json:"createdAt"`
+	// ConsentRecords include the withdrawn consents and the ones accepted again in a newer version
+	ConsentRecords []ConsentRecord `json:"consentRecords"`
+}
+
+type ConsentRecord struct {
+	ConsentType string `json:"consentType"`
+	Version     string `json:"version"`
+	AcceptedAt  int64  `json:"acceptedAt"`
+	WithdrawnAt int64  `json:"withdrawnAt,omitempty"`
+	Channel     string `json:"channel,omitempty"`
+}
+
+type ContactInfo struct {
+	ID                     string `json:"id"`
+	Type                   string `json:"type"`
+	Email                  string `json:"email,omitempty"`
+	Phone                  string `json:"phone,omitempty"`
+	ConfirmedAt            int64  `json:"confirmedAt"`
+	ConfirmationLinkSentAt int64  `json:"confirmationLinkSentAt"`
+}
+
+type ContactPreferences struct {
+	SubscribedToNewsletter        bool     `json:"subscribedToNewsletter"`
+	SendNewsletterTo              []string `json:"sendNewsletterTo"`
+	SubscribedToWeekly            bool     `json:"subscribedToWeekly"`
+	ReceiveWeeklyMessageDayOfWeek int32    `json:"receiveWeeklyMessageDayOfWeek"`
+}
+
+type FederatedIdentity struct {
+	Issuer   string `json:"issuer"`
+	Subject  string `json:"subject"`
+	LinkedAt int64  `json:"linkedAt"`
+}
+
+type Session struct {
+	ExpiresAt int64 `json:"expiresAt"`
+}
+
+type TempToken struct {
+	Purpose    string            `json:"purpose"`
+	Expiration int64             `json:"expiration"`
+	Info       map[string]string `json:"info,omitempty"`
+}
+
+type SecurityEvent struct {
+	EventName string `json:"eventName"`
+	Msg       string `json:"msg,omitempty"`
+	CreatedAt int64  `json:"createdAt"`
+}
+
+type Exporter struct {
+	userDBService   *userdb.UserDBService
+	globalDBService *globaldb.GlobalDBService
+}
+
+func New(userDBService *userdb.UserDBService, globalDBService *globaldb.GlobalDBService) *Exporter {
+	return &Exporter{
+		userDBService:   userDBService,
+		globalDBService: globalDBService,
+	}
+}
+
+// Export reads the data of the user
+func (e *Exporter) Export(instanceID string, userID string) (Archive, error) {
+	user, err := e.userDBService.GetUserByID(instanceID, userID)
+	if err != nil {
+		return Archive{}, err
+	}
+	renewTokens, err := e.userDBService.FindActiveRenewTokensForUser(instanceID, userID)
+	if err != nil {
+		return Archive{}, err
+	}
+	tempTokens, err := e.globalDBService.GetTempTokenForUser(instanceID, userID, "")
+	if err != nil {
+		return Archive{}, err
+	}
+	events, err := e.globalDBService.FindAuditEventsForUser(instanceID, userID, int32(loggingAPI.LogEventType_SECURITY), securityEventsLimit)
+	if err != nil {
+		return Archive{}, err
+	}
+	return newArchive(instanceID, user, renewTokens, tempTokens, events, time.Now().Unix()), nil
+}
+
+func newArchive(instanceID string, u models.User, renewTokens []userdb.RenewToken, tempTokens models.TempTokens, events []models.AuditOutboxEntry, now int64) Archive {
+	a := Archive{
+		ExportedAt: now,
+		InstanceID: instanceID,
+		UserID:     u.ID.Hex(),
+		Account: Account{
+			Type:                  u.Account.Type,
+			AccountID:             u.Account.AccountID,
+			AccountConfirmedAt:    u.Account.AccountConfirmedAt,
+			AuthType:              u.Account.AuthType,
+			PreferredLanguage:     u.Account.PreferredLanguage,
+			DeactivatedAt:         u.Account.DeactivatedAt,
+			ProvisionedBy:         u.Account.ProvisionedBy,
+			DeletionScheduledAt:   u.Account.DeletionScheduledAt,
+			FailedLoginAttempts:   nonNil(u.Account.FailedLoginAttempts),
+			PasswordResetTriggers: nonNil(u.Account.PasswordResetTriggers),
+		},
+		Roles: u.Roles,
+		Timestamps: Timestamps{
+			CreatedAt:               u.Timestamps.CreatedAt,
+			UpdatedAt:               u.Timestamps.UpdatedAt,
+			LastLogin:               u.Timestamps.LastLogin,
+			LastTokenRefresh:        u.Timestamps.LastTokenRefresh,
+			LastPasswordChange:      u.Timestamps.LastPasswordChange,
+			ReminderToConfirmSentAt: u.Timestamps.ReminderToConfirmSentAt,
+			MarkedForDeletion:       u.Timestamps.MarkedForDeletion,
+		},
+		Profiles:     make([]Profile, len(u.Profiles)),
+		ContactInfos: make([]ContactInfo, len(u.ContactInfos)),
+		ContactPreferences: ContactPreferences{
+			SubscribedToNewsletter:        u.ContactPreferences.SubscribedToNewsletter,
+			SendNewsletterTo:              u.ContactPreferences.SendNewsletterTo,
+			SubscribedToWeekly:            u.ContactPreferences.SubscribedToWeekly,
+			ReceiveWeeklyMessageDayOfWeek: u.ContactPreferences.ReceiveWeeklyMessageDayOfWeek,
+		},
+		FederatedIdentities: make([]FederatedIdentity, len(u.FederatedIdentities)),
+		Sessions:            make([]Session, len(renewTokens)),
+		PendingTempTokens:   []TempToken{},
+		SecurityEvents:      make([]SecurityEvent, len(events)),
+	}
+	if a.Roles == nil {
+		a.Roles = []string{}
+	}
+	for i, p := range u.Profiles {
+		a.Profiles[i] = Profile{
+			ID:                 p.ID.Hex(),
+			Alias:              p.Alias,
+			AvatarID:           p.AvatarID,
+			MainProfile:        p.MainProfile,
+			ConsentConfirmedAt: p.ConsentConfirmedAt,
+			CreatedAt:          p.CreatedAt,
+			ConsentRecords:     make([]ConsentRecord, len(p.ConsentRecords)),
+		}
+		for j, c := range p.ConsentRecords {
+			a.Profiles[i].ConsentRecords[j] = ConsentRecord{
+				ConsentType: c.ConsentType,
+				Version:     c.Version,
+				AcceptedAt:  c.AcceptedAt,
+				WithdrawnAt: c.WithdrawnAt,
+				Channel:     c.Channel,
+			}
+		}
+	}
+	for i, c := range u.ContactInfos {
+		a.ContactInfos[i] = ContactInfo{
+			ID:                     c.ID.Hex(),
+			Type:                   c.Type,
+			Email:                  c.Email,
+			Phone:                  c.Phone,
+			ConfirmedAt:            c.ConfirmedAt,
+			ConfirmationLinkSentAt: c.ConfirmationLinkSentAt,
+		}
+	}
+	for i, fi := range u.FederatedIdentities {
+		a.FederatedIdentities[i] = FederatedIdentity{
+			Issuer:   fi.Issuer,
+			Subject:  fi.Subject,
+			LinkedAt: fi.LinkedAt,
+		}
+	}
+	for i, rt := range renewTokens {
+		a.Sessions[i] = Session{ExpiresAt: rt.ExpiresAt}
+	}
+	for _, t := range tempTokens {
+		if t.Expiration < now {
+			continue
+		}
+		a.PendingTempTokens = append(a.PendingTempTokens, TempToken{
+			Purpose:    t.Purpose,
+			Expiration: t.Expiration,
+			Info:       t.Info,
+		})
+	}
+	for i, e := range events {
+		a.SecurityEvents[i] = SecurityEvent{
+			EventName: e.EventName,
+			Msg:       e.Msg,
+			CreatedAt: e.CreatedAt,
+		}
+	}
+	return a
+}
+
+func nonNil(values []int64) []int64 {
+	if values == nil {
+		return []int64{}
+	}
+	return values
+}
+
+// Marshal encodes the archive as indented JSON
+func (a Archive) Marshal() ([]byte, error) {
+	return json.MarshalIndent(a, "", "  ")
+}
+
+// FileName is the suggested name of the archive file
+func (a Archive) FileName() string {
+	return fmt.Sprintf("data-export-%s-%s.json", a.UserID, time.Unix(a.ExportedAt, 0).UTC().Format("20060102"))
+}
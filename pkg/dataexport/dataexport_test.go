@@ -0,0 +1,56 @@
+package dataexport
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/influenzanet/user-management-service/pkg/dbs/userdb"
+	"github.com/influenzanet/user-management-service/pkg/models"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestNewArchive(t *testing.T) {
+	now := int64(1700000000)
+	user := models.User{
+		ID: primitive.NewObjectID(),
+		Account: models.Account{
+			Type:             "email",
+			AccountID:        "export@test.com",
+			Password:         "secret-password-hash",
+			VerificationCode: models.VerificationCode{Code: "secret-code"},
+		},
+		Profiles: []models.Profile{{
+			ID: primitive.NewObjectID(), Alias: "main", MainProfile: true,
+			ConsentRecords: []models.ConsentRecord{{ConsentType: "participation", Version: "1", AcceptedAt: now - 100}},
+		}},
+	}
+	renewTokens := []userdb.RenewToken{{UserID: user.ID.Hex(), RenewToken: "secret-renew-token", ExpiresAt: now + 100}}
+	tempTokens := models.TempTokens{
+		{Token: "secret-temp-token", Purpose: "data-export", Expiration: now + 100},
+		{Token: "expired", Purpose: "invitation", Expiration: now - 100},
+	}
+	events := []models.AuditOutboxEntry{{EventName: "AUTH WRONG PASSWORD", CreatedAt: now - 10}}
+
+	archive := newArchive("test", user, renewTokens, tempTokens, events, now)
+	if archive.UserID != user.ID.Hex() || len(archive.Profiles) != 1 || len(archive.Sessions) != 1 || len(archive.SecurityEvents) != 1 {
+		t.Errorf("unexpected archive: %+v", archive)
+	}
+	if len(archive.Profiles[0].ConsentRecords) != 1 || archive.Profiles[0].ConsentRecords[0].Version != "1" {
+		t.Errorf("consent records missing: %v", archive.Profiles[0])
+	}
+	if len(archive.PendingTempTokens) != 1 || archive.PendingTempTokens[0].Purpose != "data-export" {
+		t.Errorf("only pending temp tokens should be exported: %v", archive.PendingTempTokens)
+	}
+
+	data, err := archive.Marshal()
+	if err != nil {
+		t.Errorf("unexpected error: %s", err.Error())
+		return
+	}
+	if !strings.Contains(string(data), "export@test.com") {
+		t.Errorf("account missing: %s", data)
+	}
+	if strings.Contains(string(data), "secret") {
+		t.Errorf("secrets should not be exported: %s", data)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/influenzanet/user-management-service/pkg/models"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.opentelemetry.io/contrib/instrumentation/go.mongodb.org/mongo-driver/mongo/otelmongo"
 )
 
 type GlobalDBService struct {
@@ -22,6 +23,7 @@ func NewGlobalDBService(configs models.DBConfig) *GlobalDBService {
 		options.Client().ApplyURI(configs.URI),
 		options.Client().SetMaxConnIdleTime(time.Duration(configs.IdleConnTimeout)*time.Second),
 		options.Client().SetMaxPoolSize(configs.MaxPoolSize),
+		options.Client().SetMonitor(otelmongo.NewMonitor()),
 	)
 	if err != nil {
 		logger.Error.Fatal(err)
@@ -58,6 +60,26 @@ func (dbService *GlobalDBService) collectionAppToken() *mongo.Collection {
 	return dbService.DBClient.Database(dbService.DBNamePrefix + "global-infos").Collection("app-tokens")
 }
 
+func (dbService *GlobalDBService) collectionScimToken() *mongo.Collection {
+	return dbService.DBClient.Database(dbService.DBNamePrefix + "global-infos").Collection("scim-tokens")
+}
+
+func (dbService *GlobalDBService) collectionAuditOutbox() *mongo.Collection {
+	return dbService.DBClient.Database(dbService.DBNamePrefix + "global-infos").Collection("audit-outbox")
+}
+
+func (dbService *GlobalDBService) collectionInstanceSettings() *mongo.Collection {
+	return dbService.DBClient.Database(dbService.DBNamePrefix + "global-infos").Collection("instance-settings")
+}
+
+func (dbService *GlobalDBService) collectionLeases() *mongo.Collection {
+	return dbService.DBClient.Database(dbService.DBNamePrefix + "global-infos").Collection("leases")
+}
+
+func (dbService *GlobalDBService) collectionJobRuns() *mongo.Collection {
+	return dbService.DBClient.Database(dbService.DBNamePrefix + "global-infos").Collection("job-runs")
+}
+
 func (dbService *GlobalDBService) collectionRefInstances() *mongo.Collection {
 	return dbService.DBClient.Database(dbService.DBNamePrefix + "global-infos").Collection("instances")
 }
@@ -66,3 +88,17 @@ func (dbService *GlobalDBService) collectionRefInstances() *mongo.Collection {
 func (dbService *GlobalDBService) getContext() (ctx context.Context, cancel context.CancelFunc) {
 	return context.WithTimeout(context.Background(), time.Duration(dbService.timeout)*time.Second)
 }
+
+// Ping checks the connection to the DB
+func (dbService *GlobalDBService) Ping() error {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+	return dbService.DBClient.Ping(ctx, nil)
+}
+
+// Close disconnects from the DB, waiting for the operations in progress
+func (dbService *GlobalDBService) Close() error {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+	return dbService.DBClient.Disconnect(ctx)
+}
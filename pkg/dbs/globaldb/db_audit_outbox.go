@@ -0,0 +1,121 @@
+package globaldb
+
+import (
+	"time"
+
+	"github.com/influenzanet/user-management-service/pkg/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// pendingAuditEventsFilter matches entries not delivered yet
+var pendingAuditEventsFilter = bson.M{"deliveredAt": bson.M{"$exists": false}}
+
+// CreateIndexForAuditOutbox creates the indexes to find pending entries and the events of a user, and the TTL index
+// removing delivered ones
+func (dbService *GlobalDBService) CreateIndexForAuditOutbox(deliveredEntriesTTL time.Duration) error {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	_, err := dbService.collectionAuditOutbox().Indexes().CreateMany(
+		ctx, []mongo.IndexModel{
+			{
+				Keys: bson.D{
+					{Key: "nextAttemptAt", Value: 1},
+					{Key: "createdAt", Value: 1},
+				},
+				Options: options.Index().SetPartialFilterExpression(pendingAuditEventsFilter),
+			},
+			{
+				Keys: bson.D{
+					{Key: "instanceID", Value: 1},
+					{Key: "userID", Value: 1},
+					{Key: "createdAt", Value: -1},
+				},
+			},
+			{
+				Keys:    bson.D{{Key: "deliveredAt", Value: 1}},
+				Options: options.Index().SetExpireAfterSeconds(int32(deliveredEntriesTTL.Seconds())),
+			},
+		},
+	)
+	return err
+}
+
+func (dbService *GlobalDBService) AddAuditOutboxEntry(entry models.AuditOutboxEntry) error {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	_, err := dbService.collectionAuditOutbox().InsertOne(ctx, entry)
+	return err
+}
+
+// ClaimAuditOutboxEntry returns the oldest entry due for delivery and postpones its next attempt by lease,
+// so that other service instances won't deliver it at the same time. Returns mongo.ErrNoDocuments if none is due.
+func (dbService *GlobalDBService) ClaimAuditOutboxEntry(now int64, lease time.Duration) (entry models.AuditOutboxEntry, err error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{
+		"deliveredAt":   bson.M{"$exists": false},
+		"nextAttemptAt": bson.M{"$lte": now},
+	}
+	update := bson.M{"$set": bson.M{"nextAttemptAt": now + int64(lease.Seconds())}}
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.D{{Key: "nextAttemptAt", Value: 1}, {Key: "createdAt", Value: 1}}).
+		SetReturnDocument(options.After)
+	err = dbService.collectionAuditOutbox().FindOneAndUpdate(ctx, filter, update, opts).Decode(&entry)
+	return
+}
+
+func (dbService *GlobalDBService) MarkAuditOutboxEntryDelivered(entry models.AuditOutboxEntry) error {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	now := time.Now()
+	update := bson.M{
+		"$set": bson.M{"deliveredAt": now},
+		"$inc": bson.M{"attempts": 1},
+	}
+	_, err := dbService.collectionAuditOutbox().UpdateOne(ctx, bson.M{"_id": entry.ID}, update)
+	return err
+}
+
+func (dbService *GlobalDBService) MarkAuditOutboxEntryFailed(entry models.AuditOutboxEntry, nextAttemptAt int64, reason string) error {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	update := bson.M{
+		"$set": bson.M{"nextAttemptAt": nextAttemptAt, "lastError": reason},
+		"$inc": bson.M{"attempts": 1},
+	}
+	_, err := dbService.collectionAuditOutbox().UpdateOne(ctx, bson.M{"_id": entry.ID}, update)
+	return err
+}
+
+// FindAuditEventsForUser returns the latest events of the type logged for the user, delivered or not. Delivered
+// events are only kept until they expire.
+func (dbService *GlobalDBService) FindAuditEventsForUser(instanceID string, userID string, eventType int32, limit int64) (entries []models.AuditOutboxEntry, err error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{"instanceID": instanceID, "userID": userID, "eventType": eventType}
+	opts := options.Find().SetSort(bson.D{{Key: "createdAt", Value: -1}}).SetLimit(limit)
+	cur, err := dbService.collectionAuditOutbox().Find(ctx, filter, opts)
+	if err != nil {
+		return entries, err
+	}
+	defer cur.Close(ctx)
+
+	entries = []models.AuditOutboxEntry{}
+	err = cur.All(ctx, &entries)
+	return
+}
+
+func (dbService *GlobalDBService) CountPendingAuditOutboxEntries() (int64, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	return dbService.collectionAuditOutbox().CountDocuments(ctx, pendingAuditEventsFilter)
+}
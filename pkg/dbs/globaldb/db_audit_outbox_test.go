@@ -0,0 +1,89 @@
+package globaldb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/influenzanet/user-management-service/pkg/models"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestDbInterfaceMethodsForAuditOutbox(t *testing.T) {
+	if err := testDBService.CreateIndexForAuditOutbox(time.Hour); err != nil {
+		t.Errorf("unexpected error: %s", err.Error())
+		return
+	}
+
+	now := time.Now().Unix()
+	for _, name := range []string{"first", "second"} {
+		err := testDBService.AddAuditOutboxEntry(models.AuditOutboxEntry{
+			InstanceID:    testInstanceID,
+			EventName:     name,
+			CreatedAt:     now,
+			NextAttemptAt: now,
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+	}
+
+	t.Run("Count pending entries", func(t *testing.T) {
+		count, err := testDBService.CountPendingAuditOutboxEntries()
+		if err != nil || count != 2 {
+			t.Errorf("unexpected result: %d %v", count, err)
+		}
+	})
+
+	t.Run("Claim, fail and deliver entries", func(t *testing.T) {
+		first, err := testDBService.ClaimAuditOutboxEntry(now, time.Minute)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if err := testDBService.MarkAuditOutboxEntryFailed(first, now+3600, "unavailable"); err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+		}
+
+		second, err := testDBService.ClaimAuditOutboxEntry(now, time.Minute)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if second.ID == first.ID {
+			t.Error("claimed entry should not be claimed again")
+		}
+		if err := testDBService.MarkAuditOutboxEntryDelivered(second); err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+		}
+
+		if _, err := testDBService.ClaimAuditOutboxEntry(now, time.Minute); err != mongo.ErrNoDocuments {
+			t.Errorf("no entry should be due: %v", err)
+		}
+
+		count, err := testDBService.CountPendingAuditOutboxEntries()
+		if err != nil || count != 1 {
+			t.Errorf("unexpected result: %d %v", count, err)
+		}
+	})
+
+	t.Run("Find events for user", func(t *testing.T) {
+		for i, eventType := range []int32{1, 3, 1} {
+			err := testDBService.AddAuditOutboxEntry(models.AuditOutboxEntry{
+				InstanceID: testInstanceID,
+				UserID:     "audit-user",
+				EventType:  eventType,
+				EventName:  "event",
+				CreatedAt:  now + int64(i),
+			})
+			if err != nil {
+				t.Errorf("unexpected error: %s", err.Error())
+				return
+			}
+		}
+		events, err := testDBService.FindAuditEventsForUser(testInstanceID, "audit-user", 1, 10)
+		if err != nil || len(events) != 2 || events[0].CreatedAt != now+2 {
+			t.Errorf("unexpected result: %v %v", events, err)
+		}
+	})
+}
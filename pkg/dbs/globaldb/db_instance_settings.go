@@ -0,0 +1,60 @@
+package globaldb
+
+import (
+	"github.com/influenzanet/user-management-service/pkg/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// CreateIndexForInstanceSettings makes sure there is a single settings document per instance
+func (dbService *GlobalDBService) CreateIndexForInstanceSettings() error {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	_, err := dbService.collectionInstanceSettings().Indexes().CreateOne(
+		ctx, mongo.IndexModel{
+			Keys:    bson.D{{Key: "instanceID", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+	)
+	return err
+}
+
+func (dbService *GlobalDBService) GetAllInstanceSettings() ([]models.InstanceSettings, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	cur, err := dbService.collectionInstanceSettings().Find(ctx, bson.M{})
+	if err != nil {
+		return []models.InstanceSettings{}, err
+	}
+	defer cur.Close(ctx)
+
+	settings := []models.InstanceSettings{}
+	if err := cur.All(ctx, &settings); err != nil {
+		return settings, err
+	}
+	return settings, nil
+}
+
+// GetInstanceSettings returns mongo.ErrNoDocuments if no settings were stored for the instance
+func (dbService *GlobalDBService) GetInstanceSettings(instanceID string) (settings models.InstanceSettings, err error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{"instanceID": instanceID}
+	err = dbService.collectionInstanceSettings().FindOne(ctx, filter).Decode(&settings)
+	return
+}
+
+// ReplaceInstanceSettings stores the settings of settings.InstanceID, replacing the previous ones
+func (dbService *GlobalDBService) ReplaceInstanceSettings(settings models.InstanceSettings) error {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{"instanceID": settings.InstanceID}
+	opts := options.Replace().SetUpsert(true)
+	_, err := dbService.collectionInstanceSettings().ReplaceOne(ctx, filter, settings, opts)
+	return err
+}
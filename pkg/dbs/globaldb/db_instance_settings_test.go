@@ -0,0 +1,55 @@
+package globaldb
+
+import (
+	"testing"
+
+	"github.com/influenzanet/user-management-service/pkg/models"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestDbInterfaceMethodsForInstanceSettings(t *testing.T) {
+	if err := testDBService.CreateIndexForInstanceSettings(); err != nil {
+		t.Errorf("unexpected error: %s", err.Error())
+		return
+	}
+
+	t.Run("Get settings of instance without settings", func(t *testing.T) {
+		_, err := testDBService.GetInstanceSettings(testInstanceID + "_none")
+		if err != mongo.ErrNoDocuments {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("Replace settings", func(t *testing.T) {
+		limit := int64(10)
+		policy := "required"
+		err := testDBService.ReplaceInstanceSettings(models.InstanceSettings{InstanceID: testInstanceID, NewUserRateLimit: &limit})
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		err = testDBService.ReplaceInstanceSettings(models.InstanceSettings{InstanceID: testInstanceID, SecondFactorPolicy: &policy})
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+
+		res, err := testDBService.GetInstanceSettings(testInstanceID)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if res.NewUserRateLimit != nil || res.SecondFactorPolicy == nil || *res.SecondFactorPolicy != policy {
+			t.Errorf("settings not replaced: %+v", res)
+		}
+
+		all, err := testDBService.GetAllInstanceSettings()
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if len(all) != 1 {
+			t.Errorf("unexpected number of settings: %d", len(all))
+		}
+	})
+}
@@ -0,0 +1,137 @@
+package globaldb
+
+import (
+	"time"
+
+	"github.com/influenzanet/user-management-service/pkg/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// CreateIndexForJobRuns creates the index of the history and the TTL index removing finished runs
+func (dbService *GlobalDBService) CreateIndexForJobRuns(finishedRunsTTL time.Duration) error {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	_, err := dbService.collectionJobRuns().Indexes().CreateMany(
+		ctx, []mongo.IndexModel{
+			{
+				Keys: bson.D{
+					{Key: "job", Value: 1},
+					{Key: "requestedAt", Value: -1},
+				},
+			},
+			{
+				Keys: bson.D{{Key: "status", Value: 1}},
+			},
+			{
+				Keys:    bson.D{{Key: "finishedDate", Value: 1}},
+				Options: options.Index().SetExpireAfterSeconds(int32(finishedRunsTTL.Seconds())),
+			},
+		},
+	)
+	return err
+}
+
+// AddJobRun saves a new run and returns it with its ID
+func (dbService *GlobalDBService) AddJobRun(run models.JobRun) (models.JobRun, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	res, err := dbService.collectionJobRuns().InsertOne(ctx, run)
+	if err != nil {
+		return run, err
+	}
+	run.ID = res.InsertedID.(primitive.ObjectID)
+	return run, nil
+}
+
+// ClaimPendingJobRun starts the oldest pending run of a job not in excludedJobs.
+// Returns mongo.ErrNoDocuments if there is none.
+func (dbService *GlobalDBService) ClaimPendingJobRun(excludedJobs []string) (run models.JobRun, err error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{"status": models.JOB_RUN_STATUS_PENDING}
+	if len(excludedJobs) > 0 {
+		filter["job"] = bson.M{"$nin": excludedJobs}
+	}
+	update := bson.M{"$set": bson.M{"status": models.JOB_RUN_STATUS_RUNNING, "startedAt": time.Now().Unix()}}
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.D{{Key: "requestedAt", Value: 1}}).
+		SetReturnDocument(options.After)
+	err = dbService.collectionJobRuns().FindOneAndUpdate(ctx, filter, update, opts).Decode(&run)
+	return
+}
+
+// FinishJobRun saves the status and results of the run
+func (dbService *GlobalDBService) FinishJobRun(run models.JobRun) error {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	update := bson.M{"$set": bson.M{
+		"status":       run.Status,
+		"finishedAt":   run.FinishedAt,
+		"finishedDate": time.Unix(run.FinishedAt, 0),
+		"results":      run.Results,
+	}}
+	_, err := dbService.collectionJobRuns().UpdateOne(ctx, bson.M{"_id": run.ID}, update)
+	return err
+}
+
+// InterruptRunningJobRuns marks the runs still running as interrupted. Used when the jobs are taken over after
+// the service instance running them stopped without finishing them.
+func (dbService *GlobalDBService) InterruptRunningJobRuns() (int64, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	now := time.Now()
+	update := bson.M{"$set": bson.M{
+		"status":       models.JOB_RUN_STATUS_INTERRUPTED,
+		"finishedAt":   now.Unix(),
+		"finishedDate": now,
+	}}
+	res, err := dbService.collectionJobRuns().UpdateMany(ctx, bson.M{"status": models.JOB_RUN_STATUS_RUNNING}, update)
+	if err != nil {
+		return 0, err
+	}
+	return res.ModifiedCount, nil
+}
+
+// GetJobRuns returns the latest runs of the job, newest first. With an instanceID, manual runs of other
+// instances are left out.
+func (dbService *GlobalDBService) GetJobRuns(job string, instanceID string, limit int64) ([]models.JobRun, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{"job": job}
+	if instanceID != "" {
+		filter["instanceID"] = bson.M{"$in": bson.A{nil, instanceID}}
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "requestedAt", Value: -1}}).SetLimit(limit)
+	cur, err := dbService.collectionJobRuns().Find(ctx, filter, opts)
+	if err != nil {
+		return []models.JobRun{}, err
+	}
+	defer cur.Close(ctx)
+
+	runs := []models.JobRun{}
+	if err := cur.All(ctx, &runs); err != nil {
+		return runs, err
+	}
+	return runs, nil
+}
+
+// GetLastScheduledJobRun returns the latest run of the job started by its schedule.
+// Returns mongo.ErrNoDocuments if the job never ran.
+func (dbService *GlobalDBService) GetLastScheduledJobRun(job string) (run models.JobRun, err error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{"job": job, "trigger": models.JOB_TRIGGER_SCHEDULE}
+	opts := options.FindOne().SetSort(bson.D{{Key: "requestedAt", Value: -1}})
+	err = dbService.collectionJobRuns().FindOne(ctx, filter, opts).Decode(&run)
+	return
+}
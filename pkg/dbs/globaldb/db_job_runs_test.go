@@ -0,0 +1,110 @@
+package globaldb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/influenzanet/user-management-service/pkg/models"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestDbInterfaceMethodsForJobRuns(t *testing.T) {
+	job := "test-job-" + testInstanceID
+	if err := testDBService.CreateIndexForJobRuns(time.Hour); err != nil {
+		t.Errorf("unexpected error: %s", err.Error())
+		return
+	}
+
+	now := time.Now().Unix()
+	scheduled, err := testDBService.AddJobRun(models.JobRun{
+		Job:         job,
+		Trigger:     models.JOB_TRIGGER_SCHEDULE,
+		Status:      models.JOB_RUN_STATUS_RUNNING,
+		RequestedAt: now - 10,
+		StartedAt:   now - 10,
+	})
+	if err != nil {
+		t.Errorf("unexpected error: %s", err.Error())
+		return
+	}
+
+	t.Run("last scheduled run", func(t *testing.T) {
+		last, err := testDBService.GetLastScheduledJobRun(job)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if last.ID != scheduled.ID {
+			t.Errorf("unexpected run: %+v", last)
+		}
+		if _, err := testDBService.GetLastScheduledJobRun(job + "-other"); err != mongo.ErrNoDocuments {
+			t.Errorf("no run expected: %v", err)
+		}
+	})
+
+	t.Run("finish run", func(t *testing.T) {
+		scheduled.AddAffected(testInstanceID, 3)
+		scheduled.AddError(testInstanceID, "failed")
+		scheduled.Status = models.JOB_RUN_STATUS_FINISHED
+		scheduled.FinishedAt = time.Now().Unix()
+		if err := testDBService.FinishJobRun(scheduled); err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		runs, err := testDBService.GetJobRuns(job, testInstanceID, 10)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if len(runs) != 1 || runs[0].Status != models.JOB_RUN_STATUS_FINISHED || len(runs[0].Results) != 1 {
+			t.Errorf("unexpected runs: %+v", runs)
+		}
+	})
+
+	t.Run("claim manual runs", func(t *testing.T) {
+		for _, instanceID := range []string{testInstanceID, "other-instance"} {
+			if _, err := testDBService.AddJobRun(models.JobRun{
+				Job:         job,
+				Trigger:     models.JOB_TRIGGER_MANUAL,
+				InstanceID:  instanceID,
+				Status:      models.JOB_RUN_STATUS_PENDING,
+				RequestedAt: now,
+			}); err != nil {
+				t.Errorf("unexpected error: %s", err.Error())
+				return
+			}
+		}
+
+		if _, err := testDBService.ClaimPendingJobRun([]string{job}); err != mongo.ErrNoDocuments {
+			t.Errorf("running job should be excluded: %v", err)
+		}
+		run, err := testDBService.ClaimPendingJobRun(nil)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if run.Status != models.JOB_RUN_STATUS_RUNNING || run.StartedAt == 0 {
+			t.Errorf("unexpected run: %+v", run)
+		}
+
+		runs, err := testDBService.GetJobRuns(job, testInstanceID, 10)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if len(runs) != 2 {
+			t.Errorf("manual runs of other instances should be left out: %+v", runs)
+		}
+	})
+
+	t.Run("interrupt running runs", func(t *testing.T) {
+		count, err := testDBService.InterruptRunningJobRuns()
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if count < 1 {
+			t.Errorf("claimed run should be interrupted: %d", count)
+		}
+	})
+}
@@ -0,0 +1,82 @@
+package globaldb
+
+import (
+	"time"
+
+	"github.com/influenzanet/user-management-service/pkg/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// AcquireLease gives the lease to holder if it is free or expired, and returns it with its new token.
+// Returns mongo.ErrNoDocuments if another holder has the lease.
+func (dbService *GlobalDBService) AcquireLease(name string, holder string, duration time.Duration) (lease models.Lease, err error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	now := time.Now().Unix()
+	filter := bson.M{"_id": name, "expiresAt": bson.M{"$lte": now}}
+	update := bson.M{
+		"$set": bson.M{"holder": holder, "acquiredAt": now, "expiresAt": now + int64(duration.Seconds())},
+		"$inc": bson.M{"token": 1},
+	}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	err = dbService.collectionLeases().FindOneAndUpdate(ctx, filter, update, opts).Decode(&lease)
+	if err != mongo.ErrNoDocuments {
+		return lease, err
+	}
+
+	// first use of the lease
+	lease = models.Lease{
+		Name:       name,
+		Holder:     holder,
+		Token:      1,
+		AcquiredAt: now,
+		ExpiresAt:  now + int64(duration.Seconds()),
+	}
+	if _, err := dbService.collectionLeases().InsertOne(ctx, lease); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return models.Lease{}, mongo.ErrNoDocuments
+		}
+		return models.Lease{}, err
+	}
+	return lease, nil
+}
+
+// RenewLease extends the lease, returns mongo.ErrNoDocuments if it is not held by holder with token anymore
+func (dbService *GlobalDBService) RenewLease(name string, holder string, token int64, duration time.Duration) error {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{"_id": name, "holder": holder, "token": token}
+	update := bson.M{"$set": bson.M{"expiresAt": time.Now().Unix() + int64(duration.Seconds())}}
+	res, err := dbService.collectionLeases().UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount < 1 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+// ReleaseLease lets other holders acquire the lease right away
+func (dbService *GlobalDBService) ReleaseLease(name string, holder string, token int64) error {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{"_id": name, "holder": holder, "token": token}
+	update := bson.M{"$set": bson.M{"expiresAt": 0}}
+	_, err := dbService.collectionLeases().UpdateOne(ctx, filter, update)
+	return err
+}
+
+// CheckLease returns mongo.ErrNoDocuments if the lease is not held by holder with token anymore, or has expired
+func (dbService *GlobalDBService) CheckLease(name string, holder string, token int64) error {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{"_id": name, "holder": holder, "token": token, "expiresAt": bson.M{"$gt": time.Now().Unix()}}
+	return dbService.collectionLeases().FindOne(ctx, filter).Err()
+}
@@ -0,0 +1,61 @@
+package globaldb
+
+import (
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestDbInterfaceMethodsForLeases(t *testing.T) {
+	name := "test-lease-" + testInstanceID
+
+	first, err := testDBService.AcquireLease(name, "replica-1", time.Minute)
+	if err != nil {
+		t.Errorf("unexpected error: %s", err.Error())
+		return
+	}
+	if first.Token != 1 || first.Holder != "replica-1" {
+		t.Errorf("unexpected lease: %+v", first)
+	}
+
+	t.Run("acquire held lease", func(t *testing.T) {
+		_, err := testDBService.AcquireLease(name, "replica-2", time.Minute)
+		if err != mongo.ErrNoDocuments {
+			t.Errorf("lease should be held: %v", err)
+		}
+	})
+
+	t.Run("renew and check lease", func(t *testing.T) {
+		if err := testDBService.RenewLease(name, "replica-1", first.Token, time.Minute); err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+		}
+		if err := testDBService.CheckLease(name, "replica-1", first.Token); err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+		}
+		if err := testDBService.RenewLease(name, "replica-2", first.Token, time.Minute); err != mongo.ErrNoDocuments {
+			t.Errorf("other holder should not renew: %v", err)
+		}
+	})
+
+	t.Run("take over released lease", func(t *testing.T) {
+		if err := testDBService.ReleaseLease(name, "replica-1", first.Token); err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		second, err := testDBService.AcquireLease(name, "replica-2", time.Minute)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if second.Token != first.Token+1 {
+			t.Errorf("token should be incremented: %d", second.Token)
+		}
+		if err := testDBService.CheckLease(name, "replica-1", first.Token); err != mongo.ErrNoDocuments {
+			t.Errorf("former holder should be fenced: %v", err)
+		}
+		if err := testDBService.RenewLease(name, "replica-1", first.Token, time.Minute); err != mongo.ErrNoDocuments {
+			t.Errorf("former holder should not renew: %v", err)
+		}
+	})
+}
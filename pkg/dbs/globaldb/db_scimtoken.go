@@ -0,0 +1,23 @@
+package globaldb
+
+import (
+	"github.com/influenzanet/user-management-service/pkg/models"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (dbService *GlobalDBService) FindScimToken(token string) (scimTokenInfos models.ScimToken, err error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{"tokens": token}
+	err = dbService.collectionScimToken().FindOne(ctx, filter).Decode(&scimTokenInfos)
+	return
+}
+
+func (dbService *GlobalDBService) AddScimToken(scimToken models.ScimToken) (err error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	_, err = dbService.collectionScimToken().InsertOne(ctx, scimToken)
+	return
+}
@@ -0,0 +1,42 @@
+package globaldb
+
+import (
+	"testing"
+
+	"github.com/influenzanet/user-management-service/pkg/models"
+)
+
+func TestDbInterfaceMethodsForScimToken(t *testing.T) {
+	scimToken := models.ScimToken{
+		ClientName: "test-directory",
+		Instances:  []string{testInstanceID},
+		Tokens:     []string{"scim1", "scim2"},
+	}
+
+	err := testDBService.AddScimToken(scimToken)
+	if err != nil {
+		t.Errorf("unexpected error: %s", err.Error())
+	}
+
+	t.Run("Find existing scim token", func(t *testing.T) {
+		res, err := testDBService.FindScimToken("scim2")
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if res.ClientName != scimToken.ClientName {
+			t.Error("scim token object not retrieved correctly")
+		}
+		if !res.HasInstance(testInstanceID) {
+			t.Error("instance missing from scim token")
+		}
+	})
+
+	t.Run("Try to find not existing scim token", func(t *testing.T) {
+		_, err := testDBService.FindScimToken("scim3")
+		if err == nil {
+			t.Error("should not be found")
+			return
+		}
+	})
+}
@@ -97,7 +97,8 @@ func (dbService *GlobalDBService) DeleteAllTempTokenForUser(instanceID string, u
 	return nil
 }
 
-func (dbService *GlobalDBService) DeleteTempTokensExpireBefore(instanceID string, purpose string, expiresBefore int64) error {
+// DeleteTempTokensExpireBefore removes the tokens expired before the given time and returns their number
+func (dbService *GlobalDBService) DeleteTempTokensExpireBefore(instanceID string, purpose string, expiresBefore int64) (int64, error) {
 	ctx, cancel := dbService.getContext()
 	defer cancel()
 
@@ -108,9 +109,9 @@ func (dbService *GlobalDBService) DeleteTempTokensExpireBefore(instanceID string
 	if len(instanceID) > 0 {
 		filter["instanceID"] = instanceID
 	}
-	_, err := dbService.collectionRefTempToken().DeleteMany(ctx, filter)
+	res, err := dbService.collectionRefTempToken().DeleteMany(ctx, filter)
 	if err != nil {
-		return err
+		return 0, err
 	}
-	return nil
+	return res.DeletedCount, nil
 }
@@ -223,7 +223,7 @@ func TestDBDeleteExpiredTempTokens(t *testing.T) {
 	}
 
 	t.Run("Delete expired for single purpose", func(t *testing.T) {
-		err := testDBService.DeleteTempTokensExpireBefore("", "purpose1", time.Now().Unix()-15)
+		_, err := testDBService.DeleteTempTokensExpireBefore("", "purpose1", time.Now().Unix()-15)
 		if err != nil {
 			t.Errorf("unexpected error: %v", err.Error())
 			return
@@ -240,7 +240,7 @@ func TestDBDeleteExpiredTempTokens(t *testing.T) {
 	})
 
 	t.Run("Delete expired for single instance", func(t *testing.T) {
-		err := testDBService.DeleteTempTokensExpireBefore("testInstance1", "", time.Now().Unix()-5)
+		_, err := testDBService.DeleteTempTokensExpireBefore("testInstance1", "", time.Now().Unix()-5)
 		if err != nil {
 			t.Errorf("unexpected error: %v", err.Error())
 			return
@@ -257,7 +257,7 @@ func TestDBDeleteExpiredTempTokens(t *testing.T) {
 	})
 
 	t.Run("Delete expired for purpose and instance", func(t *testing.T) {
-		err := testDBService.DeleteTempTokensExpireBefore("testInstance3", "purpose3", time.Now().Unix()-5)
+		_, err := testDBService.DeleteTempTokensExpireBefore("testInstance3", "purpose3", time.Now().Unix()-5)
 		if err != nil {
 			t.Errorf("unexpected error: %v", err.Error())
 			return
@@ -274,7 +274,7 @@ func TestDBDeleteExpiredTempTokens(t *testing.T) {
 	})
 
 	t.Run("Delete expired everywhere", func(t *testing.T) {
-		err := testDBService.DeleteTempTokensExpireBefore("", "", time.Now().Unix()-5)
+		_, err := testDBService.DeleteTempTokensExpireBefore("", "", time.Now().Unix()-5)
 		if err != nil {
 			t.Errorf("unexpected error: %v", err.Error())
 			return
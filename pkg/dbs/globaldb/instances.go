@@ -36,3 +36,29 @@ func (dbService *GlobalDBService) GetAllInstances() ([]global_types.Instance, er
 
 	return instances, nil
 }
+
+// GetEnabledInstanceIDs returns the IDs of the instances not marked as disabled
+func (dbService *GlobalDBService) GetEnabledInstanceIDs() ([]string, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{"disabled": bson.M{"$ne": true}}
+	cur, err := dbService.collectionRefInstances().Find(ctx, filter)
+	if err != nil {
+		return []string{}, err
+	}
+	defer cur.Close(ctx)
+
+	instanceIDs := []string{}
+	for cur.Next(ctx) {
+		var result global_types.Instance
+		if err := cur.Decode(&result); err != nil {
+			return instanceIDs, err
+		}
+		instanceIDs = append(instanceIDs, result.InstanceID)
+	}
+	if err := cur.Err(); err != nil {
+		return instanceIDs, err
+	}
+	return instanceIDs, nil
+}
@@ -1,7 +1,11 @@
 package globaldb
 
 import (
+	"context"
 	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
 )
 
 func TestDbInterfaceMethods(t *testing.T) {
@@ -16,3 +20,33 @@ func TestDbInterfaceMethods(t *testing.T) {
 		}
 	})
 }
+
+func TestGetEnabledInstanceIDs(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := testDBService.collectionRefInstances().InsertMany(ctx, []interface{}{
+		bson.M{"instanceID": "enabled"},
+		bson.M{"instanceID": "disabled", "disabled": true},
+		bson.M{"instanceID": "enabled-again", "disabled": false},
+	})
+	if err != nil {
+		t.Errorf("failed to create testdata: %s", err.Error())
+		return
+	}
+	defer testDBService.collectionRefInstances().DeleteMany(ctx, bson.M{})
+
+	instanceIDs, err := testDBService.GetEnabledInstanceIDs()
+	if err != nil {
+		t.Errorf("unexpected error: %s", err.Error())
+		return
+	}
+	if len(instanceIDs) != 2 {
+		t.Errorf("unexpected instances: %v", instanceIDs)
+	}
+	for _, id := range instanceIDs {
+		if id == "disabled" {
+			t.Error("disabled instance returned")
+		}
+	}
+}
@@ -5,19 +5,28 @@ import (
 	"time"
 
 	"github.com/coneno/logger"
+	"github.com/influenzanet/user-management-service/pkg/fieldcrypt"
 	"github.com/influenzanet/user-management-service/pkg/models"
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.opentelemetry.io/contrib/instrumentation/go.mongodb.org/mongo-driver/mongo/otelmongo"
 )
 
 const UserCollection = "users"
 const RenewTokenCollection = "renewTokens"
+const OutgoingEmailCollection = "outgoingEmails"
+const ErasureCollection = "erasures"
 
 type UserDBService struct {
 	DBClient        *mongo.Client
 	timeout         int
 	noCursorTimeout bool
 	DBNamePrefix    string
+	// supportsTransactions is true for replica sets and sharded clusters
+	supportsTransactions bool
+	// fieldKeys encrypt the email addresses of the users, nil if they are stored in plaintext
+	fieldKeys *fieldcrypt.KeyRing
 }
 
 func NewUserDBService(configs models.DBConfig) *UserDBService {
@@ -26,6 +35,7 @@ func NewUserDBService(configs models.DBConfig) *UserDBService {
 		options.Client().ApplyURI(configs.URI),
 		options.Client().SetMaxConnIdleTime(time.Duration(configs.IdleConnTimeout)*time.Second),
 		options.Client().SetMaxPoolSize(configs.MaxPoolSize),
+		options.Client().SetMonitor(otelmongo.NewMonitor()),
 	)
 	if err != nil {
 		logger.Error.Fatal(err)
@@ -46,14 +56,38 @@ func NewUserDBService(configs models.DBConfig) *UserDBService {
 		logger.Error.Fatal("fail to connect to DB: " + err.Error())
 	}
 
+	supportsTransactions, err := checkTransactionSupport(dbClient, time.Duration(configs.Timeout)*time.Second)
+	if err != nil {
+		logger.Error.Fatal(err)
+	}
+	if !supportsTransactions {
+		logger.Warning.Println("user DB is a standalone server without transactions, outgoing emails are stored in a separate write")
+	}
+
 	return &UserDBService{
-		DBClient:        dbClient,
-		timeout:         configs.Timeout,
-		noCursorTimeout: configs.NoCursorTimeout,
-		DBNamePrefix:    configs.DBNamePrefix,
+		DBClient:             dbClient,
+		timeout:              configs.Timeout,
+		noCursorTimeout:      configs.NoCursorTimeout,
+		DBNamePrefix:         configs.DBNamePrefix,
+		supportsTransactions: supportsTransactions,
 	}
 }
 
+// checkTransactionSupport asks the server whether it is part of a replica set or a sharded cluster
+func checkTransactionSupport(dbClient *mongo.Client, timeout time.Duration) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	var res struct {
+		SetName string `bson:"setName"`
+		Msg     string `bson:"msg"`
+	}
+	if err := dbClient.Database("admin").RunCommand(ctx, bson.D{{Key: "isMaster", Value: 1}}).Decode(&res); err != nil {
+		return false, err
+	}
+	return res.SetName != "" || res.Msg == "isdbgrid", nil
+}
+
 // Collections
 func (dbService *UserDBService) collectionRefUsers(instanceID string) *mongo.Collection {
 	return dbService.DBClient.Database(dbService.DBNamePrefix + instanceID + "_users").Collection(UserCollection)
@@ -64,6 +98,16 @@ func (dbSerive *UserDBService) collectionRenewTokens(instanceID string) *mongo.C
 	return dbSerive.DBClient.Database(dbSerive.DBNamePrefix + instanceID + "_users").Collection(RenewTokenCollection)
 }
 
+// collectionOutgoingEmails get collection for the outbox of the messaging service requests
+func (dbService *UserDBService) collectionOutgoingEmails(instanceID string) *mongo.Collection {
+	return dbService.DBClient.Database(dbService.DBNamePrefix + instanceID + "_users").Collection(OutgoingEmailCollection)
+}
+
+// collectionErasures get collection for the progress of the account erasures
+func (dbService *UserDBService) collectionErasures(instanceID string) *mongo.Collection {
+	return dbService.DBClient.Database(dbService.DBNamePrefix + instanceID + "_users").Collection(ErasureCollection)
+}
+
 // DB utils
 func (dbService *UserDBService) getContext() (ctx context.Context, cancel context.CancelFunc) {
 	return context.WithTimeout(context.Background(), time.Duration(dbService.timeout)*time.Second)
@@ -83,3 +127,42 @@ func (dbService *UserDBService) GetContext() (ctx context.Context, cancel contex
 func (dbService *UserDBService) GetCollection(instanceID string, name string) *mongo.Collection {
 	return dbService.DBClient.Database(dbService.DBNamePrefix + instanceID + "_users").Collection(name)
 }
+
+// withTransaction runs fn in a transaction if the DB supports them, otherwise its writes are done one after the other.
+// The writes of fn must use the given context.
+func (dbService *UserDBService) withTransaction(fn func(ctx context.Context) error) error {
+	return dbService.withTransactionContext(context.Background(), fn)
+}
+
+// withTransactionContext is withTransaction, stopped when parent is done
+func (dbService *UserDBService) withTransactionContext(parent context.Context, fn func(ctx context.Context) error) error {
+	ctx, cancel := context.WithTimeout(parent, dbService.GetTimeout())
+	defer cancel()
+
+	if !dbService.supportsTransactions {
+		return fn(ctx)
+	}
+	session, err := dbService.DBClient.StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(ctx)
+	_, err = session.WithTransaction(ctx, func(sc mongo.SessionContext) (interface{}, error) {
+		return nil, fn(sc)
+	})
+	return err
+}
+
+// Ping checks the connection to the DB
+func (dbService *UserDBService) Ping() error {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+	return dbService.DBClient.Ping(ctx, nil)
+}
+
+// Close disconnects from the DB, waiting for the operations in progress
+func (dbService *UserDBService) Close() error {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+	return dbService.DBClient.Disconnect(ctx)
+}
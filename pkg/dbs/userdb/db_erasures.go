@@ -0,0 +1,186 @@
+package userdb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/influenzanet/user-management-service/pkg/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var (
+	ErrUserNotFound = errors.New("no user found with the given id")
+	// ErrUserChanged is returned when the user doesn't match the filter of the erasure anymore
+	ErrUserChanged = errors.New("user not found or changed since it was selected")
+)
+
+// ErasureFilter restricts the users an erasure starts for, so that a user who changed since it was selected is kept
+type ErasureFilter bson.M
+
+// UnverifiedUsers is the filter of the accounts not confirmed since their creation before createdBefore
+func UnverifiedUsers(createdBefore int64) ErasureFilter {
+	return ErasureFilter(unverifiedUsersFilter(createdBefore))
+}
+
+// UsersMarkedForDeletion is the filter of the inactive accounts to delete before the given time
+func UsersMarkedForDeletion(before int64) ErasureFilter {
+	return ErasureFilter(markedForDeletionFilter(before))
+}
+
+// UsersWithDeletionDue is the filter of the accounts whose requested deletion is scheduled before the given time
+func UsersWithDeletionDue(before int64) ErasureFilter {
+	return ErasureFilter(deletionDueFilter(before))
+}
+
+// CreateIndexForErasures creates the indexes to find due erasures, one pending erasure per user, and the TTL index
+// removing finished ones
+func (dbService *UserDBService) CreateIndexForErasures(instanceID string, finishedTTL time.Duration) error {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	_, err := dbService.collectionErasures(instanceID).Indexes().CreateMany(
+		ctx, []mongo.IndexModel{
+			{
+				Keys: bson.D{
+					{Key: "status", Value: 1},
+					{Key: "nextAttemptAt", Value: 1},
+				},
+			},
+			{
+				// an anonymized account can be erased again while its finished erasure is kept
+				Keys: bson.D{{Key: "userID", Value: 1}},
+				Options: options.Index().SetUnique(true).SetPartialFilterExpression(bson.M{
+					"status": models.ERASURE_STATUS_PENDING,
+				}),
+			},
+			{
+				Keys:    bson.D{{Key: "finishedAt", Value: 1}},
+				Options: options.Index().SetExpireAfterSeconds(int32(finishedTTL.Seconds())),
+			},
+		},
+	)
+	return err
+}
+
+// StartErasure marks the user as being erased and saves the erasure in the same transaction. The erasure is claimed
+// for lease. It fails with ErrUserChanged if the erasure of the user started already or, with a filter, if the user
+// doesn't match it anymore.
+func (dbService *UserDBService) StartErasure(instanceID string, erasure models.Erasure, filter ErasureFilter, lease time.Duration) (models.Erasure, error) {
+	erasure.ClaimID = primitive.NewObjectID().Hex()
+	erasure.NextAttemptAt = time.Now().Add(lease).Unix()
+	err := dbService.withTransaction(func(ctx context.Context) error {
+		_id, _ := primitive.ObjectIDFromHex(erasure.UserID)
+		userFilter := bson.M{"_id": _id, "account.erasureStartedAt": bson.M{"$exists": false}}
+		if filter != nil {
+			userFilter = bson.M{"$and": bson.A{userFilter, bson.M(filter)}}
+		}
+		update := bson.M{"$set": bson.M{"account.erasureStartedAt": erasure.CreatedAt}}
+		res, err := dbService.collectionRefUsers(instanceID).UpdateOne(ctx, userFilter, update)
+		if err != nil {
+			return err
+		}
+		if res.MatchedCount < 1 {
+			return ErrUserChanged
+		}
+		encrypted, err := dbService.encryptErasure(erasure)
+		if err != nil {
+			return err
+		}
+		inserted, err := dbService.collectionErasures(instanceID).InsertOne(ctx, encrypted)
+		if err != nil {
+			return err
+		}
+		erasure.ID = inserted.InsertedID.(primitive.ObjectID)
+		return nil
+	})
+	return erasure, err
+}
+
+// ClaimErasure returns the oldest pending erasure due for a retry, postpones its next attempt by lease and sets a
+// new claim ID, so that other runs won't resume it at the same time. Returns mongo.ErrNoDocuments if none is due.
+func (dbService *UserDBService) ClaimErasure(instanceID string, now int64, lease time.Duration) (erasure models.Erasure, err error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{
+		"status":        models.ERASURE_STATUS_PENDING,
+		"nextAttemptAt": bson.M{"$lte": now},
+	}
+	update := bson.M{"$set": bson.M{
+		"nextAttemptAt": now + int64(lease.Seconds()),
+		"claimID":       primitive.NewObjectID().Hex(),
+	}}
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.D{{Key: "nextAttemptAt", Value: 1}, {Key: "createdAt", Value: 1}}).
+		SetReturnDocument(options.After)
+	return dbService.decodeErasure(dbService.collectionErasures(instanceID).FindOneAndUpdate(ctx, filter, update, opts))
+}
+
+// RetryFailedErasure sets the failed erasure of the user back to pending with new attempts and claims it for lease.
+// Only an erasure of the same kind, deletion or anonymization, is retried. Returns mongo.ErrNoDocuments if there is
+// none.
+func (dbService *UserDBService) RetryFailedErasure(instanceID string, userID string, anonymize bool, lease time.Duration) (erasure models.Erasure, err error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{
+		"userID":    userID,
+		"status":    models.ERASURE_STATUS_FAILED,
+		"anonymize": bson.M{"$ne": true},
+	}
+	if anonymize {
+		filter["anonymize"] = true
+	}
+	update := bson.M{"$set": bson.M{
+		"status":        models.ERASURE_STATUS_PENDING,
+		"attempts":      0,
+		"nextAttemptAt": time.Now().Add(lease).Unix(),
+		"claimID":       primitive.NewObjectID().Hex(),
+	}}
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.D{{Key: "createdAt", Value: -1}}).
+		SetReturnDocument(options.After)
+	return dbService.decodeErasure(dbService.collectionErasures(instanceID).FindOneAndUpdate(ctx, filter, update, opts))
+}
+
+// SaveErasure records the progress of the erasure, if it is still owned by the claim
+func (dbService *UserDBService) SaveErasure(instanceID string, erasure models.Erasure) error {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	encrypted, err := dbService.encryptErasure(erasure)
+	if err != nil {
+		return err
+	}
+	filter := bson.M{"_id": erasure.ID, "claimID": erasure.ClaimID}
+	res, err := dbService.collectionErasures(instanceID).ReplaceOne(ctx, filter, encrypted)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount < 1 {
+		return errors.New("erasure claimed by another run")
+	}
+	return nil
+}
+
+// GetErasureForUser returns the latest erasure of the user, mongo.ErrNoDocuments if none was started
+func (dbService *UserDBService) GetErasureForUser(instanceID string, userID string) (erasure models.Erasure, err error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	opts := options.FindOne().SetSort(bson.D{{Key: "createdAt", Value: -1}})
+	return dbService.decodeErasure(dbService.collectionErasures(instanceID).FindOne(ctx, bson.M{"userID": userID}, opts))
+}
+
+// decodeErasure decodes a single result and decrypts the account ID
+func (dbService *UserDBService) decodeErasure(doc decoder) (erasure models.Erasure, err error) {
+	if err = doc.Decode(&erasure); err != nil {
+		return
+	}
+	err = dbService.decryptErasure(&erasure)
+	return
+}
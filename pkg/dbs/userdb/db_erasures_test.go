@@ -0,0 +1,144 @@
+package userdb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/influenzanet/user-management-service/pkg/models"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestDbInterfaceMethodsForErasures(t *testing.T) {
+	instanceID := "erasures"
+	if err := testDBService.CreateIndexForErasures(instanceID, time.Hour); err != nil {
+		t.Errorf("unexpected error: %s", err.Error())
+		return
+	}
+	id, err := testDBService.AddUser(instanceID, models.User{
+		Account: models.Account{Type: "email", AccountID: "erasure@test.com"},
+	})
+	if err != nil {
+		t.Errorf("unexpected error: %s", err.Error())
+		return
+	}
+	user, err := testDBService.GetUserByID(instanceID, id)
+	if err != nil {
+		t.Errorf("unexpected error: %s", err.Error())
+		return
+	}
+	now := time.Now().Unix()
+
+	t.Run("Start erasure", func(t *testing.T) {
+		if _, err := testDBService.StartErasure(instanceID, models.NewErasure(user, "test", "", ""), UnverifiedUsers(now-10), time.Minute); err != ErrUserChanged {
+			t.Errorf("user not matching the filter should not be erased: %v", err)
+		}
+		erasure, err := testDBService.StartErasure(instanceID, models.NewErasure(user, "test", "", ""), UnverifiedUsers(now+10), time.Minute)
+		if err != nil || erasure.ID.IsZero() || erasure.ClaimID == "" {
+			t.Errorf("unexpected result: %v %v", erasure, err)
+			return
+		}
+		if _, err := testDBService.StartErasure(instanceID, models.NewErasure(user, "test", "", ""), nil, time.Minute); err != ErrUserChanged {
+			t.Errorf("erasure should not start twice: %v", err)
+		}
+		u, err := testDBService.GetUserByID(instanceID, id)
+		if err != nil || !u.Account.IsPendingDeletion() {
+			t.Errorf("unexpected result: %v %v", u.Account, err)
+		}
+	})
+
+	t.Run("Save and claim erasure", func(t *testing.T) {
+		if _, err := testDBService.ClaimErasure(instanceID, now, time.Minute); err != mongo.ErrNoDocuments {
+			t.Errorf("erasure in progress should not be claimed: %v", err)
+		}
+		erasure, err := testDBService.GetErasureForUser(instanceID, id)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		erasure.DoneSteps = append(erasure.DoneSteps, models.ERASURE_STEP_STUDY_SERVICE)
+		erasure.NextAttemptAt = now
+		stale := erasure
+		stale.ClaimID = "other"
+		if err := testDBService.SaveErasure(instanceID, stale); err == nil {
+			t.Error("stale claim should not save the erasure")
+		}
+		if err := testDBService.SaveErasure(instanceID, erasure); err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		claimed, err := testDBService.ClaimErasure(instanceID, now, time.Minute)
+		if err != nil || claimed.ClaimID == erasure.ClaimID || !claimed.IsDone(models.ERASURE_STEP_STUDY_SERVICE) {
+			t.Errorf("unexpected result: %v %v", claimed, err)
+			return
+		}
+		if err := testDBService.SaveErasure(instanceID, erasure); err == nil {
+			t.Error("erasure claimed again should not be saved with the previous claim")
+		}
+	})
+
+	t.Run("Retry failed erasure", func(t *testing.T) {
+		if _, err := testDBService.RetryFailedErasure(instanceID, id, false, time.Minute); err != mongo.ErrNoDocuments {
+			t.Errorf("pending erasure should not be retried: %v", err)
+		}
+		erasure, err := testDBService.GetErasureForUser(instanceID, id)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		erasure.Status = models.ERASURE_STATUS_FAILED
+		erasure.Attempts = 10
+		if err := testDBService.SaveErasure(instanceID, erasure); err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if _, err := testDBService.RetryFailedErasure(instanceID, id, true, time.Minute); err != mongo.ErrNoDocuments {
+			t.Errorf("erasure of another kind should not be retried: %v", err)
+		}
+		retried, err := testDBService.RetryFailedErasure(instanceID, id, false, time.Minute)
+		if err != nil || retried.Status != models.ERASURE_STATUS_PENDING || retried.Attempts != 0 || retried.ClaimID == erasure.ClaimID {
+			t.Errorf("unexpected result: %v %v", retried, err)
+			return
+		}
+		if !retried.IsDone(models.ERASURE_STEP_STUDY_SERVICE) {
+			t.Errorf("progress should be kept: %v", retried)
+		}
+		if err := testDBService.SaveErasure(instanceID, erasure); err == nil {
+			t.Error("erasure retried should not be saved with the previous claim")
+		}
+	})
+
+	t.Run("Anonymize user", func(t *testing.T) {
+		if err := testDBService.AnonymizeUserWithOutgoingEmails(instanceID, primitive.NewObjectID().Hex(), "anonymized@anonymized.invalid", nil); err != ErrUserNotFound {
+			t.Errorf("unexpected error: %v", err)
+		}
+		erasure, err := testDBService.GetErasureForUser(instanceID, id)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if err := testDBService.AnonymizeUserWithOutgoingEmails(instanceID, id, "anonymized@anonymized.invalid", nil); err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		u, err := testDBService.GetUserByID(instanceID, id)
+		if err != nil || !u.Account.IsAnonymized() || u.Account.IsPendingDeletion() || u.Account.AccountID != "anonymized@anonymized.invalid" {
+			t.Errorf("unexpected result: %v %v", u.Account, err)
+		}
+		users, err := testDBService.FindUnverifiedUsers(instanceID, now+10)
+		if err != nil || len(users) > 0 {
+			t.Errorf("anonymized user should not be found: %v %v", users, err)
+		}
+
+		finishedAt := time.Now()
+		erasure.Status = models.ERASURE_STATUS_DONE
+		erasure.FinishedAt = &finishedAt
+		if err := testDBService.SaveErasure(instanceID, erasure); err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if _, err := testDBService.StartErasure(instanceID, models.NewErasure(u, "test", "", ""), nil, time.Minute); err != nil {
+			t.Errorf("anonymized user should still be erasable: %v", err)
+		}
+	})
+}
@@ -17,8 +17,15 @@ import (
 func (dbService *UserDBService) AddUser(instanceID string, user models.User) (id string, err error) {
 	ctx, cancel := dbService.getContext()
 	defer cancel()
+	return dbService.addUser(ctx, instanceID, user)
+}
 
-	filter := bson.M{"account.accountID": user.Account.AccountID}
+func (dbService *UserDBService) addUser(ctx context.Context, instanceID string, user models.User) (id string, err error) {
+	filter := dbService.accountIDFilter(user.Account.AccountID)
+	user, err = dbService.encryptUser(user)
+	if err != nil {
+		return
+	}
 	upsert := true
 	opts := options.UpdateOptions{
 		Upsert: &upsert,
@@ -44,14 +51,16 @@ func (dbService *UserDBService) _updateUserInDB(orgID string, user models.User)
 	ctx, cancel := dbService.getContext()
 	defer cancel()
 
-	elem := models.User{}
+	user, err := dbService.encryptUser(user)
+	if err != nil {
+		return models.User{}, err
+	}
 	filter := bson.M{"_id": user.ID}
 	rd := options.After
 	fro := options.FindOneAndReplaceOptions{
 		ReturnDocument: &rd,
 	}
-	err := dbService.collectionRefUsers(orgID).FindOneAndReplace(ctx, filter, user, &fro).Decode(&elem)
-	return elem, err
+	return dbService.decodeUser(dbService.collectionRefUsers(orgID).FindOneAndReplace(ctx, filter, user, &fro))
 }
 
 func (dbService *UserDBService) UpdateUser(instanceID string, updatedUser models.User) (models.User, error) {
@@ -67,21 +76,23 @@ func (dbService *UserDBService) GetUserByID(instanceID string, id string) (model
 	ctx, cancel := dbService.getContext()
 	defer cancel()
 
-	elem := models.User{}
-	err := dbService.collectionRefUsers(instanceID).FindOne(ctx, filter).Decode(&elem)
-
-	return elem, err
+	return dbService.decodeUser(dbService.collectionRefUsers(instanceID).FindOne(ctx, filter))
 }
 
 func (dbService *UserDBService) GetUserByAccountID(instanceID string, username string) (models.User, error) {
 	ctx, cancel := dbService.getContext()
 	defer cancel()
 
-	elem := models.User{}
-	filter := bson.M{"account.accountID": username}
-	err := dbService.collectionRefUsers(instanceID).FindOne(ctx, filter).Decode(&elem)
+	filter := dbService.accountIDFilter(username)
+	return dbService.decodeUser(dbService.collectionRefUsers(instanceID).FindOne(ctx, filter))
+}
+
+func (dbService *UserDBService) GetUserByFederatedIdentity(instanceID string, issuer string, subject string) (models.User, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
 
-	return elem, err
+	filter := bson.M{"federatedIdentities": bson.M{"$elemMatch": bson.M{"issuer": issuer, "subject": subject}}}
+	return dbService.decodeUser(dbService.collectionRefUsers(instanceID).FindOne(ctx, filter))
 }
 
 func (dbService *UserDBService) UpdateUserPassword(instanceID string, userID string, newPassword string) error {
@@ -133,15 +144,12 @@ func (dbService *UserDBService) UpdateAccountPreferredLang(instanceID string, us
 	_id, _ := primitive.ObjectIDFromHex(userID)
 	filter := bson.M{"_id": _id}
 
-	elem := models.User{}
-
 	rd := options.After
 	fro := options.FindOneAndUpdateOptions{
 		ReturnDocument: &rd,
 	}
 	update := bson.M{"$set": bson.M{"account.preferredLanguage": lang, "timestamps.updatedAt": time.Now().Unix()}}
-	err := dbService.collectionRefUsers(instanceID).FindOneAndUpdate(ctx, filter, update, &fro).Decode(&elem)
-	return elem, err
+	return dbService.decodeUser(dbService.collectionRefUsers(instanceID).FindOneAndUpdate(ctx, filter, update, &fro))
 }
 
 func (dbService *UserDBService) UpdateContactPreferences(instanceID string, userID string, prefs models.ContactPreferences) (models.User, error) {
@@ -151,15 +159,12 @@ func (dbService *UserDBService) UpdateContactPreferences(instanceID string, user
 	_id, _ := primitive.ObjectIDFromHex(userID)
 	filter := bson.M{"_id": _id}
 
-	elem := models.User{}
-
 	rd := options.After
 	fro := options.FindOneAndUpdateOptions{
 		ReturnDocument: &rd,
 	}
 	update := bson.M{"$set": bson.M{"contactPreferences": prefs, "timestamps.updatedAt": time.Now().Unix()}}
-	err := dbService.collectionRefUsers(instanceID).FindOneAndUpdate(ctx, filter, update, &fro).Decode(&elem)
-	return elem, err
+	return dbService.decodeUser(dbService.collectionRefUsers(instanceID).FindOneAndUpdate(ctx, filter, update, &fro))
 }
 
 func (dbService *UserDBService) UpdateLoginTime(instanceID string, id string) error {
@@ -180,20 +185,6 @@ func (dbService *UserDBService) UpdateLoginTime(instanceID string, id string) er
 	return nil
 }
 
-func (dbService *UserDBService) UpdateReminderToConfirmSentAtTime(instanceID string, id string) error {
-	ctx, cancel := dbService.getContext()
-	defer cancel()
-
-	_id, _ := primitive.ObjectIDFromHex(id)
-	filter := bson.M{"_id": _id}
-	update := bson.M{"$set": bson.M{"timestamps.reminderToConfirmSentAt": time.Now().Unix()}}
-	_, err := dbService.collectionRefUsers(instanceID).UpdateOne(ctx, filter, update)
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
 func (dbService *UserDBService) UpdateMarkedForDeletionTime(instanceID string, id string, dT int64, reset bool) (bool, error) {
 	ctx, cancel := dbService.getContext()
 	defer cancel()
@@ -227,6 +218,29 @@ func (dbService *UserDBService) UpdateMarkedForDeletionTime(instanceID string, i
 	return false, nil
 }
 
+// CancelAccountDeletion clears the scheduled deletion of the account, returns false if none was scheduled or if
+// the erasure of the account started already
+func (dbService *UserDBService) CancelAccountDeletion(instanceID string, id string) (bool, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	_id, _ := primitive.ObjectIDFromHex(id)
+	filter := bson.M{
+		"_id":                         _id,
+		"account.deletionScheduledAt": bson.M{"$gt": 0},
+		"account.erasureStartedAt":    bson.M{"$exists": false},
+	}
+	update := bson.M{
+		"$unset": bson.M{"account.deletionScheduledAt": ""},
+		"$set":   bson.M{"timestamps.updatedAt": time.Now().Unix()},
+	}
+	res, err := dbService.collectionRefUsers(instanceID).UpdateOne(ctx, filter, update)
+	if err != nil {
+		return false, err
+	}
+	return res.MatchedCount > 0, nil
+}
+
 func (dbService *UserDBService) CountRecentlyCreatedUsers(instanceID string, interval int64) (count int64, err error) {
 	ctx, cancel := dbService.getContext()
 	defer cancel()
@@ -236,52 +250,111 @@ func (dbService *UserDBService) CountRecentlyCreatedUsers(instanceID string, int
 	return
 }
 
-func (dbService *UserDBService) DeleteUser(instanceID string, id string) error {
+func (dbService *UserDBService) CountUsers(instanceID string) (count int64, err error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	count, err = dbService.collectionRefUsers(instanceID).CountDocuments(ctx, bson.M{})
+	return
+}
+
+func (dbService *UserDBService) deleteUser(ctx context.Context, instanceID string, id string) error {
 	_id, _ := primitive.ObjectIDFromHex(id)
 	filter := bson.M{"_id": _id}
 
-	ctx, cancel := dbService.getContext()
-	defer cancel()
 	res, err := dbService.collectionRefUsers(instanceID).DeleteOne(ctx, filter, nil)
 	if err != nil {
 		return err
 	}
 	if res.DeletedCount < 1 {
-		return errors.New("no user found with the given id")
+		return ErrUserNotFound
 	}
 	return nil
 }
 
-func (dbService *UserDBService) DeleteUnverfiedUsers(instanceID string, createdBefore int64) (int64, error) {
+// notErasedFilter leaves out the accounts whose erasure started already and the anonymized ones
+var notErasedFilter = bson.M{
+	"account.erasureStartedAt": bson.M{"$exists": false},
+	"account.anonymizedAt":     bson.M{"$exists": false},
+}
+
+// unverifiedUsersFilter matches the accounts not confirmed since their creation before createdBefore
+func unverifiedUsersFilter(createdBefore int64) bson.M {
 	filter := bson.M{}
 	filter["$and"] = bson.A{
 		bson.M{"account.accountConfirmedAt": 0},
 		bson.M{"timestamps.createdAt": bson.M{"$lt": createdBefore}},
+		notErasedFilter,
 	}
+	return filter
+}
+
+// deletionDueFilter matches the accounts whose requested deletion is scheduled before the given time
+func deletionDueFilter(before int64) bson.M {
+	filter := bson.M{}
+	filter["$and"] = bson.A{
+		bson.M{"account.deletionScheduledAt": bson.M{"$gt": 0}},
+		bson.M{"account.deletionScheduledAt": bson.M{"$lt": before}},
+		notErasedFilter,
+	}
+	return filter
+}
+
+// markedForDeletionFilter matches the inactive accounts to delete before the given time
+func markedForDeletionFilter(before int64) bson.M {
+	filter := bson.M{}
+	filter["$and"] = bson.A{
+		bson.M{"timestamps.markedForDeletion": bson.M{"$gt": 0}},
+		bson.M{"timestamps.markedForDeletion": bson.M{"$lt": before}},
+		notErasedFilter,
+	}
+	return filter
+}
 
+// FindUnverifiedUsers returns the accounts not confirmed since their creation before createdBefore, which are
+// erased by the cleanup job
+func (dbService *UserDBService) FindUnverifiedUsers(instanceID string, createdBefore int64) (users []models.User, err error) {
 	ctx, cancel := dbService.getContext()
 	defer cancel()
-	res, err := dbService.collectionRefUsers(instanceID).DeleteMany(ctx, filter, nil)
+
+	cur, err := dbService.collectionRefUsers(instanceID).Find(ctx, unverifiedUsersFilter(createdBefore))
 	if err != nil {
-		return 0, err
+		return users, err
 	}
+	defer cur.Close(ctx)
 
-	return res.DeletedCount, nil
+	users = []models.User{}
+	if err := cur.All(ctx, &users); err != nil {
+		return users, err
+	}
+	return users, dbService.decryptUsers(users)
 }
 
-func (dbService *UserDBService) FindUsersMarkedForDeletion(instanceID string) (users []models.User, err error) {
+// FindUsersWithDeletionDue returns the accounts whose requested deletion is scheduled before the given time
+func (dbService *UserDBService) FindUsersWithDeletionDue(instanceID string, before int64) (users []models.User, err error) {
 	ctx, cancel := dbService.getContext()
 	defer cancel()
 
-	filter := bson.M{}
-	filter["$and"] = bson.A{
-		bson.M{"timestamps.markedForDeletion": bson.M{"$gt": 0}},
-		bson.M{"timestamps.markedForDeletion": bson.M{"$lt": time.Now().Unix()}},
+	cur, err := dbService.collectionRefUsers(instanceID).Find(ctx, deletionDueFilter(before))
+	if err != nil {
+		return users, err
 	}
+	defer cur.Close(ctx)
+
+	users = []models.User{}
+	if err := cur.All(ctx, &users); err != nil {
+		return users, err
+	}
+	return users, dbService.decryptUsers(users)
+}
+
+func (dbService *UserDBService) FindUsersMarkedForDeletion(instanceID string) (users []models.User, err error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
 
 	cur, err := dbService.collectionRefUsers(instanceID).Find(
 		ctx,
-		filter,
+		markedForDeletionFilter(time.Now().Unix()),
 	)
 
 	if err != nil {
@@ -291,8 +364,7 @@ func (dbService *UserDBService) FindUsersMarkedForDeletion(instanceID string) (u
 
 	users = []models.User{}
 	for cur.Next(ctx) {
-		var result models.User
-		err := cur.Decode(&result)
+		result, err := dbService.decodeUser(cur)
 		if err != nil {
 			return users, err
 		}
@@ -329,8 +401,39 @@ func (dbService *UserDBService) FindNonParticipantUsers(instanceID string) (user
 
 	users = []models.User{}
 	for cur.Next(ctx) {
-		var result models.User
-		err := cur.Decode(&result)
+		result, err := dbService.decodeUser(cur)
+		if err != nil {
+			return users, err
+		}
+
+		users = append(users, result)
+	}
+	if err := cur.Err(); err != nil {
+		return users, err
+	}
+
+	return users, nil
+}
+
+// FindProvisionedUsers returns the users created by the provisioning client, the ones it can manage
+func (dbService *UserDBService) FindProvisionedUsers(instanceID string, clientName string) (users []models.User, err error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{"account.provisionedBy": clientName}
+	cur, err := dbService.collectionRefUsers(instanceID).Find(
+		ctx,
+		filter,
+	)
+
+	if err != nil {
+		return users, err
+	}
+	defer cur.Close(ctx)
+
+	users = []models.User{}
+	for cur.Next(ctx) {
+		result, err := dbService.decodeUser(cur)
 		if err != nil {
 			return users, err
 		}
@@ -360,6 +463,7 @@ func (dbService *UserDBService) FindInactiveUsers(instanceID string, dT int64) (
 		bson.M{"timestamps.lastLogin": bson.M{"$lt": time.Now().Unix() - dT}},
 		bson.M{"timestamps.lastTokenRefresh": bson.M{"$lt": time.Now().Unix() - dT}},
 		bson.M{"timestamps.markedForDeletion": bson.M{"$not": bson.M{"$gt": 0}}},
+		notErasedFilter,
 	}
 
 	cur, err := dbService.collectionRefUsers(instanceID).Find(
@@ -374,8 +478,7 @@ func (dbService *UserDBService) FindInactiveUsers(instanceID string, dT int64) (
 
 	users = []models.User{}
 	for cur.Next(ctx) {
-		var result models.User
-		err := cur.Decode(&result)
+		result, err := dbService.decodeUser(cur)
 		if err != nil {
 			return users, err
 		}
@@ -401,13 +504,18 @@ func (dbService *UserDBService) PerfomActionForUsers(
 	cbk func(instanceID string, user models.User, args ...interface{}) error,
 	args ...interface{},
 ) (err error) {
-	filter := bson.M{}
+	// accounts waiting for their deletion and erased accounts don't receive messages anymore
+	conditions := bson.A{
+		bson.M{"account.deletionScheduledAt": bson.M{"$not": bson.M{"$gt": 0}}},
+		notErasedFilter,
+	}
 	if filters.OnlyConfirmed {
-		filter["account.accountConfirmedAt"] = bson.M{"$gt": 0}
+		conditions = append(conditions, bson.M{"account.accountConfirmedAt": bson.M{"$gt": 0}})
 	}
 	if filters.ReminderWeekDay > -1 {
-		filter["contactPreferences.receiveWeeklyMessageDayOfWeek"] = filters.ReminderWeekDay
+		conditions = append(conditions, bson.M{"contactPreferences.receiveWeeklyMessageDayOfWeek": filters.ReminderWeekDay})
 	}
+	filter := bson.M{"$and": conditions}
 
 	batchSize := int32(32)
 	options := options.FindOptions{
@@ -430,10 +538,9 @@ func (dbService *UserDBService) PerfomActionForUsers(
 			logger.Debug.Println(ctx.Err())
 			return ctx.Err()
 		}
-		var result models.User
-		err := cur.Decode(&result)
+		result, err := dbService.decodeUser(cur)
 		if err != nil {
-			logger.Error.Printf("wrong user model %v, %v", result, err)
+			logger.Error.Printf("wrong user model %v, %v", result.ID, err)
 			continue
 		}
 
@@ -460,6 +567,7 @@ func (dbService *UserDBService) SendReminderToConfirmAccountLoop(
 		bson.M{"account.accountConfirmedAt": bson.M{"$lt": 1}},
 		bson.M{"timestamps.reminderToConfirmSentAt": bson.M{"$lt": 1}},
 		bson.M{"timestamps.createdAt": bson.M{"$lt": createdBefore}},
+		notErasedFilter,
 	}
 
 	batchSize := int32(32)
@@ -483,22 +591,17 @@ func (dbService *UserDBService) SendReminderToConfirmAccountLoop(
 			logger.Debug.Println(ctx.Err())
 			return ctx.Err()
 		}
-		var result models.User
-		err := cur.Decode(&result)
+		result, err := dbService.decodeUser(cur)
 		if err != nil {
-			logger.Error.Printf("wrong user model %v, %v", result, err)
+			logger.Error.Printf("wrong user model %v, %v", result.ID, err)
 			continue
 		}
 
+		// the callback records that the reminder was sent
 		if err := cbk(instanceID, result, args...); err != nil {
 			logger.Debug.Printf("error in callback: %v", err)
 			continue
 		}
-
-		if err := dbService.UpdateReminderToConfirmSentAtTime(instanceID, result.ID.Hex()); err != nil {
-			logger.Error.Printf("unexpected error: %v", err)
-			continue
-		}
 	}
 	if err := cur.Err(); err != nil {
 		return err
@@ -522,6 +625,18 @@ func (dbService *UserDBService) CreateIndexForUser(instanceID string) error {
 					{Key: "account.accountID", Value: 1},
 				},
 			},
+			{
+				Keys: bson.D{
+					{Key: "account.accountIDIndex", Value: 1},
+				},
+				Options: options.Index().SetSparse(true),
+			},
+			{
+				Keys: bson.D{
+					{Key: "account.deletionScheduledAt", Value: 1},
+				},
+				Options: options.Index().SetSparse(true),
+			},
 			{
 				Keys: bson.D{
 					{Key: "timestamps.createdAt", Value: 1},
@@ -538,6 +653,14 @@ func (dbService *UserDBService) CreateIndexForUser(instanceID string) error {
 					{Key: "contactPreferences.receiveWeeklyMessageDayOfWeek", Value: 1},
 				},
 			},
+			{
+				Keys: bson.D{
+					{Key: "federatedIdentities.issuer", Value: 1},
+					{Key: "federatedIdentities.subject", Value: 1},
+				},
+				// an identity can only be linked to one account
+				Options: options.Index().SetUnique(true).SetSparse(true),
+			},
 		},
 	)
 	return err
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/coneno/logger"
+	messageAPI "github.com/influenzanet/messaging-service/pkg/api/messaging_service"
 	"github.com/influenzanet/user-management-service/pkg/models"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
@@ -202,22 +203,6 @@ func TestDbInterfaceMethods(t *testing.T) {
 			t.Error("at least one user should be found")
 		}
 	})
-
-	t.Run("Testing deleting existing user", func(t *testing.T) {
-		err := testDBService.DeleteUser(testInstanceID, testUser.ID.Hex())
-		if err != nil {
-			t.Errorf(err.Error())
-			return
-		}
-	})
-
-	t.Run("Testing deleting not existing user", func(t *testing.T) {
-		err := testDBService.DeleteUser(testInstanceID, testUser.ID.Hex()+"1")
-		if err == nil {
-			t.Errorf("user should not be found - error expected")
-			return
-		}
-	})
 }
 
 func TestDbPerformActionForUsers(t *testing.T) {
@@ -268,6 +253,40 @@ func TestDbPerformActionForUsers(t *testing.T) {
 	if err != nil {
 		t.Errorf("unexpected error: %v", err)
 	}
+
+	t.Run("skip accounts pending deletion and erased accounts", func(t *testing.T) {
+		skippedUsers := []models.User{
+			{Account: models.Account{AccountID: "action_pending_deletion", DeletionScheduledAt: time.Now().Unix() + 100}},
+			{Account: models.Account{AccountID: "action_erasure_started", ErasureStartedAt: time.Now().Unix()}},
+			{Account: models.Account{AccountID: "action_anonymized", AnonymizedAt: time.Now().Unix()}},
+		}
+		for _, u := range skippedUsers {
+			if _, err := testDBService.AddUser(testInstanceID, u); err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+		}
+
+		count := 0
+		err := testDBService.PerfomActionForUsers(
+			ctx,
+			testInstanceID,
+			UserFilter{ReminderWeekDay: -1},
+			func(instanceID string, user models.User, args ...interface{}) error {
+				if user.Account.IsPendingDeletion() || user.Account.IsAnonymized() {
+					t.Errorf("unexpected user: %s", user.Account.AccountID)
+				}
+				count++
+				return nil
+			},
+		)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if count < len(testUsers) {
+			t.Errorf("users missing: %d found", count)
+		}
+	})
 }
 
 func AssertNumberOfNonParticipantUsers(instanceID string, count int) error {
@@ -281,7 +300,15 @@ func AssertNumberOfNonParticipantUsers(instanceID string, count int) error {
 	return nil
 }
 
-func TestDeleteUnverfiedUsers(t *testing.T) {
+// removeUnverifiedUsers deletes the unverified users left by other tests
+func removeUnverifiedUsers(instanceID string, createdBefore int64) error {
+	ctx, cancel := testDBService.getContext()
+	defer cancel()
+	_, err := testDBService.collectionRefUsers(instanceID).DeleteMany(ctx, unverifiedUsersFilter(createdBefore))
+	return err
+}
+
+func TestFindUnverifiedUsers(t *testing.T) {
 	testUsers := []models.User{
 		{Account: models.Account{AccountID: "delete_1"}, Roles: []string{"RESEARCHER"}, Timestamps: models.Timestamps{CreatedAt: time.Now().Unix() - 100}},
 		{Account: models.Account{AccountID: "delete_2"}, Roles: []string{"RESEARCHER"}, Timestamps: models.Timestamps{CreatedAt: time.Now().Unix() - 50}},
@@ -295,12 +322,11 @@ func TestDeleteUnverfiedUsers(t *testing.T) {
 	}
 
 	t.Run("remove any other user not in the test set", func(t *testing.T) {
-		count, err := testDBService.DeleteUnverfiedUsers(testInstanceID, time.Now().Unix()-105)
+		err := removeUnverifiedUsers(testInstanceID, time.Now().Unix()-105)
 		if err != nil {
 			t.Errorf("unexpected error: %v", err)
 			return
 		}
-		logger.Debug.Printf("deleted %d users", count)
 		err = AssertNumberOfNonParticipantUsers(testInstanceID, 3)
 		if err != nil {
 			t.Errorf("unexpected error: %v", err)
@@ -308,31 +334,14 @@ func TestDeleteUnverfiedUsers(t *testing.T) {
 		}
 	})
 
-	t.Run("remove 1 user", func(t *testing.T) {
-		count, err := testDBService.DeleteUnverfiedUsers(testInstanceID, time.Now().Unix()-55)
+	t.Run("find the user to remove", func(t *testing.T) {
+		users, err := testDBService.FindUnverifiedUsers(testInstanceID, time.Now().Unix()-55)
 		if err != nil {
 			t.Errorf("unexpected error: %v", err)
 			return
 		}
-		logger.Debug.Printf("deleted %d users", count)
-		err = AssertNumberOfNonParticipantUsers(testInstanceID, 2)
-		if err != nil {
-			t.Errorf("unexpected error: %v", err)
-			return
-		}
-	})
-
-	t.Run("remove an other user", func(t *testing.T) {
-		count, err := testDBService.DeleteUnverfiedUsers(testInstanceID, time.Now().Unix()-15)
-		if err != nil {
-			t.Errorf("unexpected error: %v", err)
-			return
-		}
-		logger.Debug.Printf("deleted %d users", count)
-		err = AssertNumberOfNonParticipantUsers(testInstanceID, 1)
-		if err != nil {
-			t.Errorf("unexpected error: %v", err)
-			return
+		if len(users) != 1 || users[0].Account.AccountID != "delete_1" {
+			t.Errorf("unexpected users: %v", users)
 		}
 	})
 }
@@ -366,12 +375,10 @@ func TestFindInactiveUsers(t *testing.T) {
 	}
 
 	t.Run("remove any other user not in the test set", func(t *testing.T) {
-		count, err := testDBService.DeleteUnverfiedUsers(testInstanceID, time.Now().Unix()-105)
-		if err != nil {
+		if err := removeUnverifiedUsers(testInstanceID, time.Now().Unix()-105); err != nil {
 			t.Errorf("unexpected error: %v", err)
 			return
 		}
-		logger.Debug.Printf("deleted %d users", count)
 	})
 
 	t.Run("Testing finding inactive users", func(t *testing.T) {
@@ -521,3 +528,144 @@ func TestFindInactiveUsers(t *testing.T) {
 		}
 	})
 }
+
+func TestGetUserByFederatedIdentity(t *testing.T) {
+	testUser := models.User{
+		Account: models.Account{
+			Type:      "email",
+			AccountID: "test-federated@test.com",
+		},
+		FederatedIdentities: []models.FederatedIdentity{
+			{Issuer: "https://idp.test.com", Subject: "sub-1", LinkedAt: time.Now().Unix()},
+		},
+	}
+	_, err := testDBService.AddUser(testInstanceID, testUser)
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+
+	t.Run("with linked identity", func(t *testing.T) {
+		user, err := testDBService.GetUserByFederatedIdentity(testInstanceID, "https://idp.test.com", "sub-1")
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if user.Account.AccountID != testUser.Account.AccountID {
+			t.Errorf("found user is not matching test user")
+		}
+	})
+
+	t.Run("with subject from other issuer", func(t *testing.T) {
+		_, err := testDBService.GetUserByFederatedIdentity(testInstanceID, "https://other-idp.test.com", "sub-1")
+		if err == nil {
+			t.Error("user should not be found")
+		}
+	})
+}
+
+func TestFindProvisionedUsers(t *testing.T) {
+	instanceID := "provisioned-users"
+	testUsers := []models.User{
+		{Account: models.Account{Type: "email", AccountID: "p1@test.com"}, Roles: []string{"PARTICIPANT"}},
+		{Account: models.Account{Type: "email", AccountID: "p2@test.com"}, Roles: []string{"RESEARCHER"}},
+		{Account: models.Account{Type: "email", AccountID: "p3@test.com", ProvisionedBy: "directory"}, Roles: []string{}},
+		{Account: models.Account{Type: "email", AccountID: "p4@test.com", ProvisionedBy: "directory"}, Roles: []string{"ADMIN"}},
+		{Account: models.Account{Type: "email", AccountID: "p5@test.com", ProvisionedBy: "other-directory"}, Roles: []string{}},
+	}
+	for _, u := range testUsers {
+		if _, err := testDBService.AddUser(instanceID, u); err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+	}
+
+	users, err := testDBService.FindProvisionedUsers(instanceID, "directory")
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+	if len(users) != 2 {
+		t.Errorf("wrong number of users found: %d instead of 2", len(users))
+	}
+	for _, u := range users {
+		if u.Account.ProvisionedBy != "directory" {
+			t.Errorf("only users of the client should be returned: %s", u.Account.AccountID)
+		}
+	}
+}
+
+func TestAccountDeletion(t *testing.T) {
+	instanceID := "account-deletion"
+	id, err := testDBService.AddUser(instanceID, models.User{
+		Account: models.Account{Type: "email", AccountID: "deletion@test.com"},
+	})
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+	email := models.NewOutgoingEmail(models.OUTGOING_EMAIL_INSTANT, &messageAPI.SendEmailReq{
+		To:          []string{"deletion@test.com"},
+		MessageType: "account-deleted",
+	})
+	now := time.Now().Unix()
+
+	t.Run("schedule and restore", func(t *testing.T) {
+		if err := testDBService.ScheduleAccountDeletionWithOutgoingEmails(instanceID, id, now+10, []models.OutgoingEmail{email}); err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if err := testDBService.ScheduleAccountDeletionWithOutgoingEmails(instanceID, id, now+10, nil); err == nil {
+			t.Error("deletion should not be scheduled twice")
+		}
+		users, err := testDBService.FindUsersWithDeletionDue(instanceID, now)
+		if err != nil || len(users) != 0 {
+			t.Errorf("deletion should not be due yet: %v %v", users, err)
+		}
+		restored, err := testDBService.CancelAccountDeletion(instanceID, id)
+		if err != nil || !restored {
+			t.Errorf("unexpected result: %v %v", restored, err)
+		}
+		if restored, _ := testDBService.CancelAccountDeletion(instanceID, id); restored {
+			t.Error("account without scheduled deletion should not be restored")
+		}
+	})
+
+	t.Run("start erasure when due", func(t *testing.T) {
+		user, err := testDBService.GetUserByID(instanceID, id)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		erasure := models.NewErasure(user, "test", "account-deleted", "")
+		if _, err := testDBService.StartErasure(instanceID, erasure, UsersWithDeletionDue(now+20), time.Minute); err != ErrUserChanged {
+			t.Errorf("restored account should not be erased: %v", err)
+		}
+		if err := testDBService.ScheduleAccountDeletionWithOutgoingEmails(instanceID, id, now+10, nil); err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		users, err := testDBService.FindUsersWithDeletionDue(instanceID, now+20)
+		if err != nil || len(users) != 1 || users[0].Account.DeletionScheduledAt != now+10 {
+			t.Errorf("unexpected result: %v %v", users, err)
+			return
+		}
+		if _, err := testDBService.StartErasure(instanceID, erasure, UsersWithDeletionDue(now+20), time.Minute); err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if restored, _ := testDBService.CancelAccountDeletion(instanceID, id); restored {
+			t.Error("account being erased should not be restored")
+		}
+		users, err = testDBService.FindUsersWithDeletionDue(instanceID, now+20)
+		if err != nil || len(users) != 0 {
+			t.Errorf("account being erased should not be found again: %v %v", users, err)
+		}
+		if err := testDBService.DeleteUserWithOutgoingEmails(instanceID, id, []models.OutgoingEmail{email}); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if _, err := testDBService.GetUserByID(instanceID, id); err == nil {
+			t.Error("user should not exist")
+		}
+	})
+}
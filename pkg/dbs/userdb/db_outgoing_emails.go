@@ -0,0 +1,323 @@
+package userdb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/influenzanet/user-management-service/pkg/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// CreateIndexForOutgoingEmails creates the index to find due emails and the TTL index removing sent ones
+func (dbService *UserDBService) CreateIndexForOutgoingEmails(instanceID string, sentEmailsTTL time.Duration) error {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	_, err := dbService.collectionOutgoingEmails(instanceID).Indexes().CreateMany(
+		ctx, []mongo.IndexModel{
+			{
+				Keys: bson.D{
+					{Key: "status", Value: 1},
+					{Key: "nextAttemptAt", Value: 1},
+				},
+			},
+			{
+				Keys:    bson.D{{Key: "sentAt", Value: 1}},
+				Options: options.Index().SetExpireAfterSeconds(int32(sentEmailsTTL.Seconds())),
+			},
+		},
+	)
+	return err
+}
+
+func (dbService *UserDBService) AddOutgoingEmail(instanceID string, email models.OutgoingEmail) error {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+	return dbService.addOutgoingEmails(ctx, instanceID, []models.OutgoingEmail{email})
+}
+
+func (dbService *UserDBService) addOutgoingEmails(ctx context.Context, instanceID string, emails []models.OutgoingEmail) error {
+	if len(emails) < 1 {
+		return nil
+	}
+	docs := make([]interface{}, len(emails))
+	for i, e := range emails {
+		encrypted, err := dbService.encryptOutgoingEmail(e)
+		if err != nil {
+			return err
+		}
+		docs[i] = encrypted
+	}
+	_, err := dbService.collectionOutgoingEmails(instanceID).InsertMany(ctx, docs)
+	return err
+}
+
+// AddUserWithOutgoingEmails creates the user and queues the emails in the same transaction
+func (dbService *UserDBService) AddUserWithOutgoingEmails(instanceID string, user models.User, emails []models.OutgoingEmail) (id string, err error) {
+	err = dbService.withTransaction(func(ctx context.Context) error {
+		var err error
+		id, err = dbService.addUser(ctx, instanceID, user)
+		if err != nil {
+			return err
+		}
+		return dbService.addOutgoingEmails(ctx, instanceID, emails)
+	})
+	return
+}
+
+// DeleteUserWithOutgoingEmails deletes the user and queues the emails in the same transaction
+func (dbService *UserDBService) DeleteUserWithOutgoingEmails(instanceID string, id string, emails []models.OutgoingEmail) error {
+	return dbService.withTransaction(func(ctx context.Context) error {
+		if err := dbService.deleteUser(ctx, instanceID, id); err != nil {
+			return err
+		}
+		return dbService.addOutgoingEmails(ctx, instanceID, emails)
+	})
+}
+
+// AnonymizeUserWithOutgoingEmails removes the personal data of the user and queues the emails in the same
+// transaction. The profiles are kept, the account ID is replaced by accountID. The erasure mark is removed, so that the
+// account can still be deleted later.
+func (dbService *UserDBService) AnonymizeUserWithOutgoingEmails(instanceID string, id string, accountID string, emails []models.OutgoingEmail) error {
+	return dbService.withTransaction(func(ctx context.Context) error {
+		_id, _ := primitive.ObjectIDFromHex(id)
+		now := time.Now().Unix()
+		update := bson.M{
+			"$set": bson.M{
+				"account.accountID":                         accountID,
+				"account.password":                          "",
+				"account.authType":                          "",
+				"account.verificationCode":                  models.VerificationCode{},
+				"account.preferredLanguage":                 "",
+				"account.failedLoginAttempts":               []int64{},
+				"account.passwordResetTriggers":             []int64{},
+				"account.anonymizedAt":                      now,
+				"profiles.$[].alias":                        "",
+				"contactInfos":                              []models.ContactInfo{},
+				"contactPreferences.sendNewsletterTo":       []string{},
+				"contactPreferences.subscribedToNewsletter": false,
+				"contactPreferences.subscribedToWeekly":     false,
+				"timestamps.markedForDeletion":              0,
+				"timestamps.updatedAt":                      now,
+			},
+			"$unset": bson.M{
+				// the blind index would still find the account by its former ID
+				"account.accountIDIndex":      "",
+				"account.provisionedBy":       "",
+				"account.deletionScheduledAt": "",
+				"account.erasureStartedAt":    "",
+				"federatedIdentities":         "",
+			},
+		}
+		res, err := dbService.collectionRefUsers(instanceID).UpdateOne(ctx, bson.M{"_id": _id}, update)
+		if err != nil {
+			return err
+		}
+		if res.MatchedCount < 1 {
+			return ErrUserNotFound
+		}
+		return dbService.addOutgoingEmails(ctx, instanceID, emails)
+	})
+}
+
+// ScheduleAccountDeletionWithOutgoingEmails sets when the account is deleted and queues the emails in the same
+// transaction. It fails if the deletion was already scheduled.
+func (dbService *UserDBService) ScheduleAccountDeletionWithOutgoingEmails(instanceID string, id string, deleteAt int64, emails []models.OutgoingEmail) error {
+	return dbService.withTransaction(func(ctx context.Context) error {
+		_id, _ := primitive.ObjectIDFromHex(id)
+		filter := bson.M{"_id": _id, "account.deletionScheduledAt": bson.M{"$not": bson.M{"$gt": 0}}}
+		update := bson.M{"$set": bson.M{
+			"account.deletionScheduledAt": deleteAt,
+			"timestamps.updatedAt":        time.Now().Unix(),
+		}}
+		res, err := dbService.collectionRefUsers(instanceID).UpdateOne(ctx, filter, update)
+		if err != nil {
+			return err
+		}
+		if res.MatchedCount < 1 {
+			return errors.New("no user found with the given id or deletion already scheduled")
+		}
+		return dbService.addOutgoingEmails(ctx, instanceID, emails)
+	})
+}
+
+// MarkReminderToConfirmSentWithOutgoingEmails records that the reminder to confirm the account was sent and queues
+// the emails in the same transaction
+func (dbService *UserDBService) MarkReminderToConfirmSentWithOutgoingEmails(ctx context.Context, instanceID string, id string, emails []models.OutgoingEmail) error {
+	return dbService.withTransactionContext(ctx, func(ctx context.Context) error {
+		_id, _ := primitive.ObjectIDFromHex(id)
+		update := bson.M{"$set": bson.M{"timestamps.reminderToConfirmSentAt": time.Now().Unix()}}
+		res, err := dbService.collectionRefUsers(instanceID).UpdateOne(ctx, bson.M{"_id": _id}, update)
+		if err != nil {
+			return err
+		}
+		if res.MatchedCount < 1 {
+			return ErrUserNotFound
+		}
+		return dbService.addOutgoingEmails(ctx, instanceID, emails)
+	})
+}
+
+// MarkForDeletionWithOutgoingEmails sets when the inactive account is deleted, dT seconds from now, and queues the
+// emails in the same transaction. It returns false without queuing the emails if the account was already marked.
+func (dbService *UserDBService) MarkForDeletionWithOutgoingEmails(ctx context.Context, instanceID string, id string, dT int64, emails []models.OutgoingEmail) (marked bool, err error) {
+	err = dbService.withTransactionContext(ctx, func(ctx context.Context) error {
+		marked = false
+		_id, _ := primitive.ObjectIDFromHex(id)
+		filter := bson.M{
+			"_id":                          _id,
+			"timestamps.markedForDeletion": bson.M{"$not": bson.M{"$gt": 0}},
+		}
+		update := bson.M{"$set": bson.M{"timestamps.markedForDeletion": time.Now().Unix() + dT}}
+		res, err := dbService.collectionRefUsers(instanceID).UpdateOne(ctx, filter, update)
+		if err != nil {
+			return err
+		}
+		if res.MatchedCount < 1 {
+			return nil
+		}
+		marked = true
+		return dbService.addOutgoingEmails(ctx, instanceID, emails)
+	})
+	return
+}
+
+// ClaimOutgoingEmail returns the oldest pending email due for delivery, postpones its next attempt by lease and
+// sets a new claim ID, so that other service instances won't send it at the same time. Returns
+// mongo.ErrNoDocuments if none is due.
+func (dbService *UserDBService) ClaimOutgoingEmail(instanceID string, now int64, lease time.Duration) (email models.OutgoingEmail, err error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{
+		"status":        models.OUTGOING_EMAIL_STATUS_PENDING,
+		"nextAttemptAt": bson.M{"$lte": now},
+	}
+	update := bson.M{"$set": bson.M{
+		"nextAttemptAt": now + int64(lease.Seconds()),
+		"claimID":       primitive.NewObjectID().Hex(),
+	}}
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.D{{Key: "nextAttemptAt", Value: 1}, {Key: "createdAt", Value: 1}}).
+		SetReturnDocument(options.After)
+	if err = dbService.collectionOutgoingEmails(instanceID).FindOneAndUpdate(ctx, filter, update, opts).Decode(&email); err != nil {
+		return
+	}
+	err = dbService.decryptOutgoingEmail(&email)
+	return
+}
+
+// MarkOutgoingEmailSent records the delivery, if the email is still owned by the claim
+func (dbService *UserDBService) MarkOutgoingEmailSent(instanceID string, email models.OutgoingEmail) error {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	now := time.Now()
+	update := bson.M{
+		"$set": bson.M{
+			"status":        models.OUTGOING_EMAIL_STATUS_SENT,
+			"sentAt":        now,
+			"lastAttemptAt": now.Unix(),
+		},
+		"$inc":   bson.M{"attempts": 1},
+		"$unset": bson.M{"claimID": ""},
+	}
+	return dbService.updateClaimedOutgoingEmail(ctx, instanceID, email, update)
+}
+
+// MarkOutgoingEmailFailed records the failed attempt. With deadLetter, the email is not retried anymore.
+func (dbService *UserDBService) MarkOutgoingEmailFailed(instanceID string, email models.OutgoingEmail, nextAttemptAt int64, reason string, deadLetter bool) error {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	set := bson.M{
+		"nextAttemptAt": nextAttemptAt,
+		"lastAttemptAt": time.Now().Unix(),
+		"lastError":     reason,
+	}
+	if deadLetter {
+		set["status"] = models.OUTGOING_EMAIL_STATUS_FAILED
+	}
+	update := bson.M{
+		"$set":   set,
+		"$inc":   bson.M{"attempts": 1},
+		"$unset": bson.M{"claimID": ""},
+	}
+	return dbService.updateClaimedOutgoingEmail(ctx, instanceID, email, update)
+}
+
+func (dbService *UserDBService) updateClaimedOutgoingEmail(ctx context.Context, instanceID string, email models.OutgoingEmail, update bson.M) error {
+	filter := bson.M{"_id": email.ID, "claimID": email.ClaimID}
+	res, err := dbService.collectionOutgoingEmails(instanceID).UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount < 1 {
+		return errors.New("email claimed by another delivery attempt")
+	}
+	return nil
+}
+
+// FindFailedOutgoingEmails returns the dead-lettered emails, latest first
+func (dbService *UserDBService) FindFailedOutgoingEmails(instanceID string, limit int64) (emails []models.OutgoingEmail, err error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{"status": models.OUTGOING_EMAIL_STATUS_FAILED}
+	opts := options.Find().SetSort(bson.D{{Key: "lastAttemptAt", Value: -1}})
+	if limit > 0 {
+		opts.SetLimit(limit)
+	}
+	cur, err := dbService.collectionOutgoingEmails(instanceID).Find(ctx, filter, opts)
+	if err != nil {
+		return emails, err
+	}
+	defer cur.Close(ctx)
+
+	emails = []models.OutgoingEmail{}
+	if err = cur.All(ctx, &emails); err != nil {
+		return emails, err
+	}
+	for i := range emails {
+		if err = dbService.decryptOutgoingEmail(&emails[i]); err != nil {
+			return emails, err
+		}
+	}
+	return emails, nil
+}
+
+// ResetFailedOutgoingEmail puts a dead-lettered email back into the outbox for immediate delivery
+func (dbService *UserDBService) ResetFailedOutgoingEmail(instanceID string, id string) error {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	_id, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+	filter := bson.M{"_id": _id, "status": models.OUTGOING_EMAIL_STATUS_FAILED}
+	update := bson.M{"$set": bson.M{
+		"status":        models.OUTGOING_EMAIL_STATUS_PENDING,
+		"attempts":      0,
+		"nextAttemptAt": time.Now().Unix(),
+	}}
+	res, err := dbService.collectionOutgoingEmails(instanceID).UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount < 1 {
+		return errors.New("no failed email found with the given id")
+	}
+	return nil
+}
+
+func (dbService *UserDBService) CountOutgoingEmails(instanceID string, status string) (int64, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	return dbService.collectionOutgoingEmails(instanceID).CountDocuments(ctx, bson.M{"status": status})
+}
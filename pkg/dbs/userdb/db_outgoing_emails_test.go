@@ -0,0 +1,124 @@
+package userdb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	messageAPI "github.com/influenzanet/messaging-service/pkg/api/messaging_service"
+	"github.com/influenzanet/user-management-service/pkg/models"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestDbInterfaceMethodsForOutgoingEmails(t *testing.T) {
+	if err := testDBService.CreateIndexForOutgoingEmails(testInstanceID, time.Hour); err != nil {
+		t.Errorf("unexpected error: %s", err.Error())
+		return
+	}
+
+	user := models.User{
+		Account: models.Account{
+			Type:      "email",
+			AccountID: "outbox_user@test.com",
+		},
+	}
+	email := models.NewOutgoingEmail(models.OUTGOING_EMAIL_INSTANT, &messageAPI.SendEmailReq{
+		To:          []string{user.Account.AccountID},
+		MessageType: "invitation",
+	})
+	now := email.NextAttemptAt
+
+	t.Run("Add user with email", func(t *testing.T) {
+		id, err := testDBService.AddUserWithOutgoingEmails(testInstanceID, user, []models.OutgoingEmail{email})
+		if err != nil || id == "" {
+			t.Errorf("unexpected result: %s %v", id, err)
+			return
+		}
+		if _, err := testDBService.AddUserWithOutgoingEmails(testInstanceID, user, []models.OutgoingEmail{email}); err == nil {
+			t.Error("existing user should not be created")
+		}
+		count, err := testDBService.CountOutgoingEmails(testInstanceID, models.OUTGOING_EMAIL_STATUS_PENDING)
+		if err != nil || count != 1 {
+			t.Errorf("unexpected result: %d %v", count, err)
+		}
+	})
+
+	t.Run("Claim, fail and dead-letter email", func(t *testing.T) {
+		claimed, err := testDBService.ClaimOutgoingEmail(testInstanceID, now, time.Minute)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if _, err := testDBService.ClaimOutgoingEmail(testInstanceID, now, time.Minute); err != mongo.ErrNoDocuments {
+			t.Errorf("claimed email should not be claimed again: %v", err)
+		}
+		stale := claimed
+		stale.ClaimID = "other"
+		if err := testDBService.MarkOutgoingEmailSent(testInstanceID, stale); err == nil {
+			t.Error("stale claim should not mark the email")
+		}
+		if err := testDBService.MarkOutgoingEmailFailed(testInstanceID, claimed, now, "unavailable", true); err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+		}
+
+		failed, err := testDBService.FindFailedOutgoingEmails(testInstanceID, 10)
+		if err != nil || len(failed) != 1 || failed[0].LastError != "unavailable" || failed[0].Attempts != 1 {
+			t.Errorf("unexpected result: %v %v", failed, err)
+		}
+	})
+
+	t.Run("Resend and deliver email", func(t *testing.T) {
+		failed, err := testDBService.FindFailedOutgoingEmails(testInstanceID, 10)
+		if err != nil || len(failed) != 1 {
+			t.Errorf("unexpected result: %v %v", failed, err)
+			return
+		}
+		if err := testDBService.ResetFailedOutgoingEmail(testInstanceID, failed[0].ID.Hex()); err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if err := testDBService.ResetFailedOutgoingEmail(testInstanceID, failed[0].ID.Hex()); err == nil {
+			t.Error("pending email should not be reset")
+		}
+
+		claimed, err := testDBService.ClaimOutgoingEmail(testInstanceID, time.Now().Unix(), time.Minute)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if err := testDBService.MarkOutgoingEmailSent(testInstanceID, claimed); err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+		}
+		count, err := testDBService.CountOutgoingEmails(testInstanceID, models.OUTGOING_EMAIL_STATUS_SENT)
+		if err != nil || count != 1 {
+			t.Errorf("unexpected result: %d %v", count, err)
+		}
+	})
+
+	t.Run("Update user with email", func(t *testing.T) {
+		id, err := testDBService.AddUser(testInstanceID, models.User{Account: models.Account{Type: "email", AccountID: "outbox_inactive@test.com"}})
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		ctx := context.Background()
+		marked, err := testDBService.MarkForDeletionWithOutgoingEmails(ctx, testInstanceID, id, 100, []models.OutgoingEmail{email})
+		if err != nil || !marked {
+			t.Errorf("unexpected result: %v %v", marked, err)
+		}
+		marked, err = testDBService.MarkForDeletionWithOutgoingEmails(ctx, testInstanceID, id, 100, []models.OutgoingEmail{email})
+		if err != nil || marked {
+			t.Errorf("marked user should not be marked again: %v %v", marked, err)
+		}
+		if err := testDBService.MarkReminderToConfirmSentWithOutgoingEmails(ctx, testInstanceID, id, []models.OutgoingEmail{email}); err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+		}
+		if err := testDBService.MarkReminderToConfirmSentWithOutgoingEmails(ctx, testInstanceID, "000000000000000000000000", []models.OutgoingEmail{email}); err != ErrUserNotFound {
+			t.Errorf("unexpected error: %v", err)
+		}
+		count, err := testDBService.CountOutgoingEmails(testInstanceID, models.OUTGOING_EMAIL_STATUS_PENDING)
+		if err != nil || count != 2 {
+			t.Errorf("unexpected result: %d %v", count, err)
+		}
+	})
+}
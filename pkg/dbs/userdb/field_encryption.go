@@ -0,0 +1,260 @@
+package userdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/coneno/logger"
+	"github.com/influenzanet/user-management-service/pkg/fieldcrypt"
+	"github.com/influenzanet/user-management-service/pkg/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var errNoFieldKeys = errors.New("user holds encrypted fields, but field encryption is not enabled")
+
+// EnableFieldEncryption makes the service encrypt the account ID and the contact emails of the users it writes, and
+// decrypt them when reading. Users written before are still read and found by their account ID, until the
+// encrypt-user-fields tool encrypted them.
+func (dbService *UserDBService) EnableFieldEncryption(keys *fieldcrypt.KeyRing) {
+	dbService.fieldKeys = keys
+}
+
+// FieldEncryptionEnabled tells if the account ID and the contact emails are encrypted
+func (dbService *UserDBService) FieldEncryptionEnabled() bool {
+	return dbService.fieldKeys != nil
+}
+
+type decoder interface {
+	Decode(val interface{}) error
+}
+
+// decodeUser decodes a single result or the current document of a cursor, and decrypts the fields of the user
+func (dbService *UserDBService) decodeUser(doc decoder) (user models.User, err error) {
+	if err = doc.Decode(&user); err != nil {
+		return
+	}
+	err = dbService.decryptUser(&user)
+	return
+}
+
+func (dbService *UserDBService) decryptUsers(users []models.User) error {
+	for i := range users {
+		if err := dbService.decryptUser(&users[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// accountIDFilter matches the user with the account ID, through the blind index computed with any of the keys or,
+// for users not encrypted yet, through the plaintext
+func (dbService *UserDBService) accountIDFilter(accountID string) bson.M {
+	if dbService.fieldKeys == nil {
+		return bson.M{"account.accountID": accountID}
+	}
+	return bson.M{"$or": bson.A{
+		bson.M{"account.accountIDIndex": bson.M{"$in": dbService.fieldKeys.BlindIndexes(accountID)}},
+		bson.M{"account.accountID": accountID},
+	}}
+}
+
+// encryptAccountID returns the value to store as account ID and its blind index, empty without field encryption
+func (dbService *UserDBService) encryptAccountID(accountID string) (value string, index string, err error) {
+	if dbService.fieldKeys == nil {
+		return accountID, "", nil
+	}
+	value, err = dbService.fieldKeys.Encrypt(accountID)
+	return value, dbService.fieldKeys.BlindIndex(accountID), err
+}
+
+// encryptUser returns a copy of the user with the account ID and the contact emails encrypted, and their blind
+// indexes set
+func (dbService *UserDBService) encryptUser(user models.User) (models.User, error) {
+	if dbService.fieldKeys == nil {
+		return user, nil
+	}
+	var err error
+	user.Account.AccountID, user.Account.AccountIDIndex, err = dbService.encryptAccountID(user.Account.AccountID)
+	if err != nil {
+		return user, err
+	}
+	if user.ContactInfos == nil {
+		return user, nil
+	}
+	contactInfos := make([]models.ContactInfo, len(user.ContactInfos))
+	for i, c := range user.ContactInfos {
+		c.EmailIndex = dbService.fieldKeys.BlindIndex(c.Email)
+		if c.Email, err = dbService.fieldKeys.Encrypt(c.Email); err != nil {
+			return user, err
+		}
+		contactInfos[i] = c
+	}
+	user.ContactInfos = contactInfos
+	return user, nil
+}
+
+// decryptUser replaces the encrypted fields by their plaintext, the blind indexes are cleared
+func (dbService *UserDBService) decryptUser(user *models.User) (err error) {
+	user.Account.AccountID, err = dbService.decrypt(user.Account.AccountID)
+	if err != nil {
+		return err
+	}
+	user.Account.AccountIDIndex = ""
+	for i := range user.ContactInfos {
+		if user.ContactInfos[i].Email, err = dbService.decrypt(user.ContactInfos[i].Email); err != nil {
+			return err
+		}
+		user.ContactInfos[i].EmailIndex = ""
+	}
+	return nil
+}
+
+// encryptOutgoingEmail returns a copy of the email with the recipients encrypted
+func (dbService *UserDBService) encryptOutgoingEmail(email models.OutgoingEmail) (models.OutgoingEmail, error) {
+	if dbService.fieldKeys == nil {
+		return email, nil
+	}
+	to := make([]string, len(email.To))
+	for i, addr := range email.To {
+		var err error
+		if to[i], err = dbService.fieldKeys.Encrypt(addr); err != nil {
+			return email, err
+		}
+	}
+	email.To = to
+	return email, nil
+}
+
+func (dbService *UserDBService) decryptOutgoingEmail(email *models.OutgoingEmail) (err error) {
+	for i := range email.To {
+		if email.To[i], err = dbService.decrypt(email.To[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// encryptErasure returns a copy of the erasure with the account ID encrypted
+func (dbService *UserDBService) encryptErasure(erasure models.Erasure) (models.Erasure, error) {
+	if dbService.fieldKeys == nil {
+		return erasure, nil
+	}
+	var err error
+	erasure.AccountID, err = dbService.fieldKeys.Encrypt(erasure.AccountID)
+	return erasure, err
+}
+
+func (dbService *UserDBService) decryptErasure(erasure *models.Erasure) (err error) {
+	erasure.AccountID, err = dbService.decrypt(erasure.AccountID)
+	return err
+}
+
+func (dbService *UserDBService) decrypt(value string) (string, error) {
+	if dbService.fieldKeys == nil {
+		if fieldcrypt.IsEncrypted(value) {
+			return "", errNoFieldKeys
+		}
+		return value, nil
+	}
+	return dbService.fieldKeys.Decrypt(value)
+}
+
+// EncryptUserFields encrypts the account ID and the contact emails of the users stored in plaintext or with another
+// key than the active one, and computes their blind indexes with the active key. A user changed while it is processed
+// is skipped, the next run encrypts it. It returns the number of users to encrypt, they are only counted if commit is
+// false.
+func (dbService *UserDBService) EncryptUserFields(ctx context.Context, instanceID string, commit bool) (count int64, err error) {
+	if dbService.fieldKeys == nil {
+		return 0, errors.New("field encryption is not enabled")
+	}
+
+	batchSize := int32(32)
+	opts := options.FindOptions{
+		NoCursorTimeout: &dbService.noCursorTimeout,
+		BatchSize:       &batchSize,
+	}
+	cur, err := dbService.collectionRefUsers(instanceID).Find(ctx, bson.M{}, &opts)
+	if err != nil {
+		return 0, err
+	}
+	defer cur.Close(ctx)
+
+	for cur.Next(ctx) {
+		stored := models.User{}
+		if err := cur.Decode(&stored); err != nil {
+			return count, err
+		}
+		user := stored
+		user.ContactInfos = append([]models.ContactInfo(nil), stored.ContactInfos...)
+		if err := dbService.decryptUser(&user); err != nil {
+			return count, fmt.Errorf("user %s: %v", stored.ID.Hex(), err)
+		}
+		if !dbService.needsEncryption(stored, user) {
+			continue
+		}
+		if !commit {
+			count++
+			continue
+		}
+
+		encrypted, err := dbService.encryptUser(user)
+		if err != nil {
+			return count, err
+		}
+		updated, err := dbService.replaceEncryptedFields(instanceID, stored, encrypted)
+		if err != nil {
+			return count, err
+		}
+		if !updated {
+			logger.Warning.Printf("%s: user %s changed while it was encrypted, skipped", instanceID, stored.ID.Hex())
+			continue
+		}
+		count++
+	}
+	return count, cur.Err()
+}
+
+// needsEncryption tells if a field of the stored user is in plaintext, encrypted with another key than the active
+// one, or has a blind index computed with another key
+func (dbService *UserDBService) needsEncryption(stored models.User, plain models.User) bool {
+	active := dbService.fieldKeys.ActiveKey()
+	outdated := func(value string, index string, plaintext string) bool {
+		if plaintext == "" {
+			return false
+		}
+		return fieldcrypt.KeyID(value) != active || index != dbService.fieldKeys.BlindIndex(plaintext)
+	}
+	if outdated(stored.Account.AccountID, stored.Account.AccountIDIndex, plain.Account.AccountID) {
+		return true
+	}
+	for i, c := range stored.ContactInfos {
+		if outdated(c.Email, c.EmailIndex, plain.ContactInfos[i].Email) {
+			return true
+		}
+	}
+	return false
+}
+
+// replaceEncryptedFields writes the encrypted fields, unless the user changed since it was read
+func (dbService *UserDBService) replaceEncryptedFields(instanceID string, stored models.User, encrypted models.User) (bool, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{
+		"_id":                  stored.ID,
+		"account.accountID":    stored.Account.AccountID,
+		"timestamps.updatedAt": stored.Timestamps.UpdatedAt,
+	}
+	update := bson.M{"$set": bson.M{
+		"account.accountID":      encrypted.Account.AccountID,
+		"account.accountIDIndex": encrypted.Account.AccountIDIndex,
+		"contactInfos":           encrypted.ContactInfos,
+	}}
+	res, err := dbService.collectionRefUsers(instanceID).UpdateOne(ctx, filter, update)
+	if err != nil {
+		return false, err
+	}
+	return res.MatchedCount > 0, nil
+}
@@ -0,0 +1,184 @@
+package userdb
+
+import (
+	"context"
+	b64 "encoding/base64"
+	"strings"
+	"testing"
+	"time"
+
+	messageAPI "github.com/influenzanet/messaging-service/pkg/api/messaging_service"
+	"github.com/influenzanet/user-management-service/pkg/fieldcrypt"
+	"github.com/influenzanet/user-management-service/pkg/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestFieldEncryption(t *testing.T) {
+	// own instance, so that the users of the other tests stay in plaintext
+	instanceID := testInstanceID + "_fieldcrypt"
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		testDBService.DBClient.Database(testDBNamePrefix + instanceID + "_users").Drop(ctx)
+	}()
+
+	key1 := b64.StdEncoding.EncodeToString([]byte(strings.Repeat("1", 32)))
+	key2 := b64.StdEncoding.EncodeToString([]byte(strings.Repeat("2", 32)))
+	keys, err := fieldcrypt.NewKeyRing(fieldcrypt.KeyFile{ActiveKey: "k1", Keys: map[string]string{"k1": key1}})
+	if err != nil {
+		t.Errorf("unexpected error: %s", err.Error())
+		return
+	}
+	encrypted := *testDBService
+	encrypted.EnableFieldEncryption(keys)
+
+	newUser := func(accountID string) models.User {
+		return models.User{
+			Account: models.Account{Type: "email", AccountID: accountID},
+			ContactInfos: []models.ContactInfo{
+				{ID: primitive.NewObjectID(), Type: "email", Email: accountID},
+			},
+			Timestamps: models.Timestamps{CreatedAt: time.Now().Unix()},
+		}
+	}
+	storedUser := func(id string) (user models.User) {
+		_id, _ := primitive.ObjectIDFromHex(id)
+		ctx, cancel := testDBService.getContext()
+		defer cancel()
+		if err := testDBService.collectionRefUsers(instanceID).FindOne(ctx, bson.M{"_id": _id}).Decode(&user); err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+		}
+		return
+	}
+
+	plainID, err := testDBService.AddUser(instanceID, newUser("plain@test.com"))
+	if err != nil {
+		t.Errorf("unexpected error: %s", err.Error())
+		return
+	}
+	var encryptedID string
+
+	t.Run("write encrypted user", func(t *testing.T) {
+		encryptedID, err = encrypted.AddUser(instanceID, newUser("encrypted@test.com"))
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		stored := storedUser(encryptedID)
+		if fieldcrypt.KeyID(stored.Account.AccountID) != "k1" || fieldcrypt.KeyID(stored.ContactInfos[0].Email) != "k1" {
+			t.Errorf("fields should be encrypted: %v", stored)
+		}
+		if stored.Account.AccountIDIndex == "" || stored.ContactInfos[0].EmailIndex == "" {
+			t.Errorf("blind indexes should be set: %v", stored)
+		}
+		if _, err := encrypted.AddUser(instanceID, newUser("encrypted@test.com")); err == nil {
+			t.Error("user should already exist")
+		}
+	})
+
+	t.Run("read users", func(t *testing.T) {
+		user, err := encrypted.GetUserByAccountID(instanceID, "encrypted@test.com")
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if user.Account.AccountID != "encrypted@test.com" || user.ContactInfos[0].Email != "encrypted@test.com" {
+			t.Errorf("fields should be decrypted: %v", user)
+		}
+		user, err = encrypted.GetUserByAccountID(instanceID, "plain@test.com")
+		if err != nil || user.ID.Hex() != plainID {
+			t.Errorf("user in plaintext should be found: %v", err)
+		}
+		if _, err := testDBService.GetUserByID(instanceID, encryptedID); err == nil {
+			t.Error("encrypted user should not be read without field encryption")
+		}
+	})
+
+	t.Run("encrypt users in plaintext", func(t *testing.T) {
+		count, err := encrypted.EncryptUserFields(context.Background(), instanceID, false)
+		if err != nil || count != 1 {
+			t.Errorf("unexpected result: %d %v", count, err)
+			return
+		}
+		if stored := storedUser(plainID); stored.Account.AccountID != "plain@test.com" {
+			t.Error("user should not be changed without commit")
+		}
+		count, err = encrypted.EncryptUserFields(context.Background(), instanceID, true)
+		if err != nil || count != 1 {
+			t.Errorf("unexpected result: %d %v", count, err)
+			return
+		}
+		if stored := storedUser(plainID); fieldcrypt.KeyID(stored.Account.AccountID) != "k1" {
+			t.Errorf("user should be encrypted: %v", stored)
+		}
+		user, err := encrypted.GetUserByAccountID(instanceID, "plain@test.com")
+		if err != nil || user.ID.Hex() != plainID {
+			t.Errorf("encrypted user should be found: %v", err)
+		}
+	})
+
+	t.Run("encrypt copies of the account ID", func(t *testing.T) {
+		email := models.NewOutgoingEmail(models.OUTGOING_EMAIL_INSTANT, &messageAPI.SendEmailReq{
+			To:          []string{"encrypted@test.com"},
+			MessageType: "test",
+		})
+		if err := encrypted.AddOutgoingEmail(instanceID, email); err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		ctx, cancel := testDBService.getContext()
+		defer cancel()
+		stored := models.OutgoingEmail{}
+		if err := testDBService.collectionOutgoingEmails(instanceID).FindOne(ctx, bson.M{}).Decode(&stored); err != nil || fieldcrypt.KeyID(stored.To[0]) != "k1" {
+			t.Errorf("recipient should be encrypted: %v %v", stored, err)
+		}
+		claimed, err := encrypted.ClaimOutgoingEmail(instanceID, time.Now().Unix(), time.Minute)
+		if err != nil || claimed.To[0] != "encrypted@test.com" {
+			t.Errorf("recipient should be decrypted: %v %v", claimed, err)
+		}
+
+		user, err := encrypted.GetUserByID(instanceID, encryptedID)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if _, err := encrypted.StartErasure(instanceID, models.NewErasure(user, "test", "", ""), nil, time.Minute); err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		storedErasure := models.Erasure{}
+		if err := testDBService.collectionErasures(instanceID).FindOne(ctx, bson.M{"userID": encryptedID}).Decode(&storedErasure); err != nil || fieldcrypt.KeyID(storedErasure.AccountID) != "k1" {
+			t.Errorf("account ID of the erasure should be encrypted: %v %v", storedErasure, err)
+		}
+		erasure, err := encrypted.GetErasureForUser(instanceID, encryptedID)
+		if err != nil || erasure.AccountID != "encrypted@test.com" {
+			t.Errorf("account ID of the erasure should be decrypted: %v %v", erasure, err)
+		}
+	})
+
+	t.Run("rotate key", func(t *testing.T) {
+		rotatedKeys, err := fieldcrypt.NewKeyRing(fieldcrypt.KeyFile{ActiveKey: "k2", Keys: map[string]string{"k1": key1, "k2": key2}})
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		rotated := *testDBService
+		rotated.EnableFieldEncryption(rotatedKeys)
+
+		if _, err := rotated.GetUserByAccountID(instanceID, "encrypted@test.com"); err != nil {
+			t.Errorf("user of the previous key should be found: %v", err)
+		}
+		count, err := rotated.EncryptUserFields(context.Background(), instanceID, true)
+		if err != nil || count != 2 {
+			t.Errorf("unexpected result: %d %v", count, err)
+			return
+		}
+		if stored := storedUser(encryptedID); fieldcrypt.KeyID(stored.Account.AccountID) != "k2" || stored.Account.AccountIDIndex != rotatedKeys.BlindIndex("encrypted@test.com") {
+			t.Errorf("user should be encrypted with the active key: %v", stored)
+		}
+		if _, err := rotated.GetUserByAccountID(instanceID, "encrypted@test.com"); err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+		}
+	})
+}
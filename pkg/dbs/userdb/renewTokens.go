@@ -94,6 +94,27 @@ func (dbService *UserDBService) CreateRenewToken(instanceID string, userID strin
 	return err
 }
 
+// FindActiveRenewTokensForUser returns the renew tokens of the user's sessions, the ones not expired nor replaced yet
+func (dbService *UserDBService) FindActiveRenewTokensForUser(instanceID string, userID string) (tokens []RenewToken, err error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{
+		"userID":    userID,
+		"expiresAt": bson.M{"$gt": time.Now().Unix()},
+		"nextToken": bson.M{"$exists": false},
+	}
+	cur, err := dbService.collectionRenewTokens(instanceID).Find(ctx, filter)
+	if err != nil {
+		return tokens, err
+	}
+	defer cur.Close(ctx)
+
+	tokens = []RenewToken{}
+	err = cur.All(ctx, &tokens)
+	return
+}
+
 func (dbService *UserDBService) FindAndUpdateRenewToken(instanceID string, userID string, renewToken string, nextToken string) (rtObj RenewToken, err error) {
 	ctx, cancel := dbService.getContext()
 	defer cancel()
@@ -79,4 +79,22 @@ func TestRenewTokenDBMethods(t *testing.T) {
 		}
 	})
 
+	t.Run("Testing finding active renew tokens", func(t *testing.T) {
+		tokenValue := "TEST_RENEW_TOKEN_ACTIVE"
+		err := testDBService.CreateRenewToken(testInstanceID, testToken.UserID, tokenValue, time.Now().Unix()+1000)
+		if err != nil {
+			t.Errorf(err.Error())
+			return
+		}
+
+		tokens, err := testDBService.FindActiveRenewTokensForUser(testInstanceID, testToken.UserID)
+		if err != nil {
+			t.Errorf(err.Error())
+			return
+		}
+		if len(tokens) != 1 || tokens[0].RenewToken != tokenValue {
+			t.Errorf("replaced and expired tokens should not be returned: %v", tokens)
+		}
+	})
+
 }
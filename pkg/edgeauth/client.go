@@ -0,0 +1,43 @@
+package edgeauth
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// UnaryClientInterceptor authenticates the requests the HTTP gateway forwards to the gRPC server
+func UnaryClientInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	if err := authenticate(md, req); err != nil {
+		return err
+	}
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+// StreamClientInterceptor authenticates the requests sent on the streams of the HTTP gateway
+func StreamClientInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	// check the header before opening the stream, the requests are only sent afterwards
+	if _, err := TokenInfos(md); err != nil {
+		return nil, err
+	}
+	cs, err := streamer(ctx, desc, cc, method, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &clientStream{ClientStream: cs, md: md}, nil
+}
+
+type clientStream struct {
+	grpc.ClientStream
+	md metadata.MD
+}
+
+func (s *clientStream) SendMsg(m interface{}) error {
+	if err := authenticate(s.md, m); err != nil {
+		return err
+	}
+	return s.ClientStream.SendMsg(m)
+}
@@ -0,0 +1,69 @@
+// Package edgeauth authenticates the users calling the API through the public endpoints, the HTTP gateway and
+// gRPC-Web. Their token infos are taken from the access token in the Authorization header and replace the ones sent
+// in the request, so that clients can't claim another user or roles.
+package edgeauth
+
+import (
+	"strings"
+
+	api_types "github.com/influenzanet/go-utils/pkg/api_types"
+	"github.com/influenzanet/user-management-service/pkg/tokens"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// authorizationKey is the metadata key of the Authorization header, forwarded by both endpoints
+const authorizationKey = "authorization"
+
+var tokenInfosName = (&api_types.TokenInfos{}).ProtoReflect().Descriptor().FullName()
+
+// TokenInfos validates the bearer access token in the metadata, nil if there is none
+func TokenInfos(md metadata.MD) (*api_types.TokenInfos, error) {
+	values := md.Get(authorizationKey)
+	if len(values) == 0 {
+		return nil, nil
+	}
+	if len(values) > 1 {
+		return nil, status.Error(codes.Unauthenticated, "multiple authorization headers")
+	}
+	if len(values[0]) < 7 || !strings.EqualFold(values[0][:7], "Bearer ") {
+		return nil, status.Error(codes.Unauthenticated, "bearer token expected")
+	}
+	claims, valid, err := tokens.ValidateToken(strings.TrimSpace(values[0][7:]))
+	if err != nil || !valid {
+		return nil, status.Error(codes.Unauthenticated, "invalid access token")
+	}
+	return claims.TokenInfos(), nil
+}
+
+// SetTokenInfos replaces the token infos of the request, they are cleared if infos is nil. Requests without token
+// infos are left as they are.
+func SetTokenInfos(req interface{}, infos *api_types.TokenInfos) {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return
+	}
+	m := msg.ProtoReflect()
+	fd := m.Descriptor().Fields().ByName("token")
+	if fd == nil || fd.Message() == nil || fd.Message().FullName() != tokenInfosName {
+		return
+	}
+	if infos == nil {
+		m.Clear(fd)
+		return
+	}
+	m.Set(fd, protoreflect.ValueOfMessage(infos.ProtoReflect()))
+}
+
+// authenticate sets the token infos of the request from the access token in the metadata
+func authenticate(md metadata.MD, req interface{}) error {
+	infos, err := TokenInfos(md)
+	if err != nil {
+		return err
+	}
+	SetTokenInfos(req, infos)
+	return nil
+}
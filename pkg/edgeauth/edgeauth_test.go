@@ -0,0 +1,83 @@
+package edgeauth
+
+import (
+	b64 "encoding/base64"
+	"strings"
+	"testing"
+	"time"
+
+	api_types "github.com/influenzanet/go-utils/pkg/api_types"
+	"github.com/influenzanet/user-management-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/tokens"
+	"google.golang.org/grpc/metadata"
+)
+
+func testAccessToken(t *testing.T) string {
+	t.Setenv("JWT_TOKEN_KEY", b64.StdEncoding.EncodeToString([]byte(strings.Repeat("k", 32))))
+	token, err := tokens.GenerateNewToken("user-1", true, "profile-1", []string{"PARTICIPANT"}, "inst", time.Minute, "", nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+func TestTokenInfos(t *testing.T) {
+	token := testAccessToken(t)
+
+	t.Run("without header", func(t *testing.T) {
+		infos, err := TokenInfos(metadata.MD{})
+		if err != nil || infos != nil {
+			t.Errorf("unexpected result: %v %v", infos, err)
+		}
+	})
+
+	t.Run("with valid token", func(t *testing.T) {
+		infos, err := TokenInfos(metadata.Pairs("authorization", "Bearer "+token))
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if infos.Id != "user-1" || infos.InstanceId != "inst" || infos.Payload["roles"] != "PARTICIPANT" {
+			t.Errorf("unexpected token infos: %v", infos)
+		}
+	})
+
+	for name, value := range map[string]string{
+		"with invalid token": "Bearer " + token + "x",
+		"without bearer":     token,
+	} {
+		t.Run(name, func(t *testing.T) {
+			if _, err := TokenInfos(metadata.Pairs("authorization", value)); err == nil {
+				t.Error("should be rejected")
+			}
+		})
+	}
+}
+
+func TestSetTokenInfos(t *testing.T) {
+	claimed := &api_types.TokenInfos{Id: "admin", InstanceId: "inst", Payload: map[string]string{"roles": "ADMIN"}}
+
+	t.Run("replace", func(t *testing.T) {
+		req := &api.UserReference{Token: claimed, UserId: "user-2"}
+		SetTokenInfos(req, &api_types.TokenInfos{Id: "user-1", InstanceId: "inst"})
+		if req.Token.Id != "user-1" || req.Token.Payload["roles"] != "" || req.UserId != "user-2" {
+			t.Errorf("unexpected request: %v", req)
+		}
+	})
+
+	t.Run("clear", func(t *testing.T) {
+		req := &api.UserReference{Token: claimed}
+		SetTokenInfos(req, nil)
+		if req.Token != nil {
+			t.Errorf("token infos should be cleared: %v", req)
+		}
+	})
+
+	t.Run("request without token infos", func(t *testing.T) {
+		req := &api.JWTRequest{Token: "value"}
+		SetTokenInfos(req, nil)
+		if req.Token != "value" {
+			t.Errorf("request should not be changed: %v", req)
+		}
+	})
+}
@@ -0,0 +1,57 @@
+package edgeauth
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+type edgeRequestKey struct{}
+
+// WithEdgeRequest marks the requests of a public endpoint served in-process, e.g. gRPC-Web, whose token infos are
+// set by the server interceptors
+func WithEdgeRequest(ctx context.Context) context.Context {
+	return context.WithValue(ctx, edgeRequestKey{}, true)
+}
+
+func isEdgeRequest(ctx context.Context) bool {
+	edge, _ := ctx.Value(edgeRequestKey{}).(bool)
+	return edge
+}
+
+// UnaryServerInterceptor authenticates the requests marked with WithEdgeRequest, others are left as they are
+func UnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if !isEdgeRequest(ctx) {
+		return handler(ctx, req)
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	if err := authenticate(md, req); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// StreamServerInterceptor authenticates the requests received on streams marked with WithEdgeRequest
+func StreamServerInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if !isEdgeRequest(ss.Context()) {
+		return handler(srv, ss)
+	}
+	md, _ := metadata.FromIncomingContext(ss.Context())
+	if _, err := TokenInfos(md); err != nil {
+		return err
+	}
+	return handler(srv, &serverStream{ServerStream: ss, md: md})
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	md metadata.MD
+}
+
+func (s *serverStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return authenticate(s.md, m)
+}
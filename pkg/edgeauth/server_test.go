@@ -0,0 +1,58 @@
+package edgeauth
+
+import (
+	"context"
+	"testing"
+
+	api_types "github.com/influenzanet/go-utils/pkg/api_types"
+	"github.com/influenzanet/user-management-service/pkg/api"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	token := testAccessToken(t)
+	info := &grpc.UnaryServerInfo{FullMethod: "/test/GetUser"}
+	var received *api.UserReference
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		received = req.(*api.UserReference)
+		return nil, nil
+	}
+	newReq := func() *api.UserReference {
+		return &api.UserReference{Token: &api_types.TokenInfos{Id: "admin", Payload: map[string]string{"roles": "ADMIN"}}}
+	}
+
+	t.Run("internal request", func(t *testing.T) {
+		if _, err := UnaryServerInterceptor(context.Background(), newReq(), info, handler); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if received.Token.Id != "admin" {
+			t.Errorf("token infos of internal requests should be kept: %v", received)
+		}
+	})
+
+	t.Run("edge request without access token", func(t *testing.T) {
+		if _, err := UnaryServerInterceptor(WithEdgeRequest(context.Background()), newReq(), info, handler); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if received.Token != nil {
+			t.Errorf("token infos should be dropped: %v", received)
+		}
+	})
+
+	t.Run("edge request with access token", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(WithEdgeRequest(context.Background()), metadata.Pairs("authorization", "Bearer "+token))
+		if _, err := UnaryServerInterceptor(ctx, newReq(), info, handler); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if received.Token.Id != "user-1" || received.Token.Payload["roles"] != "PARTICIPANT" {
+			t.Errorf("token infos should be set from the access token: %v", received)
+		}
+
+		received = nil
+		ctx = metadata.NewIncomingContext(WithEdgeRequest(context.Background()), metadata.Pairs("authorization", "Bearer "+token+"x"))
+		if _, err := UnaryServerInterceptor(ctx, newReq(), info, handler); err == nil || received != nil {
+			t.Error("invalid access token should be rejected")
+		}
+	})
+}
@@ -0,0 +1,114 @@
+package emailoutbox
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/coneno/logger"
+	messageAPI "github.com/influenzanet/messaging-service/pkg/api/messaging_service"
+	"github.com/influenzanet/user-management-service/pkg/dbs/userdb"
+	"github.com/influenzanet/user-management-service/pkg/instances"
+	"github.com/influenzanet/user-management-service/pkg/metrics"
+	"github.com/influenzanet/user-management-service/pkg/models"
+	"github.com/influenzanet/user-management-service/pkg/outbox"
+)
+
+// MaxAttempts before an email is dead-lettered, which takes about three hours with the retry delays
+const MaxAttempts = 10
+
+// SentEmailsTTL is how long sent emails are kept in the outbox
+const SentEmailsTTL = 7 * 24 * time.Hour
+
+var outboxConfig = outbox.Config{
+	Name:             "email outbox",
+	DispatchInterval: 5 * time.Second,
+	DeliveryTimeout:  10 * time.Second,
+	ClaimLease:       time.Minute,
+	MinRetryDelay:    30 * time.Second,
+	MaxRetryDelay:    time.Hour,
+	MaxAttempts:      MaxAttempts,
+}
+
+// Dispatcher hands the outbox emails of the instances over to the messaging service
+type Dispatcher struct {
+	client        messageAPI.MessagingServiceApiClient
+	userDBService *userdb.UserDBService
+	instances     *instances.Registry
+}
+
+// NewDispatcher creates a dispatcher sending with client, which must not be an OutboxClient. The emails of
+// disabled instances stay in their outbox.
+func NewDispatcher(client messageAPI.MessagingServiceApiClient, userDBService *userdb.UserDBService, instances *instances.Registry) *Dispatcher {
+	return &Dispatcher{
+		client:        client,
+		userDBService: userDBService,
+		instances:     instances,
+	}
+}
+
+// Run sends due emails until ctx is done
+func (d *Dispatcher) Run(ctx context.Context) {
+	outbox.Run(ctx, outboxConfig, func() []outbox.Queue[models.OutgoingEmail] {
+		queues := []outbox.Queue[models.OutgoingEmail]{}
+		for _, instanceID := range d.instances.IDs() {
+			queues = append(queues, queue{Dispatcher: d, instanceID: instanceID})
+		}
+		return queues
+	})
+}
+
+// queue is the outbox of an instance's user DB
+type queue struct {
+	*Dispatcher
+	instanceID string
+}
+
+func (q queue) Claim(now int64, lease time.Duration) (models.OutgoingEmail, error) {
+	return q.userDBService.ClaimOutgoingEmail(q.instanceID, now, lease)
+}
+
+func (q queue) Deliver(ctx context.Context, email models.OutgoingEmail) error {
+	req := email.ToSendEmailReq(q.instanceID)
+	var err error
+	switch email.Delivery {
+	case models.OUTGOING_EMAIL_QUEUED:
+		_, err = q.client.QueueEmailTemplateForSending(ctx, req)
+	case models.OUTGOING_EMAIL_INSTANT:
+		_, err = q.client.SendInstantEmail(ctx, req)
+	default:
+		err = errors.New("unknown delivery: " + email.Delivery)
+	}
+	return err
+}
+
+func (q queue) MarkDelivered(email models.OutgoingEmail) error {
+	return q.userDBService.MarkOutgoingEmailSent(q.instanceID, email)
+}
+
+func (q queue) MarkFailed(email models.OutgoingEmail, nextAttemptAt int64, reason string, deadLetter bool) error {
+	return q.userDBService.MarkOutgoingEmailFailed(q.instanceID, email, nextAttemptAt, reason, deadLetter)
+}
+
+func (q queue) Describe(email models.OutgoingEmail) string {
+	return email.MessageType + " email " + email.ID.Hex()
+}
+
+func (q queue) Attempts(email models.OutgoingEmail) int {
+	return email.Attempts
+}
+
+func (q queue) DeliveryFailed() {
+	metrics.EmailDeliveryFailed(q.instanceID)
+}
+
+func (q queue) UpdateBacklog() {
+	for _, status := range []string{models.OUTGOING_EMAIL_STATUS_PENDING, models.OUTGOING_EMAIL_STATUS_FAILED} {
+		count, err := q.userDBService.CountOutgoingEmails(q.instanceID, status)
+		if err != nil {
+			logger.Error.Printf("email outbox: %v", err)
+			return
+		}
+		metrics.SetEmailOutboxCount(q.instanceID, status, count)
+	}
+}
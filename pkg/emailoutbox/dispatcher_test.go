@@ -0,0 +1,18 @@
+package emailoutbox
+
+import (
+	"testing"
+	"time"
+
+	"github.com/influenzanet/user-management-service/pkg/utils"
+)
+
+func TestTimeBeforeDeadLetter(t *testing.T) {
+	total := time.Duration(0)
+	for attempts := 1; attempts < MaxAttempts; attempts++ {
+		total += utils.RetryDelay(attempts, outboxConfig.MinRetryDelay, outboxConfig.MaxRetryDelay)
+	}
+	if total < 2*time.Hour || total > 4*time.Hour {
+		t.Errorf("emails should be retried for about three hours, not %s", total)
+	}
+}
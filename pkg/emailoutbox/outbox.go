@@ -0,0 +1,53 @@
+// Package emailoutbox hands emails over to the messaging service through an outbox collection of
+// each instance's user DB, so that emails are retried while the messaging service is unavailable.
+package emailoutbox
+
+import (
+	"context"
+
+	"github.com/coneno/logger"
+	messageAPI "github.com/influenzanet/messaging-service/pkg/api/messaging_service"
+	"github.com/influenzanet/user-management-service/pkg/dbs/userdb"
+	"github.com/influenzanet/user-management-service/pkg/models"
+	"google.golang.org/grpc"
+)
+
+// OutboxClient is a messaging service client storing email requests in the outbox instead of sending them.
+// Other calls go to the messaging service directly.
+type OutboxClient struct {
+	messageAPI.MessagingServiceApiClient
+	userDBService *userdb.UserDBService
+}
+
+// NewOutboxClient wraps the client of the messaging service
+func NewOutboxClient(client messageAPI.MessagingServiceApiClient, userDBService *userdb.UserDBService) *OutboxClient {
+	return &OutboxClient{
+		MessagingServiceApiClient: client,
+		userDBService:             userDBService,
+	}
+}
+
+// SendInstantEmail queues the email for delivery. If the outbox can't be written, the email is sent directly.
+func (c *OutboxClient) SendInstantEmail(ctx context.Context, in *messageAPI.SendEmailReq, opts ...grpc.CallOption) (*messageAPI.ServiceStatus, error) {
+	if err := c.userDBService.AddOutgoingEmail(in.InstanceId, models.NewOutgoingEmail(models.OUTGOING_EMAIL_INSTANT, in)); err != nil {
+		logger.Error.Printf("email outbox: failed to queue %s email, sending it directly: %v", in.MessageType, err)
+		return c.MessagingServiceApiClient.SendInstantEmail(ctx, in, opts...)
+	}
+	return queuedStatus(), nil
+}
+
+// QueueEmailTemplateForSending queues the email for delivery. If the outbox can't be written, the email is sent directly.
+func (c *OutboxClient) QueueEmailTemplateForSending(ctx context.Context, in *messageAPI.SendEmailReq, opts ...grpc.CallOption) (*messageAPI.ServiceStatus, error) {
+	if err := c.userDBService.AddOutgoingEmail(in.InstanceId, models.NewOutgoingEmail(models.OUTGOING_EMAIL_QUEUED, in)); err != nil {
+		logger.Error.Printf("email outbox: failed to queue %s email, sending it directly: %v", in.MessageType, err)
+		return c.MessagingServiceApiClient.QueueEmailTemplateForSending(ctx, in, opts...)
+	}
+	return queuedStatus(), nil
+}
+
+func queuedStatus() *messageAPI.ServiceStatus {
+	return &messageAPI.ServiceStatus{
+		Status: messageAPI.ServiceStatus_NORMAL,
+		Msg:    "email queued",
+	}
+}
@@ -0,0 +1,285 @@
+// Package erasure removes accounts together with their data in the other services. Every deletion of an account
+// goes through it, so that none of the steps is forgotten. The progress is saved after each step, an erasure whose
+// step keeps failing is resumed later. An erasure can anonymize the account instead, the profiles referenced by study
+// responses are then kept.
+package erasure
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/coneno/logger"
+	"github.com/influenzanet/go-utils/pkg/api_types"
+	loggingAPI "github.com/influenzanet/logging-service/pkg/api"
+	messageAPI "github.com/influenzanet/messaging-service/pkg/api/messaging_service"
+	"github.com/influenzanet/user-management-service/pkg/dbs/globaldb"
+	"github.com/influenzanet/user-management-service/pkg/dbs/userdb"
+	"github.com/influenzanet/user-management-service/pkg/models"
+	"github.com/influenzanet/user-management-service/pkg/utils"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const (
+	// a failing step is tried again right away before the erasure is left for a later run
+	stepAttempts   = 3
+	stepRetryDelay = 2 * time.Second
+
+	// claimLease is how long a run owns an erasure before another run may resume it
+	claimLease = 10 * time.Minute
+
+	minRetryDelay = 5 * time.Minute
+	maxRetryDelay = 24 * time.Hour
+	// MaxAttempts is the number of runs after which the erasure is marked as failed
+	MaxAttempts = 10
+
+	// FinishedErasuresTTL is how long finished erasures are kept
+	FinishedErasuresTTL = 7 * 24 * time.Hour
+
+	// LogEventAccountAnonymized is saved for anonymized accounts
+	LogEventAccountAnonymized = "ACCOUNT ANONYMIZED"
+)
+
+// Request describes the erasure of a user
+type Request struct {
+	User        models.User
+	RequestedBy string
+	// EmailType is sent to the account when it is deleted, no email if empty
+	EmailType string
+	// LogEvent is saved for the user once it is deleted, no event if empty
+	LogEvent string
+	// Filter makes the erasure start only if the user still matches it, e.g. is still unverified
+	Filter userdb.ErasureFilter
+	// Anonymize removes the personal data of the user instead of deleting it, the study service keeps the data of
+	// the profiles
+	Anonymize bool
+}
+
+type Eraser struct {
+	userDBService   *userdb.UserDBService
+	globalDBService *globaldb.GlobalDBService
+	clients         *models.APIClients
+}
+
+func New(userDBService *userdb.UserDBService, globalDBService *globaldb.GlobalDBService, clients *models.APIClients) *Eraser {
+	return &Eraser{
+		userDBService:   userDBService,
+		globalDBService: globalDBService,
+		clients:         clients,
+	}
+}
+
+// Erase starts the erasure of the user and runs its steps. An error is returned if it couldn't start, e.g.
+// userdb.ErrUserChanged if the user doesn't match the filter anymore. Otherwise the returned erasure is done, or
+// pending with the error of the failing step in LastError. Without filter, i.e. for admins, a failed erasure of the
+// user is retried with new attempts.
+func (e *Eraser) Erase(ctx context.Context, instanceID string, req Request) (models.Erasure, error) {
+	erasure := models.NewErasure(req.User, req.RequestedBy, req.EmailType, req.LogEvent)
+	erasure.Anonymize = req.Anonymize
+	erasure, err := e.userDBService.StartErasure(instanceID, erasure, req.Filter, claimLease)
+	if err == userdb.ErrUserChanged && req.Filter == nil {
+		erasure, err = e.retryFailed(instanceID, req)
+	}
+	if err != nil {
+		return erasure, err
+	}
+	e.run(ctx, instanceID, &erasure)
+	return erasure, nil
+}
+
+// ResumeDue continues the pending erasures of the instance that are due for a retry, until none is left, ctx is
+// done or proceed returns false. proceed is called before each erasure, e.g. to check the lease of the leader. It
+// returns the erasures it resumed.
+func (e *Eraser) ResumeDue(ctx context.Context, instanceID string, proceed func() bool) ([]models.Erasure, error) {
+	resumed := []models.Erasure{}
+	for ctx.Err() == nil && proceed() {
+		erasure, err := e.userDBService.ClaimErasure(instanceID, time.Now().Unix(), claimLease)
+		if err != nil {
+			if err == mongo.ErrNoDocuments {
+				return resumed, nil
+			}
+			return resumed, err
+		}
+		logger.Info.Printf("%s: resuming erasure of user %s at attempt %d", instanceID, erasure.UserID, erasure.Attempts+1)
+		e.run(ctx, instanceID, &erasure)
+		resumed = append(resumed, erasure)
+	}
+	return resumed, nil
+}
+
+// retryFailed claims the failed erasure of the user again, userdb.ErrUserChanged if there is none
+func (e *Eraser) retryFailed(instanceID string, req Request) (models.Erasure, error) {
+	erasure, err := e.userDBService.RetryFailedErasure(instanceID, req.User.ID.Hex(), req.Anonymize, claimLease)
+	if err == mongo.ErrNoDocuments {
+		return erasure, userdb.ErrUserChanged
+	}
+	if err != nil {
+		return erasure, err
+	}
+	logger.Info.Printf("%s: retrying failed erasure of user %s for %s", instanceID, erasure.UserID, req.RequestedBy)
+	return erasure, nil
+}
+
+// run goes through the remaining steps and saves the progress after each of them
+func (e *Eraser) run(ctx context.Context, instanceID string, erasure *models.Erasure) {
+	for _, step := range models.ErasureSteps {
+		if erasure.IsDone(step) {
+			continue
+		}
+		if err := e.runStep(ctx, instanceID, erasure, step); err != nil {
+			e.postpone(instanceID, erasure, step, err)
+			return
+		}
+		erasure.DoneSteps = append(erasure.DoneSteps, step)
+		if err := e.userDBService.SaveErasure(instanceID, *erasure); err != nil {
+			logger.Error.Printf("%s: progress of the erasure of user %s couldn't be saved: %v", instanceID, erasure.UserID, err)
+			return
+		}
+	}
+
+	now := time.Now()
+	erasure.Status = models.ERASURE_STATUS_DONE
+	erasure.FinishedAt = &now
+	erasure.LastError = ""
+	// the account ID is not kept after the account is deleted or anonymized
+	erasure.AccountID = ""
+	if err := e.userDBService.SaveErasure(instanceID, *erasure); err != nil {
+		logger.Error.Printf("%s: end of the erasure of user %s couldn't be saved: %v", instanceID, erasure.UserID, err)
+	}
+	if erasure.Anonymize {
+		logger.Info.Printf("%s: anonymized user %s", instanceID, erasure.UserID)
+	} else {
+		logger.Info.Printf("%s: erased user %s", instanceID, erasure.UserID)
+	}
+}
+
+// runStep tries the step a few times, unless ctx is done
+func (e *Eraser) runStep(ctx context.Context, instanceID string, erasure *models.Erasure, step string) (err error) {
+	for attempt := 1; attempt <= stepAttempts; attempt++ {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err = e.step(ctx, instanceID, erasure, step); err == nil {
+			return nil
+		}
+		logger.Warning.Printf("%s: erasure of user %s, step %s failed (%d/%d): %v", instanceID, erasure.UserID, step, attempt, stepAttempts, err)
+		if attempt < stepAttempts {
+			select {
+			case <-time.After(stepRetryDelay):
+			case <-ctx.Done():
+			}
+		}
+	}
+	return err
+}
+
+func (e *Eraser) step(ctx context.Context, instanceID string, erasure *models.Erasure, step string) error {
+	switch step {
+	case models.ERASURE_STEP_STUDY_SERVICE:
+		if erasure.Anonymize {
+			return nil
+		}
+		return e.notifyStudyService(ctx, instanceID, erasure)
+	case models.ERASURE_STEP_TEMP_TOKENS:
+		return e.globalDBService.DeleteAllTempTokenForUser(instanceID, erasure.UserID, "")
+	case models.ERASURE_STEP_RENEW_TOKENS:
+		_, err := e.userDBService.DeleteRenewTokensForUser(instanceID, erasure.UserID)
+		return err
+	case models.ERASURE_STEP_USER:
+		if erasure.Anonymize {
+			return e.anonymizeUser(instanceID, erasure)
+		}
+		return e.deleteUser(instanceID, erasure)
+	case models.ERASURE_STEP_LOG_EVENT:
+		if erasure.LogEvent == "" {
+			return nil
+		}
+		_, err := e.clients.LoggingService.SaveLogEvent(ctx, &loggingAPI.NewLogEvent{
+			Origin:     "user-management",
+			InstanceId: instanceID,
+			UserId:     erasure.UserID,
+			EventType:  loggingAPI.LogEventType_LOG,
+			EventName:  erasure.LogEvent,
+		})
+		return err
+	default:
+		return errors.New("unknown step " + step)
+	}
+}
+
+// notifyStudyService lets the study service delete the data of each profile. Without connection to the study
+// service the step is skipped with a warning, the account is still deleted.
+func (e *Eraser) notifyStudyService(ctx context.Context, instanceID string, erasure *models.Erasure) error {
+	if len(erasure.ProfileIDs) == 0 {
+		return nil
+	}
+	if e.clients.StudyService == nil {
+		logger.Warning.Printf("%s: no connection to the study service, the data of the %d profiles of user %s is kept there", instanceID, len(erasure.ProfileIDs), erasure.UserID)
+		return nil
+	}
+	token := &api_types.TokenInfos{
+		Id:              erasure.UserID,
+		InstanceId:      instanceID,
+		OtherProfileIds: erasure.ProfileIDs[1:],
+	}
+	for _, profileID := range erasure.ProfileIDs {
+		if erasure.IsNotified(profileID) {
+			continue
+		}
+		token.ProfilId = profileID
+		if _, err := e.clients.StudyService.ProfileDeleted(ctx, token); err != nil {
+			return err
+		}
+		erasure.NotifiedProfiles = append(erasure.NotifiedProfiles, profileID)
+	}
+	return nil
+}
+
+// deleteUser deletes the user and queues the email in the same transaction. A user deleted by a previous attempt
+// counts as deleted.
+func (e *Eraser) deleteUser(instanceID string, erasure *models.Erasure) error {
+	err := e.userDBService.DeleteUserWithOutgoingEmails(instanceID, erasure.UserID, outgoingEmails(instanceID, erasure))
+	if err == userdb.ErrUserNotFound {
+		return nil
+	}
+	return err
+}
+
+// anonymizeUser replaces the account ID by a random one and removes the personal data, the email is queued in the
+// same transaction
+func (e *Eraser) anonymizeUser(instanceID string, erasure *models.Erasure) error {
+	accountID, err := models.AnonymizedAccountID()
+	if err != nil {
+		return err
+	}
+	return e.userDBService.AnonymizeUserWithOutgoingEmails(instanceID, erasure.UserID, accountID, outgoingEmails(instanceID, erasure))
+}
+
+// outgoingEmails are sent to the account ID the user had before the erasure
+func outgoingEmails(instanceID string, erasure *models.Erasure) []models.OutgoingEmail {
+	emails := []models.OutgoingEmail{}
+	if erasure.EmailType != "" {
+		emails = append(emails, models.NewOutgoingEmail(models.OUTGOING_EMAIL_INSTANT, &messageAPI.SendEmailReq{
+			InstanceId:        instanceID,
+			To:                []string{erasure.AccountID},
+			MessageType:       erasure.EmailType,
+			PreferredLanguage: erasure.PreferredLanguage,
+			UseLowPrio:        true,
+		}))
+	}
+	return emails
+}
+
+// postpone records the failed step, the erasure is resumed after a delay growing with the attempts
+func (e *Eraser) postpone(instanceID string, erasure *models.Erasure, step string, err error) {
+	erasure.Attempts++
+	erasure.LastError = step + ": " + err.Error()
+	erasure.NextAttemptAt = time.Now().Add(utils.RetryDelay(erasure.Attempts, minRetryDelay, maxRetryDelay)).Unix()
+	if erasure.Attempts >= MaxAttempts {
+		erasure.Status = models.ERASURE_STATUS_FAILED
+		logger.Error.Printf("%s: erasure of user %s failed after %d attempts: %s", instanceID, erasure.UserID, erasure.Attempts, erasure.LastError)
+	}
+	if err := e.userDBService.SaveErasure(instanceID, *erasure); err != nil {
+		logger.Error.Printf("%s: failure of the erasure of user %s couldn't be saved: %v", instanceID, erasure.UserID, err)
+	}
+}
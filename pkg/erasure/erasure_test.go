@@ -0,0 +1,14 @@
+package erasure
+
+import (
+	"testing"
+
+	"github.com/influenzanet/user-management-service/pkg/utils"
+)
+
+func TestRetryDelay(t *testing.T) {
+	// the delay reaches a day when the erasure is marked as failed
+	if d := utils.RetryDelay(MaxAttempts, minRetryDelay, maxRetryDelay); d != maxRetryDelay {
+		t.Errorf("unexpected delay at the last attempt: %s", d)
+	}
+}
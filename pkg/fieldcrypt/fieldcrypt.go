@@ -0,0 +1,188 @@
+// Package fieldcrypt encrypts single fields of DB documents, like email addresses, with AES-GCM. Encrypted values
+// can't be searched, so a blind index, a keyed HMAC of the plaintext, is stored next to them for lookups.
+//
+// The key ring holds named keys, new values are encrypted with the active one. Older keys are kept to decrypt the
+// values written with them, until these were encrypted again with the active key.
+package fieldcrypt
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	b64 "encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
+// prefix marks encrypted values, followed by the key ID and the base64 encoded nonce and ciphertext
+const prefix = "enc:v1:"
+
+// KeyFile is the JSON file the key ring is loaded from
+type KeyFile struct {
+	// ActiveKey is the ID of the key encrypting new values
+	ActiveKey string `json:"activeKey"`
+	// Keys are base64 encoded secrets of at least 32 bytes, by key ID
+	Keys map[string]string `json:"keys"`
+}
+
+type key struct {
+	aead     cipher.AEAD
+	indexKey []byte
+}
+
+type KeyRing struct {
+	active string
+	keys   map[string]key
+}
+
+// LoadKeyRing reads the key ring from a JSON file
+func LoadKeyRing(path string) (*KeyRing, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	f := KeyFile{}
+	if err := json.Unmarshal(content, &f); err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	keys, err := NewKeyRing(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	return keys, nil
+}
+
+// NewKeyRing derives the encryption and the blind index key from each secret
+func NewKeyRing(f KeyFile) (*KeyRing, error) {
+	if _, ok := f.Keys[f.ActiveKey]; !ok {
+		return nil, fmt.Errorf("active key '%s' not found", f.ActiveKey)
+	}
+	k := &KeyRing{
+		active: f.ActiveKey,
+		keys:   map[string]key{},
+	}
+	for id, encoded := range f.Keys {
+		if id == "" || strings.Contains(id, ":") {
+			return nil, fmt.Errorf("key ID '%s' must not be empty or contain ':'", id)
+		}
+		secret, err := b64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("key %s: %v", id, err)
+		}
+		if len(secret) < 32 {
+			return nil, fmt.Errorf("key %s: must be at least 32 bytes long", id)
+		}
+		block, err := aes.NewCipher(derive(secret, "encryption"))
+		if err != nil {
+			return nil, fmt.Errorf("key %s: %v", id, err)
+		}
+		aead, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, fmt.Errorf("key %s: %v", id, err)
+		}
+		k.keys[id] = key{aead: aead, indexKey: derive(secret, "blind index")}
+	}
+	return k, nil
+}
+
+// derive returns a 32 byte key for the purpose, so that the same secret isn't used for encryption and HMAC
+func derive(secret []byte, purpose string) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte("fieldcrypt " + purpose))
+	return mac.Sum(nil)
+}
+
+// ActiveKey is the ID of the key encrypting new values
+func (k *KeyRing) ActiveKey() string {
+	return k.active
+}
+
+// Encrypt encrypts the value with the active key, empty values stay empty
+func (k *KeyRing) Encrypt(plaintext string) (string, error) {
+	if plaintext == "" {
+		return "", nil
+	}
+	aead := k.keys[k.active].aead
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	sealed := aead.Seal(nonce, nonce, []byte(plaintext), nil)
+	return prefix + k.active + ":" + b64.RawStdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt returns the plaintext of an encrypted value. Values that are not encrypted, e.g. not migrated yet, are
+// returned as they are.
+func (k *KeyRing) Decrypt(value string) (string, error) {
+	if !IsEncrypted(value) {
+		return value, nil
+	}
+	keyID, data, _ := strings.Cut(strings.TrimPrefix(value, prefix), ":")
+	key, ok := k.keys[keyID]
+	if !ok {
+		return "", fmt.Errorf("unknown key '%s'", keyID)
+	}
+	sealed, err := b64.RawStdEncoding.DecodeString(data)
+	if err != nil {
+		return "", err
+	}
+	nonceSize := key.aead.NonceSize()
+	if len(sealed) < nonceSize {
+		return "", errors.New("encrypted value too short")
+	}
+	plaintext, err := key.aead.Open(nil, sealed[:nonceSize], sealed[nonceSize:], nil)
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
+
+// IsEncrypted tells if the value was encrypted by a key ring
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, prefix)
+}
+
+// KeyID returns the ID of the key the value was encrypted with, empty if it isn't encrypted
+func KeyID(value string) string {
+	if !IsEncrypted(value) {
+		return ""
+	}
+	keyID, _, _ := strings.Cut(strings.TrimPrefix(value, prefix), ":")
+	return keyID
+}
+
+// BlindIndex is the HMAC of the plaintext with the active key, empty for an empty plaintext
+func (k *KeyRing) BlindIndex(plaintext string) string {
+	if plaintext == "" {
+		return ""
+	}
+	return blindIndex(k.keys[k.active].indexKey, plaintext)
+}
+
+// BlindIndexes are the HMACs of the plaintext with every key, to find values whose index wasn't computed with the
+// active key yet
+func (k *KeyRing) BlindIndexes(plaintext string) []string {
+	ids := make([]string, 0, len(k.keys))
+	for id := range k.keys {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	indexes := make([]string, len(ids))
+	for i, id := range ids {
+		indexes[i] = blindIndex(k.keys[id].indexKey, plaintext)
+	}
+	return indexes
+}
+
+func blindIndex(indexKey []byte, plaintext string) string {
+	mac := hmac.New(sha256.New, indexKey)
+	mac.Write([]byte(plaintext))
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,115 @@
+package fieldcrypt
+
+import (
+	b64 "encoding/base64"
+	"strings"
+	"testing"
+)
+
+var (
+	testKey1 = b64.StdEncoding.EncodeToString([]byte(strings.Repeat("1", 32)))
+	testKey2 = b64.StdEncoding.EncodeToString([]byte(strings.Repeat("2", 32)))
+)
+
+func TestNewKeyRing(t *testing.T) {
+	for name, f := range map[string]KeyFile{
+		"unknown active key": {ActiveKey: "k2", Keys: map[string]string{"k1": testKey1}},
+		"short key":          {ActiveKey: "k1", Keys: map[string]string{"k1": b64.StdEncoding.EncodeToString([]byte("short"))}},
+		"invalid key ID":     {ActiveKey: "k:1", Keys: map[string]string{"k:1": testKey1}},
+	} {
+		if _, err := NewKeyRing(f); err == nil {
+			t.Errorf("%s: should be invalid", name)
+		}
+	}
+}
+
+func TestEncryption(t *testing.T) {
+	keys, err := NewKeyRing(KeyFile{ActiveKey: "k1", Keys: map[string]string{"k1": testKey1}})
+	if err != nil {
+		t.Errorf("unexpected error: %s", err.Error())
+		return
+	}
+
+	t.Run("encrypt and decrypt", func(t *testing.T) {
+		encrypted, err := keys.Encrypt("test@test.com")
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if !IsEncrypted(encrypted) || KeyID(encrypted) != "k1" || strings.Contains(encrypted, "test@test.com") {
+			t.Errorf("unexpected value: %s", encrypted)
+		}
+		other, _ := keys.Encrypt("test@test.com")
+		if other == encrypted {
+			t.Error("encrypting twice should use different nonces")
+		}
+		decrypted, err := keys.Decrypt(encrypted)
+		if err != nil || decrypted != "test@test.com" {
+			t.Errorf("unexpected result: %s %v", decrypted, err)
+		}
+	})
+
+	t.Run("values not encrypted", func(t *testing.T) {
+		if v, _ := keys.Encrypt(""); v != "" {
+			t.Errorf("empty value should stay empty: %s", v)
+		}
+		if v, err := keys.Decrypt("test@test.com"); err != nil || v != "test@test.com" {
+			t.Errorf("plaintext should be returned as is: %s %v", v, err)
+		}
+	})
+
+	t.Run("tampered value", func(t *testing.T) {
+		encrypted, _ := keys.Encrypt("test@test.com")
+		tampered := encrypted[:len(encrypted)-2] + "AA"
+		if tampered == encrypted {
+			tampered = encrypted[:len(encrypted)-2] + "BB"
+		}
+		if _, err := keys.Decrypt(tampered); err == nil {
+			t.Error("tampered value should not be decrypted")
+		}
+	})
+
+	t.Run("key rotation", func(t *testing.T) {
+		encrypted, _ := keys.Encrypt("test@test.com")
+		index := keys.BlindIndex("test@test.com")
+
+		rotated, err := NewKeyRing(KeyFile{ActiveKey: "k2", Keys: map[string]string{"k1": testKey1, "k2": testKey2}})
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if v, err := rotated.Decrypt(encrypted); err != nil || v != "test@test.com" {
+			t.Errorf("value of the previous key should be decrypted: %s %v", v, err)
+		}
+		if v, _ := rotated.Encrypt("test@test.com"); KeyID(v) != "k2" {
+			t.Errorf("active key should be used: %s", v)
+		}
+		if rotated.BlindIndex("test@test.com") == index {
+			t.Error("blind index should depend on the key")
+		}
+		found := false
+		for _, i := range rotated.BlindIndexes("test@test.com") {
+			found = found || i == index
+		}
+		if !found {
+			t.Error("blind index of the previous key should be in the indexes")
+		}
+
+		withoutOldKey, _ := NewKeyRing(KeyFile{ActiveKey: "k2", Keys: map[string]string{"k2": testKey2}})
+		if _, err := withoutOldKey.Decrypt(encrypted); err == nil {
+			t.Error("value of an unknown key should not be decrypted")
+		}
+	})
+
+	t.Run("blind index", func(t *testing.T) {
+		if keys.BlindIndex("test@test.com") != keys.BlindIndex("test@test.com") {
+			t.Error("blind index should be stable")
+		}
+		if keys.BlindIndex("test@test.com") == keys.BlindIndex("other@test.com") {
+			t.Error("blind indexes of different values should differ")
+		}
+		if keys.BlindIndex("") != "" {
+			t.Error("blind index of an empty value should be empty")
+		}
+	})
+}
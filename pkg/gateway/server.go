@@ -0,0 +1,94 @@
+// Package gateway serves the UserManagementApi as HTTP/JSON. Requests are transcoded and
+// forwarded to the gRPC server, so they go through the same handlers and interceptors.
+// Users are authenticated with their access token in the Authorization header.
+package gateway
+
+import (
+	"context"
+	_ "embed"
+	"net/http"
+	"time"
+
+	"github.com/coneno/logger"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/influenzanet/user-management-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/edgeauth"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// OpenAPIPath is the path the OpenAPI document of the gateway is served at
+const OpenAPIPath = "/openapi.json"
+
+// openAPIDoc is generated from the protos and http_rules.yaml together with the gateway code
+//
+//go:embed user-management-api.swagger.json
+var openAPIDoc []byte
+
+// NewHandler creates the HTTP handler forwarding to the gRPC server listening at grpcAddr.
+// dialOpts are added to the connection, e.g. the service token of the gateway.
+func NewHandler(ctx context.Context, grpcAddr string, dialOpts ...grpc.DialOption) (http.Handler, error) {
+	gwMux := runtime.NewServeMux()
+	opts := append([]grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+		// token infos come from the access token, never from the request
+		grpc.WithChainUnaryInterceptor(edgeauth.UnaryClientInterceptor),
+		grpc.WithChainStreamInterceptor(edgeauth.StreamClientInterceptor),
+	}, dialOpts...)
+	if err := api.RegisterUserManagementApiHandlerFromEndpoint(ctx, gwMux, grpcAddr, opts); err != nil {
+		return nil, err
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(OpenAPIPath, serveOpenAPIDoc)
+	mux.Handle("/", gwMux)
+	return mux, nil
+}
+
+// RunServer starts the HTTP/JSON gateway and stops it when ctx is done
+func RunServer(ctx context.Context, port string, grpcAddr string, dialOpts ...grpc.DialOption) error {
+	handler, err := NewHandler(ctx, grpcAddr, dialOpts...)
+	if err != nil {
+		return err
+	}
+
+	server := &http.Server{
+		Addr:              ":" + port,
+		Handler:           handler,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	shutdownDone := make(chan struct{})
+	go func() {
+		defer close(shutdownDone)
+		<-ctx.Done()
+		logger.Debug.Println("shutting down HTTP gateway...")
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			logger.Error.Printf("HTTP gateway shutdown: %v", err)
+		}
+	}()
+
+	logger.Debug.Println("starting HTTP gateway...")
+	logger.Debug.Println("wait connections on port " + port)
+	if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		return err
+	}
+	// ListenAndServe returns as soon as the shutdown starts, the requests in progress are waited for
+	<-shutdownDone
+	return nil
+}
+
+func serveOpenAPIDoc(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(openAPIDoc); err != nil {
+		logger.Error.Printf("HTTP gateway: failed to write OpenAPI document: %v", err)
+	}
+}
@@ -0,0 +1,222 @@
+package gateway
+
+import (
+	"context"
+	b64 "encoding/base64"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/influenzanet/user-management-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/tokens"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type testAPIServer struct {
+	api.UnimplementedUserManagementApiServer
+	lastLogin   *api.LoginWithEmailMsg
+	lastUserReq *api.UserReference
+}
+
+func (s *testAPIServer) GetUser(ctx context.Context, req *api.UserReference) (*api.User, error) {
+	s.lastUserReq = req
+	return &api.User{}, nil
+}
+
+func (s *testAPIServer) Status(ctx context.Context, _ *emptypb.Empty) (*api.ServiceStatus, error) {
+	return &api.ServiceStatus{Status: api.ServiceStatus_NORMAL, Version: "v1"}, nil
+}
+
+func (s *testAPIServer) LoginWithEmail(ctx context.Context, req *api.LoginWithEmailMsg) (*api.LoginResponse, error) {
+	s.lastLogin = req
+	if req.Password != "secret" {
+		return nil, status.Error(codes.InvalidArgument, "invalid username and/or password")
+	}
+	return &api.LoginResponse{Token: &api.TokenResponse{AccessToken: "access"}}, nil
+}
+
+func startTestGateway(t *testing.T) (*testAPIServer, *httptest.Server) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	apiServer := &testAPIServer{}
+	grpcServer := grpc.NewServer()
+	api.RegisterUserManagementApiServer(grpcServer, apiServer)
+	go func() {
+		_ = grpcServer.Serve(lis)
+	}()
+	t.Cleanup(grpcServer.Stop)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	handler, err := NewHandler(ctx, lis.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	return apiServer, srv
+}
+
+func TestGateway(t *testing.T) {
+	apiServer, srv := startTestGateway(t)
+
+	t.Run("GET status", func(t *testing.T) {
+		resp, err := http.Get(srv.URL + "/v1/status")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		body := map[string]interface{}{}
+		if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode != http.StatusOK || body["version"] != "v1" {
+			t.Errorf("unexpected response: %d %v", resp.StatusCode, body)
+		}
+	})
+
+	t.Run("POST login", func(t *testing.T) {
+		resp, err := http.Post(srv.URL+"/v1/auth/login", "application/json",
+			strings.NewReader(`{"email": "test@test.com", "password": "secret", "instance_id": "inst"}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		body := struct {
+			Token struct {
+				AccessToken string `json:"accessToken"`
+			} `json:"token"`
+		}{}
+		if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode != http.StatusOK || body.Token.AccessToken != "access" {
+			t.Errorf("unexpected response: %d %v", resp.StatusCode, body)
+		}
+		if apiServer.lastLogin.Email != "test@test.com" || apiServer.lastLogin.InstanceId != "inst" {
+			t.Errorf("unexpected request: %v", apiServer.lastLogin)
+		}
+	})
+
+	t.Run("token infos from access token", func(t *testing.T) {
+		t.Setenv("JWT_TOKEN_KEY", b64.StdEncoding.EncodeToString([]byte(strings.Repeat("k", 32))))
+		token, err := tokens.GenerateNewToken("user-1", true, "", []string{"PARTICIPANT"}, "inst", time.Minute, "", nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		claimed := srv.URL + "/v1/users/me?token.id=admin&token.instanceId=inst&token.payload%5Broles%5D=ADMIN"
+
+		resp, err := http.Get(claimed)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK || apiServer.lastUserReq.Token != nil {
+			t.Errorf("token infos of the request should be dropped: %d %v", resp.StatusCode, apiServer.lastUserReq)
+		}
+
+		req, _ := http.NewRequest(http.MethodGet, claimed, nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		resp, err = http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK || apiServer.lastUserReq.Token.GetId() != "user-1" || apiServer.lastUserReq.Token.Payload["roles"] != "PARTICIPANT" {
+			t.Errorf("token infos should be set from the access token: %d %v", resp.StatusCode, apiServer.lastUserReq)
+		}
+
+		req.Header.Set("Authorization", "Bearer "+token+"x")
+		resp, err = http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusUnauthorized {
+			t.Errorf("invalid access token should be rejected: %d", resp.StatusCode)
+		}
+	})
+
+	t.Run("with handler error", func(t *testing.T) {
+		resp, err := http.Post(srv.URL+"/v1/auth/login", "application/json", strings.NewReader(`{"password": "wrong"}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Errorf("unexpected status: %d", resp.StatusCode)
+		}
+	})
+
+	t.Run("with unimplemented method", func(t *testing.T) {
+		resp, err := http.Post(srv.URL+"/v1/sessions/renew", "application/json", strings.NewReader(`{}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusNotImplemented {
+			t.Errorf("unexpected status: %d", resp.StatusCode)
+		}
+	})
+
+	t.Run("GET OpenAPI document", func(t *testing.T) {
+		resp, err := http.Get(srv.URL + OpenAPIPath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		content, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		doc := map[string]interface{}{}
+		if err := json.Unmarshal(content, &doc); err != nil {
+			t.Fatalf("invalid document: %v", err)
+		}
+		paths, ok := doc["paths"].(map[string]interface{})
+		if !ok || paths["/v1/auth/login"] == nil {
+			t.Errorf("login route missing in document")
+		}
+	})
+}
+
+func TestGatewayWithDialOptions(t *testing.T) {
+	lis := bufconn.Listen(1 << 20)
+	grpcServer := grpc.NewServer()
+	api.RegisterUserManagementApiServer(grpcServer, &testAPIServer{})
+	go func() {
+		_ = grpcServer.Serve(lis)
+	}()
+	defer grpcServer.Stop()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	handler, err := NewHandler(ctx, "in-process", grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+		return lis.DialContext(ctx)
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := httptest.NewServer(handler)
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "/v1/status")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("unexpected status: %d", resp.StatusCode)
+	}
+}
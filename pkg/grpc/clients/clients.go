@@ -1,35 +1,50 @@
 package clients
 
 import (
+	"crypto/tls"
+
 	"github.com/coneno/logger"
 	loggingAPI "github.com/influenzanet/logging-service/pkg/api"
 	messageAPI "github.com/influenzanet/messaging-service/pkg/api/messaging_service"
 	studyAPI "github.com/influenzanet/study-service/pkg/api"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
-func connectToGRPCServer(addr string) *grpc.ClientConn {
-	conn, err := grpc.Dial(addr, grpc.WithInsecure())
+// connectToGRPCServer dials addr, using TLS if tlsConf is not nil
+func connectToGRPCServer(addr string, tlsConf *tls.Config) *grpc.ClientConn {
+	transportCreds := insecure.NewCredentials()
+	if tlsConf != nil {
+		transportCreds = credentials.NewTLS(tlsConf)
+	}
+	conn, err := grpc.Dial(
+		addr,
+		grpc.WithTransportCredentials(transportCreds),
+		// propagates the trace context to the called service
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+	)
 	if err != nil {
 		logger.Error.Fatalf("failed to connect to %s: %v", addr, err)
 	}
 	return conn
 }
 
-func ConnectToMessagingService(addr string) (client messageAPI.MessagingServiceApiClient, close func() error) {
+func ConnectToMessagingService(addr string, tlsConf *tls.Config) (client messageAPI.MessagingServiceApiClient, close func() error) {
 	// Connect to user management service
-	serverConn := connectToGRPCServer(addr)
+	serverConn := connectToGRPCServer(addr, tlsConf)
 	return messageAPI.NewMessagingServiceApiClient(serverConn), serverConn.Close
 }
 
-func ConnectToLoggingService(addr string) (client loggingAPI.LoggingServiceApiClient, close func() error) {
+func ConnectToLoggingService(addr string, tlsConf *tls.Config) (client loggingAPI.LoggingServiceApiClient, close func() error) {
 	// Connect to user management service
-	serverConn := connectToGRPCServer(addr)
+	serverConn := connectToGRPCServer(addr, tlsConf)
 	return loggingAPI.NewLoggingServiceApiClient(serverConn), serverConn.Close
 }
 
-func ConnectToStudyService(addr string) (client studyAPI.StudyServiceApiClient, close func() error) {
+func ConnectToStudyService(addr string, tlsConf *tls.Config) (client studyAPI.StudyServiceApiClient, close func() error) {
 	// Connect to user management service
-	serverConn := connectToGRPCServer(addr)
+	serverConn := connectToGRPCServer(addr, tlsConf)
 	return studyAPI.NewStudyServiceApiClient(serverConn), serverConn.Close
 }
@@ -10,6 +10,8 @@ import (
 	loggingAPI "github.com/influenzanet/logging-service/pkg/api"
 	messageAPI "github.com/influenzanet/messaging-service/pkg/api/messaging_service"
 	"github.com/influenzanet/user-management-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/dbs/userdb"
+	"github.com/influenzanet/user-management-service/pkg/erasure"
 	"github.com/influenzanet/user-management-service/pkg/models"
 	"github.com/influenzanet/user-management-service/pkg/pwhash"
 	"github.com/influenzanet/user-management-service/pkg/tokens"
@@ -53,6 +55,10 @@ func (s *userManagementServer) ChangePassword(ctx context.Context, req *api.Pass
 		return nil, status.Error(codes.InvalidArgument, "invalid user and/or password")
 	}
 
+	if s.authBackends.BackendForUser(req.Token.InstanceId, user) != nil {
+		return nil, status.Error(codes.FailedPrecondition, "password is managed by the authentication backend")
+	}
+
 	match, err := pwhash.ComparePasswordWithHash(user.Account.Password, req.OldPassword)
 	if err != nil || !match {
 		s.SaveLogEvent(req.Token.InstanceId, user.ID.Hex(), loggingAPI.LogEventType_SECURITY, constants.LOG_EVENT_AUTH_WRONG_PASSWORD, "change password endpoint")
@@ -110,8 +116,11 @@ func (s *userManagementServer) ChangeAccountIDEmail(ctx context.Context, req *ap
 		return nil, status.Error(codes.Internal, "user not found")
 	}
 
-	match, err := pwhash.ComparePasswordWithHash(user.Account.Password, req.Password)
-	if err != nil || !match {
+	match, err := s.verifyPassword(ctx, req.Token.InstanceId, &user, req.Password)
+	if err != nil {
+		return nil, err
+	}
+	if !match {
 		s.SaveLogEvent(req.Token.InstanceId, user.ID.Hex(), loggingAPI.LogEventType_SECURITY, constants.LOG_EVENT_AUTH_WRONG_PASSWORD, "change account id endpoint")
 		return nil, status.Error(codes.InvalidArgument, "action failed")
 	}
@@ -198,10 +207,7 @@ func (s *userManagementServer) ChangeAccountIDEmail(ctx context.Context, req *ap
 			UserID:     user.ID.Hex(),
 			InstanceID: req.Token.InstanceId,
 			Purpose:    constants.TOKEN_PURPOSE_CONTACT_VERIFICATION,
-			Info: map[string]string{
-				"type":  "email",
-				"email": user.Account.AccountID,
-			},
+			Info:       user.ContactVerificationInfos(user.Account.AccountID),
 			Expiration: tokens.GetExpirationTime(time.Hour * 24 * 30),
 		}
 		tempToken, err := s.globalDBService.AddTempToken(tempTokenInfos)
@@ -238,7 +244,7 @@ func (s *userManagementServer) ChangeAccountIDEmail(ctx context.Context, req *ap
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	s.SaveLogEvent(req.Token.InstanceId, updUser.ID.Hex(), loggingAPI.LogEventType_LOG, constants.LOG_EVENT_ACCOUNT_ID_CHANGED, updUser.Account.AccountID)
+	s.SaveLogEvent(req.Token.InstanceId, updUser.ID.Hex(), loggingAPI.LogEventType_LOG, constants.LOG_EVENT_ACCOUNT_ID_CHANGED, "")
 
 	return updUser.ToAPI(), nil
 }
@@ -248,7 +254,9 @@ func (s *userManagementServer) DeleteAccount(ctx context.Context, req *api.UserR
 		return nil, status.Error(codes.InvalidArgument, "missing argument")
 	}
 
-	// TODO: check if user auth is from admin - to remove user by admin
+	if req.Token.Id != req.UserId && utils.CheckRoleInToken(req.Token, constants.USER_ROLE_ADMIN) {
+		return s.deleteAccountByAdmin(ctx, req)
+	}
 	if req.Token.Id != req.UserId {
 		logger.Warning.Printf("unauthorized request: user %s initiated account removal for user id %s", req.Token.Id, req.UserId)
 		return nil, status.Error(codes.PermissionDenied, "not authorized")
@@ -259,35 +267,188 @@ func (s *userManagementServer) DeleteAccount(ctx context.Context, req *api.UserR
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
+	if user.Account.IsPendingDeletion() {
+		return nil, status.Error(codes.FailedPrecondition, "account deletion already requested")
+	}
 
-	// ---> Trigger message sending
-	_, err = s.clients.MessagingService.SendInstantEmail(ctx, &messageAPI.SendEmailReq{
-		InstanceId:        req.Token.InstanceId,
-		To:                []string{user.Account.AccountID},
-		MessageType:       constants.EMAIL_TYPE_ACCOUNT_DELETED,
+	gracePeriod := s.settings(req.Token.InstanceId).AccountDeletionGracePeriod
+	deleteAt := time.Now().Unix() + gracePeriod
+	restoreToken, err := s.globalDBService.AddTempToken(models.TempToken{
+		UserID:     req.UserId,
+		InstanceID: req.Token.InstanceId,
+		Purpose:    tokenPurposeRestoreAccount,
+		Info: map[string]string{
+			"type": models.ACCOUNT_TYPE_EMAIL,
+		},
+		Expiration: deleteAt,
+	})
+	if err != nil {
+		logger.Error.Printf("DeleteAccount: %s", err.Error())
+		return nil, status.Error(codes.Internal, "restore token couldn't be created")
+	}
+
+	// notification is queued together with the deletion request
+	notification := models.NewOutgoingEmail(models.OUTGOING_EMAIL_INSTANT, &messageAPI.SendEmailReq{
+		InstanceId:  req.Token.InstanceId,
+		To:          []string{user.Account.AccountID},
+		MessageType: emailTypeAccountDeletionRequested,
+		ContentInfos: map[string]string{
+			"token":    restoreToken,
+			"deleteAt": strconv.FormatInt(deleteAt, 10),
+		},
 		PreferredLanguage: user.Account.PreferredLanguage,
-		UseLowPrio:        true,
 	})
+	if err := s.userDBservice.ScheduleAccountDeletionWithOutgoingEmails(req.Token.InstanceId, req.UserId, deleteAt, []models.OutgoingEmail{notification}); err != nil {
+		if err := s.globalDBService.DeleteAllTempTokenForUser(req.Token.InstanceId, req.UserId, tokenPurposeRestoreAccount); err != nil {
+			logger.Error.Printf("error, when trying to remove temp-tokens: %s", err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// once the deletion is scheduled, sessions are revoked and the links sent before are no longer valid
+	tempTokens, err := s.globalDBService.GetTempTokenForUser(req.Token.InstanceId, req.UserId, "")
+	if err != nil {
+		logger.Error.Printf("error, when trying to find temp-tokens: %s", err.Error())
+	}
+	for _, t := range tempTokens {
+		if t.Token == restoreToken {
+			continue
+		}
+		if err := s.globalDBService.DeleteTempToken(t.Token); err != nil {
+			logger.Error.Printf("error, when trying to remove temp-token: %s", err.Error())
+		}
+	}
+	if _, err := s.userDBservice.DeleteRenewTokensForUser(req.Token.InstanceId, req.UserId); err != nil {
+		logger.Error.Printf("error, when trying to remove renew tokens: %s", err.Error())
+	}
+
+	s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_LOG, logEventAccountDeletionRequested, req.UserId)
+
+	logger.Info.Printf("user account with id %s will be removed at %d", req.UserId, deleteAt)
+	return &api.ServiceStatus{
+		Status: api.ServiceStatus_NORMAL,
+		Msg:    "user deletion scheduled",
+	}, nil
+}
+
+// deleteAccountByAdmin erases the account right away, without grace period
+func (s *userManagementServer) deleteAccountByAdmin(ctx context.Context, req *api.UserReference) (*api.ServiceStatus, error) {
+	instanceID := req.Token.InstanceId
+	logger.Info.Printf("admin %s initiated account removal for user id %s", req.Token.Id, req.UserId)
+
+	user, err := s.userDBservice.GetUserByID(instanceID, req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	emailType := constants.EMAIL_TYPE_ACCOUNT_DELETED
+	if user.Account.IsAnonymized() {
+		// the account ID of an anonymized account can't receive emails
+		emailType = ""
+	}
+	e, err := erasure.New(s.userDBservice, s.globalDBService, s.clients).Erase(ctx, instanceID, erasure.Request{
+		User:        user,
+		RequestedBy: req.Token.Id,
+		EmailType:   emailType,
+		LogEvent:    constants.LOG_EVENT_ACCOUNT_DELETED,
+	})
+	if err == userdb.ErrUserChanged {
+		return nil, status.Error(codes.FailedPrecondition, "account erasure already in progress")
+	}
 	if err != nil {
 		logger.Error.Printf("DeleteAccount: %s", err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
 	}
-	// <---
 
-	if err := s.userDBservice.DeleteUser(req.Token.InstanceId, req.UserId); err != nil {
+	s.SaveLogEvent(instanceID, req.Token.Id, loggingAPI.LogEventType_LOG, logEventAccountDeletedByAdmin, req.UserId)
+
+	// a failed step is retried by the job resuming the erasures
+	msg := "user deleted"
+	if e.Status != models.ERASURE_STATUS_DONE {
+		logger.Warning.Printf("erasure of user %s not completed: %s", req.UserId, e.LastError)
+		msg = "user deletion in progress"
+	}
+	return &api.ServiceStatus{
+		Status: api.ServiceStatus_NORMAL,
+		Msg:    msg,
+	}, nil
+}
+
+// AnonymizeAccount removes the personal data of a user for an admin, the account and its profiles are kept for the
+// study responses referencing them
+func (s *userManagementServer) AnonymizeAccount(ctx context.Context, req *api.UserReference) (*api.ServiceStatus, error) {
+	if req == nil || utils.IsTokenEmpty(req.Token) || req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing argument")
+	}
+	if !utils.CheckRoleInToken(req.Token, constants.USER_ROLE_ADMIN) {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+	instanceID := req.Token.InstanceId
+	logger.Info.Printf("admin %s initiated account anonymization for user id %s", req.Token.Id, req.UserId)
+
+	user, err := s.userDBservice.GetUserByID(instanceID, req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	if user.Account.IsAnonymized() {
+		return nil, status.Error(codes.FailedPrecondition, "account already anonymized")
+	}
+	e, err := erasure.New(s.userDBservice, s.globalDBService, s.clients).Erase(ctx, instanceID, erasure.Request{
+		User:        user,
+		RequestedBy: req.Token.Id,
+		LogEvent:    erasure.LogEventAccountAnonymized,
+		Anonymize:   true,
+	})
+	if err == userdb.ErrUserChanged {
+		return nil, status.Error(codes.FailedPrecondition, "account erasure already in progress")
+	}
+	if err != nil {
+		logger.Error.Printf("AnonymizeAccount: %s", err.Error())
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	// remove all TempTokens for the given user ID using auth-service
-	if err := s.globalDBService.DeleteAllTempTokenForUser(req.Token.InstanceId, req.Token.Id, ""); err != nil {
+	s.SaveLogEvent(instanceID, req.Token.Id, loggingAPI.LogEventType_LOG, logEventAccountAnonymizedByAdmin, req.UserId)
+
+	// a failed step is retried by the job resuming the erasures
+	msg := "user anonymized"
+	if e.Status != models.ERASURE_STATUS_DONE {
+		logger.Warning.Printf("anonymization of user %s not completed: %s", req.UserId, e.LastError)
+		msg = "user anonymization in progress"
+	}
+	return &api.ServiceStatus{
+		Status: api.ServiceStatus_NORMAL,
+		Msg:    msg,
+	}, nil
+}
+
+// RestoreAccount cancels the deletion of the account with the token sent when it was requested
+func (s *userManagementServer) RestoreAccount(ctx context.Context, req *api.TempToken) (*api.ServiceStatus, error) {
+	if req == nil || req.Token == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing argument")
+	}
+	tokenInfos, err := s.ValidateTempToken(req.Token, []string{tokenPurposeRestoreAccount})
+	if err != nil {
+		logger.Warning.Printf("RestoreAccount: %s", err.Error())
+		return nil, status.Error(codes.InvalidArgument, "invalid token")
+	}
+
+	restored, err := s.userDBservice.CancelAccountDeletion(tokenInfos.InstanceID, tokenInfos.UserID)
+	if err != nil {
+		logger.Error.Printf("RestoreAccount: %s", err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !restored {
+		return nil, status.Error(codes.FailedPrecondition, "account is not pending deletion")
+	}
+	if err := s.globalDBService.DeleteAllTempTokenForUser(tokenInfos.InstanceID, tokenInfos.UserID, tokenPurposeRestoreAccount); err != nil {
 		logger.Error.Printf("error, when trying to remove temp-tokens: %s", err.Error())
 	}
 
-	s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_LOG, constants.LOG_EVENT_ACCOUNT_DELETED, user.Account.AccountID)
+	s.SaveLogEvent(tokenInfos.InstanceID, tokenInfos.UserID, loggingAPI.LogEventType_LOG, logEventAccountRestored, "")
 
-	logger.Info.Printf("user account with id %s successfully removed", req.UserId)
+	logger.Info.Printf("user account with id %s restored", tokenInfos.UserID)
 	return &api.ServiceStatus{
 		Status: api.ServiceStatus_NORMAL,
-		Msg:    "user deleted",
+		Msg:    "account restored",
 	}, nil
 }
 
@@ -313,7 +474,7 @@ func (s *userManagementServer) SaveProfile(ctx context.Context, req *api.Profile
 	}
 
 	if req.Profile.Id == "" {
-		if len(user.Profiles) > maximumProfilesAllowed {
+		if len(user.Profiles) > s.settings(req.Token.InstanceId).MaxProfiles {
 			s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_SECURITY, constants.LOG_EVENT_PROFILE_SAVED, "too many profiles added"+req.Profile.Alias)
 			return nil, status.Error(codes.Internal, "reached profile limit")
 		}
@@ -361,6 +522,48 @@ func (s *userManagementServer) RemoveProfile(ctx context.Context, req *api.Profi
 	return updUser.ToAPI(), nil
 }
 
+func (s *userManagementServer) RecordConsent(ctx context.Context, req *api.ConsentMsg) (*api.User, error) {
+	if req == nil || utils.IsTokenEmpty(req.Token) || req.ProfileId == "" || req.ConsentType == "" || req.Version == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing argument")
+	}
+
+	user, err := s.userDBservice.GetUserByID(req.Token.InstanceId, req.Token.Id)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "user not found")
+	}
+	if err := user.RecordConsent(req.ProfileId, req.ConsentType, req.Version, req.Channel); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	updUser, err := s.userDBservice.UpdateUser(req.Token.InstanceId, user)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_LOG, logEventConsentRecorded, "profile: "+req.ProfileId+", "+req.ConsentType+" version "+req.Version)
+	return updUser.ToAPI(), nil
+}
+
+func (s *userManagementServer) WithdrawConsent(ctx context.Context, req *api.ConsentMsg) (*api.User, error) {
+	if req == nil || utils.IsTokenEmpty(req.Token) || req.ProfileId == "" || req.ConsentType == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing argument")
+	}
+
+	user, err := s.userDBservice.GetUserByID(req.Token.InstanceId, req.Token.Id)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "user not found")
+	}
+	if err := user.WithdrawConsent(req.ProfileId, req.ConsentType); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	updUser, err := s.userDBservice.UpdateUser(req.Token.InstanceId, user)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_LOG, logEventConsentWithdrawn, "profile: "+req.ProfileId+", "+req.ConsentType)
+	return updUser.ToAPI(), nil
+}
+
 func (s *userManagementServer) UpdateContactPreferences(ctx context.Context, req *api.ContactPreferencesMsg) (*api.User, error) {
 	if req == nil || utils.IsTokenEmpty(req.Token) || req.ContactPreferences == nil {
 		return nil, status.Error(codes.InvalidArgument, "missing argument")
@@ -428,11 +631,7 @@ func (s *userManagementServer) AddEmail(ctx context.Context, req *api.ContactInf
 		UserID:     user.ID.Hex(),
 		InstanceID: req.Token.InstanceId,
 		Purpose:    constants.TOKEN_PURPOSE_CONTACT_VERIFICATION,
-		Info: map[string]string{
-			"type":  "email",
-			"email": email,
-		},
-
+		Info:       user.ContactVerificationInfos(email),
 		Expiration: tokens.GetExpirationTime(time.Hour * 24 * 30),
 	}
 	tempToken, err := s.globalDBService.AddTempToken(tempTokenInfos)
@@ -7,9 +7,13 @@ import (
 
 	"github.com/golang/mock/gomock"
 	api_types "github.com/influenzanet/go-utils/pkg/api_types"
+	"github.com/influenzanet/go-utils/pkg/constants"
 	"github.com/influenzanet/user-management-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/instances"
+	"github.com/influenzanet/user-management-service/pkg/instancesettings"
 	"github.com/influenzanet/user-management-service/pkg/models"
 	"github.com/influenzanet/user-management-service/pkg/pwhash"
+	"github.com/influenzanet/user-management-service/pkg/tokens"
 	loggingMock "github.com/influenzanet/user-management-service/test/mocks/logging_service"
 	messageMock "github.com/influenzanet/user-management-service/test/mocks/messaging_service"
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -20,10 +24,10 @@ func TestGetUserEndpoint(t *testing.T) {
 	s := userManagementServer{
 		userDBservice:   testUserDBService,
 		globalDBService: testGlobalDBService,
-		Intervals: models.Intervals{
+		instanceSettings: testSettingsStore(models.Intervals{
 			TokenExpiryInterval:      time.Second * 2,
 			VerificationCodeLifetime: 60,
-		},
+		}),
 	}
 
 	testUsers, err := addTestUsers([]models.User{
@@ -135,11 +139,11 @@ func TestChangePasswordEndpoint(t *testing.T) {
 	s := userManagementServer{
 		userDBservice:   testUserDBService,
 		globalDBService: testGlobalDBService,
-		instanceIDs:     []string{testInstanceID},
-		Intervals: models.Intervals{
+		instances:       instances.NewStaticRegistry(testInstanceID),
+		instanceSettings: testSettingsStore(models.Intervals{
 			TokenExpiryInterval:      time.Second * 2,
 			VerificationCodeLifetime: 60,
-		},
+		}),
 		clients: &models.APIClients{
 			MessagingService: mockMessagingClient,
 			LoggingService:   mockLoggingClient,
@@ -309,10 +313,10 @@ func TestChangeAccountIDEmailEndpoint(t *testing.T) {
 	s := userManagementServer{
 		userDBservice:   testUserDBService,
 		globalDBService: testGlobalDBService,
-		Intervals: models.Intervals{
+		instanceSettings: testSettingsStore(models.Intervals{
 			TokenExpiryInterval:      time.Second * 2,
 			VerificationCodeLifetime: 60,
-		},
+		}),
 		clients: &models.APIClients{
 			MessagingService: mockMessagingClient,
 			LoggingService:   mockLoggingClient,
@@ -574,10 +578,10 @@ func TestDeleteAccountEndpoint(t *testing.T) {
 	s := userManagementServer{
 		userDBservice:   testUserDBService,
 		globalDBService: testGlobalDBService,
-		Intervals: models.Intervals{
+		instanceSettings: testSettingsStore(models.Intervals{
 			TokenExpiryInterval:      time.Second * 2,
 			VerificationCodeLifetime: 60,
-		},
+		}),
 		clients: &models.APIClients{
 			MessagingService: mockMessagingClient,
 			LoggingService:   mockLoggingClient,
@@ -651,27 +655,222 @@ func TestDeleteAccountEndpoint(t *testing.T) {
 			gomock.Any(),
 		).Return(nil, nil)
 
-		mockMessagingClient.EXPECT().SendInstantEmail(
+		req := &api.UserReference{
+			Token: &api_types.TokenInfos{
+				Id:         testUsers[0].ID.Hex(),
+				InstanceId: testInstanceID,
+			},
+			UserId: testUsers[0].ID.Hex(),
+		}
+		if _, err := testGlobalDBService.AddTempToken(models.TempToken{
+			UserID:     testUsers[0].ID.Hex(),
+			InstanceID: testInstanceID,
+			Purpose:    constants.EMAIL_TYPE_PASSWORD_RESET,
+			Expiration: tokens.GetExpirationTime(time.Hour),
+		}); err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		_, err := s.DeleteAccount(context.Background(), req)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		user, err := testUserDBService.GetUserByID(testInstanceID, testUsers[0].ID.Hex())
+		if err != nil || !user.Account.IsPendingDeletion() {
+			t.Errorf("user should be pending deletion: %v", err)
+		}
+		tempTokens, err := testGlobalDBService.GetTempTokenForUser(testInstanceID, testUsers[0].ID.Hex(), "")
+		if err != nil || len(tempTokens) != 1 || tempTokens[0].Purpose != tokenPurposeRestoreAccount {
+			t.Errorf("only the restore token should be left: %v %v", tempTokens, err)
+		}
+
+		_, err = s.DeleteAccount(context.Background(), req)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "account deletion already requested")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("restore account", func(t *testing.T) {
+		_, err := s.RestoreAccount(context.Background(), &api.TempToken{Token: "wrong"})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "invalid token")
+		if !ok {
+			t.Error(msg)
+		}
+
+		restoreTokens, err := testGlobalDBService.GetTempTokenForUser(testInstanceID, testUsers[0].ID.Hex(), tokenPurposeRestoreAccount)
+		if err != nil || len(restoreTokens) != 1 {
+			t.Errorf("unexpected restore tokens: %v %v", restoreTokens, err)
+			return
+		}
+
+		mockLoggingClient.EXPECT().SaveLogEvent(
 			gomock.Any(),
 			gomock.Any(),
 		).Return(nil, nil)
+		_, err = s.RestoreAccount(context.Background(), &api.TempToken{Token: restoreTokens[0].Token})
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		user, err := testUserDBService.GetUserByID(testInstanceID, testUsers[0].ID.Hex())
+		if err != nil || user.Account.IsPendingDeletion() {
+			t.Errorf("user should be restored: %v", err)
+		}
+		_, err = s.RestoreAccount(context.Background(), &api.TempToken{Token: restoreTokens[0].Token})
+		ok, msg = shouldHaveGrpcErrorStatus(err, "invalid token")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("by admin", func(t *testing.T) {
+		// log events of the erasure and of the admin
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil).Times(2)
 
 		req := &api.UserReference{
 			Token: &api_types.TokenInfos{
 				Id:         testUsers[0].ID.Hex(),
 				InstanceId: testInstanceID,
+				Payload: map[string]string{
+					"roles": "PARTICIPANT,ADMIN",
+				},
 			},
-			UserId: testUsers[0].ID.Hex(),
+			UserId: testUsers[1].ID.Hex(),
 		}
-		_, err := s.DeleteAccount(context.Background(), req)
+		resp, err := s.DeleteAccount(context.Background(), req)
 		if err != nil {
 			t.Errorf("unexpected error: %s", err.Error())
 			return
 		}
-		_, err = testUserDBService.GetUserByID(testInstanceID, testUsers[0].ID.Hex())
-		if err == nil {
+		if resp.Msg != "user deleted" {
+			t.Errorf("unexpected response: %s", resp)
+		}
+		if _, err := testUserDBService.GetUserByID(testInstanceID, testUsers[1].ID.Hex()); err == nil {
 			t.Error("user should not exist")
 		}
+		erasure, err := testUserDBService.GetErasureForUser(testInstanceID, testUsers[1].ID.Hex())
+		if err != nil || erasure.Status != models.ERASURE_STATUS_DONE || erasure.RequestedBy != req.Token.Id {
+			t.Errorf("unexpected erasure: %v %v", erasure, err)
+		}
+
+		_, err = s.DeleteAccount(context.Background(), req)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "user not found")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+}
+
+func TestAnonymizeAccountEndpoint(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockLoggingClient := loggingMock.NewMockLoggingServiceApiClient(mockCtrl)
+
+	s := userManagementServer{
+		userDBservice:    testUserDBService,
+		globalDBService:  testGlobalDBService,
+		instanceSettings: testSettingsStore(models.Intervals{}),
+		clients: &models.APIClients{
+			LoggingService: mockLoggingClient,
+		},
+	}
+
+	testUsers, err := addTestUsers([]models.User{
+		{
+			Account: models.Account{
+				Type:              "email",
+				AccountID:         "anonymize_user_1@test.com",
+				PreferredLanguage: "de",
+			},
+			Profiles: []models.Profile{
+				{ID: primitive.NewObjectID(), Alias: "anonymize_user_1@test.com", MainProfile: true},
+			},
+			ContactInfos: []models.ContactInfo{
+				{ID: primitive.NewObjectID(), Type: "email", Email: "anonymize_user_1@test.com"},
+			},
+		},
+	})
+	if err != nil {
+		t.Errorf("failed to create testusers: %s", err.Error())
+		return
+	}
+	adminToken := &api_types.TokenInfos{
+		Id:         "testadmin",
+		InstanceId: testInstanceID,
+		Payload: map[string]string{
+			"roles": "PARTICIPANT,ADMIN",
+		},
+	}
+
+	t.Run("without payload", func(t *testing.T) {
+		_, err := s.AnonymizeAccount(context.Background(), nil)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "missing argument")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("with non admin user", func(t *testing.T) {
+		_, err := s.AnonymizeAccount(context.Background(), &api.UserReference{
+			Token: &api_types.TokenInfos{
+				Id:         testUsers[0].ID.Hex(),
+				InstanceId: testInstanceID,
+				Payload:    map[string]string{"roles": "PARTICIPANT"},
+			},
+			UserId: testUsers[0].ID.Hex(),
+		})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "permission denied")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("with unknown user", func(t *testing.T) {
+		_, err := s.AnonymizeAccount(context.Background(), &api.UserReference{Token: adminToken, UserId: primitive.NewObjectID().Hex()})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "user not found")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("anonymize account", func(t *testing.T) {
+		// log events of the erasure and of the admin
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil).Times(2)
+
+		req := &api.UserReference{Token: adminToken, UserId: testUsers[0].ID.Hex()}
+		resp, err := s.AnonymizeAccount(context.Background(), req)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if resp.Msg != "user anonymized" {
+			t.Errorf("unexpected response: %s", resp)
+		}
+		user, err := testUserDBService.GetUserByID(testInstanceID, req.UserId)
+		if err != nil {
+			t.Errorf("user should be kept: %v", err)
+			return
+		}
+		if !user.Account.IsAnonymized() || user.Account.AccountID == testUsers[0].Account.AccountID || user.Account.PreferredLanguage != "" {
+			t.Errorf("unexpected account: %+v", user.Account)
+		}
+		if len(user.Profiles) != 1 || user.Profiles[0].ID != testUsers[0].Profiles[0].ID || user.Profiles[0].Alias != "" || len(user.ContactInfos) != 0 {
+			t.Errorf("unexpected user: %+v", user)
+		}
+
+		_, err = s.AnonymizeAccount(context.Background(), req)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "account already anonymized")
+		if !ok {
+			t.Error(msg)
+		}
 	})
 }
 
@@ -679,10 +878,10 @@ func TestChangePreferredLanguageEndpoint(t *testing.T) {
 	s := userManagementServer{
 		userDBservice:   testUserDBService,
 		globalDBService: testGlobalDBService,
-		Intervals: models.Intervals{
+		instanceSettings: testSettingsStore(models.Intervals{
 			TokenExpiryInterval:      time.Second * 2,
 			VerificationCodeLifetime: 60,
-		},
+		}),
 	}
 	testUsers, err := addTestUsers([]models.User{
 		{
@@ -744,10 +943,10 @@ func TestSaveProfileEndpoint(t *testing.T) {
 	s := userManagementServer{
 		userDBservice:   testUserDBService,
 		globalDBService: testGlobalDBService,
-		Intervals: models.Intervals{
+		instanceSettings: testSettingsStore(models.Intervals{
 			TokenExpiryInterval:      time.Second * 2,
 			VerificationCodeLifetime: 60,
-		},
+		}),
 		clients: &models.APIClients{
 			LoggingService: mockLoggingClient,
 		},
@@ -838,10 +1037,10 @@ func TestRemoveProfileEndpoint(t *testing.T) {
 	s := userManagementServer{
 		userDBservice:   testUserDBService,
 		globalDBService: testGlobalDBService,
-		Intervals: models.Intervals{
+		instanceSettings: testSettingsStore(models.Intervals{
 			TokenExpiryInterval:      time.Second * 2,
 			VerificationCodeLifetime: 60,
-		},
+		}),
 		clients: &models.APIClients{
 			LoggingService: mockLoggingClient,
 		},
@@ -941,15 +1140,177 @@ func TestRemoveProfileEndpoint(t *testing.T) {
 	})
 }
 
-func TestUpdateContactPreferencesEndpoint(t *testing.T) {
+func TestConsentEndpoints(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockLoggingClient := loggingMock.NewMockLoggingServiceApiClient(mockCtrl)
+
 	s := userManagementServer{
 		userDBservice:   testUserDBService,
 		globalDBService: testGlobalDBService,
-		Intervals: models.Intervals{
+		instanceSettings: testSettingsStore(models.Intervals{
 			TokenExpiryInterval:      time.Second * 2,
 			VerificationCodeLifetime: 60,
+		}),
+		clients: &models.APIClients{
+			LoggingService: mockLoggingClient,
 		},
 	}
+	testUsers, err := addTestUsers([]models.User{
+		{
+			Account: models.Account{
+				Type:      "email",
+				AccountID: "test_for_consent@test.com",
+			},
+			Profiles: []models.Profile{
+				{
+					ID:    primitive.NewObjectID(),
+					Alias: "main",
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Errorf("failed to create testusers: %s", err.Error())
+		return
+	}
+	profileID := testUsers[0].Profiles[0].ID.Hex()
+	token := api_types.TokenInfos{
+		Id:         testUsers[0].ID.Hex(),
+		InstanceId: testInstanceID,
+	}
+	settings := instancesettings.Settings{RequiredConsentVersions: map[string]string{"participation": "2"}}
+
+	t.Run("without payload", func(t *testing.T) {
+		_, err := s.RecordConsent(context.Background(), nil)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "missing argument")
+		if !ok {
+			t.Error(msg)
+		}
+		_, err = s.WithdrawConsent(context.Background(), &api.ConsentMsg{Token: &token, ProfileId: profileID})
+		ok, msg = shouldHaveGrpcErrorStatus(err, "missing argument")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("with wrong profile id", func(t *testing.T) {
+		_, err := s.RecordConsent(context.Background(), &api.ConsentMsg{
+			Token: &token, ProfileId: "wrong id", ConsentType: "participation", Version: "2",
+		})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "profile with given ID not found")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("record consent", func(t *testing.T) {
+		if needed := consentsNeeded(settings, testUsers[0]); len(needed) != 1 || needed[0].ProfileId != profileID || needed[0].RequiredVersion != "2" {
+			t.Errorf("consent should be needed: %v", needed)
+		}
+
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil).Times(2)
+
+		for _, version := range []string{"1", "2"} {
+			resp, err := s.RecordConsent(context.Background(), &api.ConsentMsg{
+				Token: &token, ProfileId: profileID, ConsentType: "participation", Version: version, Channel: "web",
+			})
+			if err != nil {
+				t.Errorf("unexpected error: %s", err.Error())
+				return
+			}
+			records := resp.Profiles[0].ConsentRecords
+			if records[len(records)-1].Version != version || records[len(records)-1].AcceptedAt == 0 {
+				t.Errorf("unexpected records: %v", records)
+			}
+		}
+
+		_, err := s.RecordConsent(context.Background(), &api.ConsentMsg{
+			Token: &token, ProfileId: profileID, ConsentType: "participation", Version: "2",
+		})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "consent already given")
+		if !ok {
+			t.Error(msg)
+		}
+
+		user, err := testUserDBService.GetUserByID(testInstanceID, token.Id)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if needed := consentsNeeded(settings, user); len(needed) != 0 {
+			t.Errorf("no consent should be needed: %v", needed)
+		}
+	})
+
+	t.Run("profile update keeps consents", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil)
+
+		resp, err := s.SaveProfile(context.Background(), &api.ProfileRequest{
+			Token:   &token,
+			Profile: &api.Profile{Id: profileID, Alias: "renamed"},
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if len(resp.Profiles[0].ConsentRecords) != 2 {
+			t.Errorf("consent records should be kept: %v", resp.Profiles[0])
+		}
+	})
+
+	t.Run("withdraw consent", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil)
+
+		resp, err := s.WithdrawConsent(context.Background(), &api.ConsentMsg{
+			Token: &token, ProfileId: profileID, ConsentType: "participation",
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		records := resp.Profiles[0].ConsentRecords
+		if len(records) != 2 || records[0].WithdrawnAt != 0 || records[1].WithdrawnAt == 0 {
+			t.Errorf("only the current consent should be withdrawn: %v", records)
+		}
+
+		_, err = s.WithdrawConsent(context.Background(), &api.ConsentMsg{
+			Token: &token, ProfileId: profileID, ConsentType: "participation",
+		})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "consent not given")
+		if !ok {
+			t.Error(msg)
+		}
+
+		user, err := testUserDBService.GetUserByID(testInstanceID, token.Id)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if needed := consentsNeeded(settings, user); len(needed) != 1 {
+			t.Errorf("withdrawn consent should be needed: %v", needed)
+		}
+	})
+}
+
+func TestUpdateContactPreferencesEndpoint(t *testing.T) {
+	s := userManagementServer{
+		userDBservice:   testUserDBService,
+		globalDBService: testGlobalDBService,
+		instanceSettings: testSettingsStore(models.Intervals{
+			TokenExpiryInterval:      time.Second * 2,
+			VerificationCodeLifetime: 60,
+		}),
+	}
 	testUsers, err := addTestUsers([]models.User{
 		{
 			Account: models.Account{
@@ -1012,10 +1373,10 @@ func TestUseUnsubscribeTokenEndpoint(t *testing.T) {
 	s := userManagementServer{
 		userDBservice:   testUserDBService,
 		globalDBService: testGlobalDBService,
-		Intervals: models.Intervals{
+		instanceSettings: testSettingsStore(models.Intervals{
 			TokenExpiryInterval:      time.Second * 2,
 			VerificationCodeLifetime: 60,
-		},
+		}),
 	}
 	testUsers, err := addTestUsers([]models.User{
 		{
@@ -1110,10 +1471,10 @@ func TestAddEmailEndpoint(t *testing.T) {
 	s := userManagementServer{
 		userDBservice:   testUserDBService,
 		globalDBService: testGlobalDBService,
-		Intervals: models.Intervals{
+		instanceSettings: testSettingsStore(models.Intervals{
 			TokenExpiryInterval:      time.Second * 2,
 			VerificationCodeLifetime: 60,
-		},
+		}),
 		clients: &models.APIClients{
 			MessagingService: mockMessagingClient,
 		},
@@ -1205,10 +1566,10 @@ func TestRemoveEmailEndpoint(t *testing.T) {
 	s := userManagementServer{
 		userDBservice:   testUserDBService,
 		globalDBService: testGlobalDBService,
-		Intervals: models.Intervals{
+		instanceSettings: testSettingsStore(models.Intervals{
 			TokenExpiryInterval:      time.Second * 2,
 			VerificationCodeLifetime: 60,
-		},
+		}),
 	}
 	testUsers, err := addTestUsers([]models.User{
 		{
@@ -12,6 +12,35 @@ const (
 	allowedVerificationCodeAttempts = 3
 
 	userCreationTimestampOffset = 7 * 24 * 3600 // consider user deletion only after this time, when created by admin
+	dataExportTokenLifetime     = 24 * 3600     // validity of the emailed link to download a data export, seconds
 
-	maximumProfilesAllowed = 6
+	defaultFailedEmailsLimit = 100
+	defaultJobRunsLimit      = 20
+)
+
+const (
+	logEventExternalIdentityLinked   = "EXTERNAL IDENTITY LINKED"
+	logEventExternalIdentityUnlinked = "EXTERNAL IDENTITY UNLINKED"
+	logEventFailedEmailResent        = "FAILED EMAIL RESENT"
+	logEventInstanceSettingsUpdated  = "INSTANCE SETTINGS UPDATED"
+	logEventJobTriggered             = "JOB TRIGGERED"
+	logEventJobDryRun                = "JOB DRY RUN"
+	logEventAccountDeletionRequested = "ACCOUNT DELETION REQUESTED"
+	logEventAccountRestored          = "ACCOUNT RESTORED"
+	logEventAccountDeletedByAdmin    = "ACCOUNT DELETED BY ADMIN"
+	logEventAccountAnonymizedByAdmin = "ACCOUNT ANONYMIZED BY ADMIN"
+	logEventDataExported             = "DATA EXPORTED"
+	logEventConsentRecorded          = "CONSENT RECORDED"
+	logEventConsentWithdrawn         = "CONSENT WITHDRAWN"
+)
+
+const (
+	// tokenPurposeRestoreAccount is sent to participants who requested the deletion of their account
+	tokenPurposeRestoreAccount = "restore-account"
+	// emailTypeAccountDeletionRequested contains the link to restore the account until it is deleted
+	emailTypeAccountDeletionRequested = "account-deletion-requested"
+	// tokenPurposeDataExport lets the user download the export of their data
+	tokenPurposeDataExport = "data-export"
+	// emailTypeDataExport contains the link to download the export
+	emailTypeDataExport = "data-export"
 )
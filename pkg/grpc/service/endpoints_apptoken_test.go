@@ -14,10 +14,10 @@ func TestValidateAppTokenEndpoint(t *testing.T) {
 	s := userManagementServer{
 		userDBservice:   testUserDBService,
 		globalDBService: testGlobalDBService,
-		Intervals: models.Intervals{
+		instanceSettings: testSettingsStore(models.Intervals{
 			TokenExpiryInterval:      time.Second * 2,
 			VerificationCodeLifetime: 60,
-		},
+		}),
 	}
 
 	appToken := models.AppToken{
@@ -17,9 +17,11 @@ import (
 	messageAPI "github.com/influenzanet/messaging-service/pkg/api/messaging_service"
 	"github.com/influenzanet/user-management-service/pkg/api"
 	"github.com/influenzanet/user-management-service/pkg/dbs/userdb"
+	"github.com/influenzanet/user-management-service/pkg/metrics"
 	"github.com/influenzanet/user-management-service/pkg/models"
 	"github.com/influenzanet/user-management-service/pkg/pwhash"
 	"github.com/influenzanet/user-management-service/pkg/tokens"
+	"github.com/influenzanet/user-management-service/pkg/tracing"
 	"github.com/influenzanet/user-management-service/pkg/utils"
 
 	constants "github.com/influenzanet/go-utils/pkg/constants"
@@ -50,7 +52,18 @@ func (s *userManagementServer) SendVerificationCode(ctx context.Context, req *ap
 	req.Email = utils.SanitizeEmail(req.Email)
 	user, err := s.userDBservice.GetUserByAccountID(req.InstanceId, req.Email)
 	if err != nil {
-		logger.Warning.Printf("SECURITY WARNING: login step 1 attempt with wrong email address for %s", req.Email)
+		logger.Warning.Println("SECURITY WARNING: login step 1 attempt with unknown email address")
+		return nil, status.Error(codes.InvalidArgument, "invalid username and/or password")
+	}
+
+	if user.Account.IsDeactivated() {
+		logger.Warning.Printf("SECURITY WARNING: login step 1 attempt on deactivated account %s", user.ID.Hex())
+		s.SaveLogEvent(req.InstanceId, user.ID.Hex(), loggingAPI.LogEventType_SECURITY, constants.LOG_EVENT_LOGIN_ATTEMPT_ON_BLOCKED_ACCOUNT, "reason: account deactivated")
+		return nil, status.Error(codes.InvalidArgument, "invalid username and/or password")
+	}
+	if user.Account.IsPendingDeletion() {
+		logger.Warning.Printf("SECURITY WARNING: login step 1 attempt on account %s pending deletion", user.ID.Hex())
+		s.SaveLogEvent(req.InstanceId, user.ID.Hex(), loggingAPI.LogEventType_SECURITY, constants.LOG_EVENT_LOGIN_ATTEMPT_ON_BLOCKED_ACCOUNT, "reason: account pending deletion")
 		return nil, status.Error(codes.InvalidArgument, "invalid username and/or password")
 	}
 
@@ -63,12 +76,15 @@ func (s *userManagementServer) SendVerificationCode(ctx context.Context, req *ap
 
 	if user.Account.VerificationCode.CreatedAt > time.Now().Unix()-loginVerificationCodeCooldown {
 		s.SaveLogEvent(req.InstanceId, user.ID.Hex(), loggingAPI.LogEventType_SECURITY, constants.LOG_EVENT_LOGIN_ATTEMPT_ON_BLOCKED_ACCOUNT, "try resending verification code too often")
-		logger.Warning.Printf("SECURITY WARNING: resend verification code %s - too many wrong tries recently", req.Email)
+		logger.Warning.Printf("SECURITY WARNING: resend verification code %s - too many wrong tries recently", user.ID.Hex())
 		return nil, status.Error(codes.InvalidArgument, "cannot generate verification code so often")
 	}
 
-	match, err := pwhash.ComparePasswordWithHash(user.Account.Password, req.Password)
-	if err != nil || !match {
+	match, err := s.verifyPassword(ctx, req.InstanceId, &user, req.Password)
+	if err != nil {
+		return nil, err
+	}
+	if !match {
 		logger.Warning.Printf("SECURITY WARNING: login step 1 attempt with wrong password for %s", user.ID.Hex())
 		if err2 := s.userDBservice.SaveFailedLoginAttempt(req.InstanceId, user.ID.Hex()); err != nil {
 			logger.Error.Printf("DB ERROR: unexpected error when updating user: %s ", err2.Error())
@@ -145,7 +161,7 @@ func (s *userManagementServer) AutoValidateTempToken(ctx context.Context, req *a
 
 	user.Account.VerificationCode = models.VerificationCode{
 		Code:      vc,
-		ExpiresAt: time.Now().Unix() + s.Intervals.VerificationCodeLifetime,
+		ExpiresAt: time.Now().Unix() + s.settings(tokenInfos.InstanceID).Intervals.VerificationCodeLifetime,
 	}
 	user, err = s.userDBservice.UpdateUser(tokenInfos.InstanceID, user)
 	if err != nil {
@@ -177,18 +193,29 @@ func (s *userManagementServer) LoginWithEmail(ctx context.Context, req *api.Logi
 		return nil, status.Error(codes.InvalidArgument, "invalid instance ID")
 	}
 
+	settings := s.settings(req.InstanceId)
+
 	req.Email = utils.SanitizeEmail(req.Email)
 	user, err := s.userDBservice.GetUserByAccountID(req.InstanceId, req.Email)
 	if err != nil {
-		logger.Warning.Printf("SECURITY WARNING: login attempt with wrong email address for %s", req.Email)
-		s.SaveLogEvent(req.InstanceId, "", loggingAPI.LogEventType_SECURITY, constants.LOG_EVENT_AUTH_WRONG_ACCOUNT_ID, req.Email)
-		return nil, status.Error(codes.InvalidArgument, "invalid username and/or password")
+		newUser, created, err := s.createUserFromAuthBackend(req.InstanceId, req.Email, req.Password)
+		if err != nil {
+			return nil, err
+		}
+		if !created {
+			logger.Warning.Println("SECURITY WARNING: login attempt with unknown email address")
+			s.SaveLogEvent(req.InstanceId, "", loggingAPI.LogEventType_SECURITY, constants.LOG_EVENT_AUTH_WRONG_ACCOUNT_ID, "reason: unknown account id")
+			metrics.LoginFailed(metrics.LoginMethodEmail, "unknown_account")
+			return nil, status.Error(codes.InvalidArgument, "invalid username and/or password")
+		}
+		user = newUser
 	}
 
 	if utils.HasMoreAttemptsRecently(user.Account.FailedLoginAttempts, allowedPasswordAttempts, loginFailedAttemptWindow) {
-		logger.Warning.Printf("SECURITY WARNING: login attempt blocked for email address for %s - too many wrong tries recently", req.Email)
+		logger.Warning.Printf("SECURITY WARNING: login attempt blocked for email address for %s - too many wrong tries recently", user.ID.Hex())
 
 		s.SaveLogEvent(req.InstanceId, user.ID.Hex(), loggingAPI.LogEventType_SECURITY, constants.LOG_EVENT_LOGIN_ATTEMPT_ON_BLOCKED_ACCOUNT, "")
+		metrics.LoginFailed(metrics.LoginMethodEmail, "too_many_attempts")
 		if err2 := s.userDBservice.SaveFailedLoginAttempt(req.InstanceId, user.ID.Hex()); err2 != nil {
 			logger.Error.Printf("DB ERROR: unexpected error when updating user: %s ", err2.Error())
 		}
@@ -197,22 +224,40 @@ func (s *userManagementServer) LoginWithEmail(ctx context.Context, req *api.Logi
 	}
 
 	if user.Account.Type == models.ACCOUNT_TYPE_EXTERNAL {
-		logger.Warning.Printf("[SECURITY WARNING]: invalid login attempt for external account (%s)", req.Email)
+		logger.Warning.Printf("[SECURITY WARNING]: invalid login attempt for external account (%s)", user.ID.Hex())
 		s.SaveLogEvent(req.InstanceId, user.ID.Hex(), loggingAPI.LogEventType_SECURITY, constants.LOG_EVENT_AUTH_WRONG_ACCOUNT_ID, "reason: account id used for external user")
+		metrics.LoginFailed(metrics.LoginMethodEmail, "external_account")
 		return nil, status.Error(codes.InvalidArgument, "invalid username and/or password")
 	}
 
-	match, err := pwhash.ComparePasswordWithHash(user.Account.Password, req.Password)
-	if err != nil || !match {
+	if user.Account.IsDeactivated() {
+		logger.Warning.Printf("SECURITY WARNING: login attempt on deactivated account %s", user.ID.Hex())
+		s.SaveLogEvent(req.InstanceId, user.ID.Hex(), loggingAPI.LogEventType_SECURITY, constants.LOG_EVENT_LOGIN_ATTEMPT_ON_BLOCKED_ACCOUNT, "reason: account deactivated")
+		metrics.LoginFailed(metrics.LoginMethodEmail, "account_deactivated")
+		return nil, status.Error(codes.InvalidArgument, "invalid username and/or password")
+	}
+	if user.Account.IsPendingDeletion() {
+		logger.Warning.Printf("SECURITY WARNING: login attempt on account %s pending deletion", user.ID.Hex())
+		s.SaveLogEvent(req.InstanceId, user.ID.Hex(), loggingAPI.LogEventType_SECURITY, constants.LOG_EVENT_LOGIN_ATTEMPT_ON_BLOCKED_ACCOUNT, "reason: account pending deletion")
+		metrics.LoginFailed(metrics.LoginMethodEmail, "account_pending_deletion")
+		return nil, status.Error(codes.InvalidArgument, "invalid username and/or password")
+	}
+
+	match, err := s.verifyPassword(ctx, req.InstanceId, &user, req.Password)
+	if err != nil {
+		return nil, err
+	}
+	if !match {
 		logger.Warning.Printf("SECURITY WARNING: login attempt with wrong password for %s", user.ID.Hex())
 		s.SaveLogEvent(req.InstanceId, user.ID.Hex(), loggingAPI.LogEventType_SECURITY, constants.LOG_EVENT_AUTH_WRONG_PASSWORD, "")
+		metrics.LoginFailed(metrics.LoginMethodEmail, "wrong_password")
 		if err2 := s.userDBservice.SaveFailedLoginAttempt(req.InstanceId, user.ID.Hex()); err2 != nil {
 			logger.Error.Printf("DB ERROR: unexpected error when updating user: %s ", err2.Error())
 		}
 		return nil, status.Error(codes.InvalidArgument, "invalid username and/or password")
 	}
 
-	if user.Account.AuthType == "2FA" {
+	if settings.UseSecondFactor(user.Account.AuthType == "2FA") {
 		if req.VerificationCode == "" {
 			// user tries first step
 			if user.Account.VerificationCode.Code == "" || user.Account.VerificationCode.CreatedAt == 0 || user.Account.VerificationCode.ExpiresAt < time.Now().Unix() {
@@ -226,6 +271,7 @@ func (s *userManagementServer) LoginWithEmail(ctx context.Context, req *api.Logi
 					logger.Error.Printf("login: unexpected error %v", err)
 					return nil, status.Error(codes.InvalidArgument, "code generation error")
 				}
+				metrics.SecondFactorChallenged()
 			}
 			return &api.LoginResponse{
 				User: &api.User{
@@ -241,6 +287,7 @@ func (s *userManagementServer) LoginWithEmail(ctx context.Context, req *api.Logi
 			if user.Account.VerificationCode.ExpiresAt < time.Now().Unix() || user.Account.VerificationCode.Code != req.VerificationCode {
 				logger.Warning.Printf("SECURITY WARNING: login attempt with wrong or expired verification code for %s", user.ID.Hex())
 				s.SaveLogEvent(req.InstanceId, user.ID.Hex(), loggingAPI.LogEventType_SECURITY, constants.LOG_EVENT_AUTH_WRONG_VERIFICATION_CODE, "")
+				metrics.LoginFailed(metrics.LoginMethodEmail, "wrong_verification_code")
 				if err2 := s.userDBservice.SaveFailedLoginAttempt(req.InstanceId, user.ID.Hex()); err2 != nil {
 					logger.Error.Printf("DB ERROR: unexpected error when updating user: %s ", err2.Error())
 				}
@@ -290,7 +337,7 @@ func (s *userManagementServer) LoginWithEmail(ctx context.Context, req *api.Logi
 		mainProfileID,
 		currentRoles,
 		req.InstanceId,
-		s.Intervals.TokenExpiryInterval,
+		settings.Intervals.TokenExpiryInterval,
 		username,
 		nil,
 		otherProfileIDs,
@@ -330,15 +377,17 @@ func (s *userManagementServer) LoginWithEmail(ctx context.Context, req *api.Logi
 	}
 
 	s.SaveLogEvent(req.InstanceId, apiUser.Id, loggingAPI.LogEventType_LOG, constants.LOG_EVENT_LOGIN_SUCCESS, "")
+	metrics.LoginSucceeded(metrics.LoginMethodEmail)
 
 	response := &api.LoginResponse{
 		Token: &api.TokenResponse{
 			AccessToken:       token,
 			RefreshToken:      rt,
-			ExpiresIn:         int32(s.Intervals.TokenExpiryInterval / time.Minute),
+			ExpiresIn:         int32(settings.Intervals.TokenExpiryInterval / time.Minute),
 			Profiles:          apiUser.Profiles,
 			SelectedProfileId: mainProfileID,
 			PreferredLanguage: apiUser.Account.PreferredLanguage,
+			ConsentsNeeded:    consentsNeeded(settings, user),
 		},
 		User: user.ToAPI(),
 	}
@@ -357,8 +406,22 @@ func (s *userManagementServer) LoginWithExternalIDP(ctx context.Context, req *ap
 		return nil, status.Error(codes.InvalidArgument, "invalid instance ID")
 	}
 
+	settings := s.settings(req.InstanceId)
+
 	req.Email = utils.SanitizeEmail(req.Email)
-	user, err := s.userDBservice.GetUserByAccountID(req.InstanceId, req.Email)
+	hasFederatedIdentity := req.Issuer != "" && req.Subject != ""
+
+	// look up linked identity first, then fall back to the email address
+	var user models.User
+	var err error
+	isLinked := false
+	if hasFederatedIdentity {
+		user, err = s.userDBservice.GetUserByFederatedIdentity(req.InstanceId, req.Issuer, req.Subject)
+		isLinked = err == nil
+	}
+	if !isLinked {
+		user, err = s.userDBservice.GetUserByAccountID(req.InstanceId, req.Email)
+	}
 	if err != nil {
 		// user does not exists - create user
 		randomPW, err := tokens.GenerateUniqueTokenString()
@@ -398,7 +461,11 @@ func (s *userManagementServer) LoginWithExternalIDP(ctx context.Context, req *ap
 
 		// on which weekday the user will receive the reminder emails
 		user.ContactPreferences.SubscribedToWeekly = false
-		user.ContactPreferences.ReceiveWeeklyMessageDayOfWeek = int32(s.weekdayStrategy.Weekday())
+		user.ContactPreferences.ReceiveWeeklyMessageDayOfWeek = int32(settings.WeekdayStrategy.Weekday())
+
+		if hasFederatedIdentity {
+			_ = user.AddFederatedIdentity(req.Issuer, req.Subject)
+		}
 
 		id, err := s.userDBservice.AddUser(req.InstanceId, user)
 		if err != nil {
@@ -408,10 +475,35 @@ func (s *userManagementServer) LoginWithExternalIDP(ctx context.Context, req *ap
 		user.ID, _ = primitive.ObjectIDFromHex(id)
 
 	} else {
-		if user.Account.Type != models.ACCOUNT_TYPE_EXTERNAL {
-			logger.Error.Printf("[ERROR] LoginWithExternalIDP: wrong account type '%s' for %v", user.Account.Type, req)
-			s.SaveLogEvent(req.InstanceId, user.ID.Hex(), loggingAPI.LogEventType_ERROR, constants.LOG_EVENT_AUTH_WRONG_ACCOUNT_ID, "wrong account type for external login: "+user.Account.Type)
-			return nil, status.Error(codes.PermissionDenied, "wrong account type")
+		if user.Account.IsDeactivated() {
+			logger.Warning.Printf("[SECURITY WARNING] LoginWithExternalIDP: login attempt on deactivated account %s", user.ID.Hex())
+			s.SaveLogEvent(req.InstanceId, user.ID.Hex(), loggingAPI.LogEventType_SECURITY, constants.LOG_EVENT_LOGIN_ATTEMPT_ON_BLOCKED_ACCOUNT, "reason: account deactivated")
+			metrics.LoginFailed(metrics.LoginMethodExternalIDP, "account_deactivated")
+			return nil, status.Error(codes.PermissionDenied, "account deactivated")
+		}
+		if user.Account.IsPendingDeletion() {
+			logger.Warning.Printf("[SECURITY WARNING] LoginWithExternalIDP: login attempt on account %s pending deletion", user.ID.Hex())
+			s.SaveLogEvent(req.InstanceId, user.ID.Hex(), loggingAPI.LogEventType_SECURITY, constants.LOG_EVENT_LOGIN_ATTEMPT_ON_BLOCKED_ACCOUNT, "reason: account pending deletion")
+			metrics.LoginFailed(metrics.LoginMethodExternalIDP, "account_pending_deletion")
+			return nil, status.Error(codes.PermissionDenied, "account pending deletion")
+		}
+		if !isLinked {
+			if user.Account.Type != models.ACCOUNT_TYPE_EXTERNAL {
+				logger.Error.Printf("[ERROR] LoginWithExternalIDP: wrong account type '%s' for %v", user.Account.Type, req)
+				s.SaveLogEvent(req.InstanceId, user.ID.Hex(), loggingAPI.LogEventType_ERROR, constants.LOG_EVENT_AUTH_WRONG_ACCOUNT_ID, "wrong account type for external login: "+user.Account.Type)
+				metrics.LoginFailed(metrics.LoginMethodExternalIDP, "wrong_account_type")
+				return nil, status.Error(codes.PermissionDenied, "wrong account type")
+			}
+			// external accounts are bound to the identity they first logged in with
+			if len(user.FederatedIdentities) > 0 {
+				logger.Warning.Printf("[SECURITY WARNING] LoginWithExternalIDP: identity doesn't match the one linked to account %s", user.ID.Hex())
+				s.SaveLogEvent(req.InstanceId, user.ID.Hex(), loggingAPI.LogEventType_SECURITY, constants.LOG_EVENT_AUTH_WRONG_ACCOUNT_ID, "external identity doesn't match the linked one: "+req.Issuer)
+				metrics.LoginFailed(metrics.LoginMethodExternalIDP, "identity_mismatch")
+				return nil, status.Error(codes.PermissionDenied, "identity mismatch")
+			}
+			if hasFederatedIdentity {
+				_ = user.AddFederatedIdentity(req.Issuer, req.Subject)
+			}
 		}
 
 		if !user.HasRole(req.Role) {
@@ -433,7 +525,7 @@ func (s *userManagementServer) LoginWithExternalIDP(ctx context.Context, req *ap
 		mainProfileID,
 		currentRoles,
 		req.InstanceId,
-		s.Intervals.TokenExpiryInterval,
+		settings.Intervals.TokenExpiryInterval,
 		username,
 		nil,
 		otherProfileIDs,
@@ -472,17 +564,19 @@ func (s *userManagementServer) LoginWithExternalIDP(ctx context.Context, req *ap
 		logger.Error.Printf("[ERROR] LoginWithExternalIDP: %s", err.Error())
 	}
 
-	msg := fmt.Sprintf("User: %s\nIDP: %s\nGroup info: %s", req.Idp, req.GroupInfo, user.Account.AccountID)
+	msg := fmt.Sprintf("IDP: %s\nGroup info: %s", req.Idp, req.GroupInfo)
 	s.SaveLogEvent(req.InstanceId, apiUser.Id, loggingAPI.LogEventType_LOG, constants.LOG_EVENT_LOGIN_SUCCESS, msg)
+	metrics.LoginSucceeded(metrics.LoginMethodExternalIDP)
 
 	response := &api.LoginResponse{
 		Token: &api.TokenResponse{
 			AccessToken:       token,
 			RefreshToken:      rt,
-			ExpiresIn:         int32(s.Intervals.TokenExpiryInterval / time.Minute),
+			ExpiresIn:         int32(settings.Intervals.TokenExpiryInterval / time.Minute),
 			Profiles:          apiUser.Profiles,
 			SelectedProfileId: mainProfileID,
 			PreferredLanguage: apiUser.Account.PreferredLanguage,
+			ConsentsNeeded:    consentsNeeded(settings, user),
 		},
 		User: user.ToAPI(),
 	}
@@ -514,17 +608,21 @@ func (s *userManagementServer) SignupWithEmail(ctx context.Context, req *api.Sig
 		return nil, status.Error(codes.InvalidArgument, "invalid instance ID")
 	}
 
+	settings := s.settings(req.InstanceId)
+
 	newUserCount, err := s.userDBservice.CountRecentlyCreatedUsers(req.InstanceId, signupRateLimitWindow)
 	if err != nil {
 		logger.Error.Printf("ERROR: signup - unexpected error when counting: %v", err)
 	} else {
-		if newUserCount > s.newUserCountLimit {
+		if newUserCount > settings.NewUserCountLimit {
 			logger.Warning.Println("ERROR: user creation blocked due to too many registations")
 			return nil, status.Error(codes.Internal, "user creation failed, please try in some minutes again")
 		}
 	}
 
+	_, hashSpan := tracing.StartSpan(ctx, "hash password")
 	password, err := pwhash.HashPassword(req.Password)
+	hashSpan.End()
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -555,7 +653,7 @@ func (s *userManagementServer) SignupWithEmail(ctx context.Context, req *api.Sig
 		},
 	}
 	newUser.AddNewEmail(req.Email, false)
-	if req.Use_2Fa {
+	if settings.UseSecondFactor(req.Use_2Fa) {
 		newUser.Account.AuthType = "2FA"
 	}
 
@@ -565,25 +663,17 @@ func (s *userManagementServer) SignupWithEmail(ctx context.Context, req *api.Sig
 	}
 	// on which weekday the user will receive the reminder emails
 	newUser.ContactPreferences.SubscribedToWeekly = true
-	newUser.ContactPreferences.ReceiveWeeklyMessageDayOfWeek = int32(s.weekdayStrategy.Weekday())
+	newUser.ContactPreferences.ReceiveWeeklyMessageDayOfWeek = int32(settings.WeekdayStrategy.Weekday())
 
-	id, err := s.userDBservice.AddUser(req.InstanceId, newUser)
-	if err != nil {
-		logger.Error.Printf("ERROR: when creating new user: %s", err.Error())
-		return nil, status.Error(codes.Internal, "user creation failed")
-	}
-	newUser.ID, _ = primitive.ObjectIDFromHex(id)
+	newUser.ID = primitive.NewObjectID()
 
 	// TempToken for contact verification:
 	tempTokenInfos := models.TempToken{
-		UserID:     id,
+		UserID:     newUser.ID.Hex(),
 		InstanceID: req.InstanceId,
 		Purpose:    constants.TOKEN_PURPOSE_CONTACT_VERIFICATION,
-		Info: map[string]string{
-			"type":  models.ACCOUNT_TYPE_EMAIL,
-			"email": newUser.Account.AccountID,
-		},
-		Expiration: tokens.GetExpirationTime(s.Intervals.ContactVerificationTokenLifetime),
+		Info:       newUser.ContactVerificationInfos(newUser.Account.AccountID),
+		Expiration: tokens.GetExpirationTime(settings.Intervals.ContactVerificationTokenLifetime),
 	}
 	tempToken, err := s.globalDBService.AddTempToken(tempTokenInfos)
 	if err != nil {
@@ -591,22 +681,23 @@ func (s *userManagementServer) SignupWithEmail(ctx context.Context, req *api.Sig
 		return nil, status.Error(codes.Internal, "failed to create verification token")
 	}
 
-	// ---> Trigger message sending
-	go func(instanceID string, accountID string, tempToken string, preferredLang string) {
-		_, err = s.clients.MessagingService.SendInstantEmail(context.TODO(), &messageAPI.SendEmailReq{
-			InstanceId:  instanceID,
-			To:          []string{accountID},
-			MessageType: constants.EMAIL_TYPE_REGISTRATION,
-			ContentInfos: map[string]string{
-				"token": tempToken,
-			},
-			PreferredLanguage: preferredLang,
-		})
-		if err != nil {
+	// verification email is queued together with the user
+	verificationEmail := models.NewOutgoingEmail(models.OUTGOING_EMAIL_INSTANT, &messageAPI.SendEmailReq{
+		InstanceId:  req.InstanceId,
+		To:          []string{newUser.Account.AccountID},
+		MessageType: constants.EMAIL_TYPE_REGISTRATION,
+		ContentInfos: map[string]string{
+			"token": tempToken,
+		},
+		PreferredLanguage: newUser.Account.PreferredLanguage,
+	})
+	if _, err := s.userDBservice.AddUserWithOutgoingEmails(req.InstanceId, newUser, []models.OutgoingEmail{verificationEmail}); err != nil {
+		logger.Error.Printf("ERROR: when creating new user: %s", err.Error())
+		if err := s.globalDBService.DeleteTempToken(tempToken); err != nil {
 			logger.Error.Printf("SignupWithEmail: %s", err.Error())
 		}
-	}(req.InstanceId, newUser.Account.AccountID, tempToken, newUser.Account.PreferredLanguage)
-	// <---
+		return nil, status.Error(codes.Internal, "user creation failed")
+	}
 
 	var username string
 	if len(newUser.Roles) > 1 || len(newUser.Roles) == 1 && newUser.Roles[0] != "PARTICIPANT" {
@@ -621,7 +712,7 @@ func (s *userManagementServer) SignupWithEmail(ctx context.Context, req *api.Sig
 		apiUser.Profiles[0].Id,
 		newUser.Roles,
 		req.InstanceId,
-		s.Intervals.TokenExpiryInterval,
+		settings.Intervals.TokenExpiryInterval,
 		username,
 		nil,
 		[]string{},
@@ -651,15 +742,16 @@ func (s *userManagementServer) SignupWithEmail(ctx context.Context, req *api.Sig
 		return nil, status.Error(codes.Internal, "user created, but token could not be saved")
 	}
 
-	s.SaveLogEvent(req.InstanceId, newUser.ID.Hex(), loggingAPI.LogEventType_LOG, constants.LOG_EVENT_ACCOUNT_CREATED, newUser.Account.AccountID)
+	s.SaveLogEvent(req.InstanceId, newUser.ID.Hex(), loggingAPI.LogEventType_LOG, constants.LOG_EVENT_ACCOUNT_CREATED, "")
 
 	response := &api.TokenResponse{
 		AccessToken:       token,
 		RefreshToken:      rt,
-		ExpiresIn:         int32(s.Intervals.TokenExpiryInterval / time.Minute),
+		ExpiresIn:         int32(settings.Intervals.TokenExpiryInterval / time.Minute),
 		Profiles:          apiUser.Profiles,
 		SelectedProfileId: apiUser.Profiles[0].Id,
 		PreferredLanguage: apiUser.Account.PreferredLanguage,
+		ConsentsNeeded:    consentsNeeded(settings, newUser),
 	}
 	return response, nil
 }
@@ -685,7 +777,7 @@ func (s *userManagementServer) VerifyContact(ctx context.Context, req *api.TempT
 	}
 
 	cType, ok1 := tokenInfos.Info["type"]
-	email, ok2 := tokenInfos.Info["email"]
+	email, ok2 := user.ContactVerificationAddress(tokenInfos.Info)
 	if !ok1 || !ok2 {
 		return nil, status.Error(codes.InvalidArgument, "missing token info")
 	}
@@ -700,7 +792,7 @@ func (s *userManagementServer) VerifyContact(ctx context.Context, req *api.TempT
 	}
 	user, err = s.userDBservice.UpdateUser(tokenInfos.InstanceID, user)
 
-	s.SaveLogEvent(tokenInfos.InstanceID, tokenInfos.UserID, loggingAPI.LogEventType_LOG, constants.LOG_EVENT_CONTACT_VERIFIED, email)
+	s.SaveLogEvent(tokenInfos.InstanceID, tokenInfos.UserID, loggingAPI.LogEventType_LOG, constants.LOG_EVENT_CONTACT_VERIFIED, cType)
 	return user.ToAPI(), err
 }
 
@@ -729,11 +821,8 @@ func (s *userManagementServer) ResendContactVerification(ctx context.Context, re
 		UserID:     req.Token.Id,
 		InstanceID: req.Token.InstanceId,
 		Purpose:    constants.TOKEN_PURPOSE_CONTACT_VERIFICATION,
-		Info: map[string]string{
-			"type":  models.ACCOUNT_TYPE_EMAIL,
-			"email": ci.Email,
-		},
-		Expiration: tokens.GetExpirationTime(s.Intervals.ContactVerificationTokenLifetime),
+		Info:       user.ContactVerificationInfos(ci.Email),
+		Expiration: tokens.GetExpirationTime(s.settings(req.Token.InstanceId).Intervals.ContactVerificationTokenLifetime),
 	}
 	tempToken, err := s.globalDBService.AddTempToken(tempTokenInfos)
 	if err != nil {
@@ -0,0 +1,198 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	api_types "github.com/influenzanet/go-utils/pkg/api_types"
+	"github.com/influenzanet/user-management-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/authbackend"
+	"github.com/influenzanet/user-management-service/pkg/instances"
+	"github.com/influenzanet/user-management-service/pkg/models"
+	"github.com/influenzanet/user-management-service/pkg/pwhash"
+	"github.com/influenzanet/user-management-service/test/ldapserver"
+	loggingMock "github.com/influenzanet/user-management-service/test/mocks/logging_service"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestLoginWithAuthBackend(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockLoggingClient := loggingMock.NewMockLoggingServiceApiClient(mockCtrl)
+	mockLoggingClient.EXPECT().SaveLogEvent(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+
+	userFilter := "(mail=%s)"
+	directory, err := ldapserver.Start([]ldapserver.Entry{
+		{
+			DN:         "uid=new-admin,dc=test",
+			Password:   "ldap-secret",
+			Attributes: map[string][]string{"mail": {"ldap-new-admin@test.com"}, "memberOf": {"cn=admins,dc=test"}},
+		},
+		{
+			DN:         "uid=researcher,dc=test",
+			Password:   "ldap-secret",
+			Attributes: map[string][]string{"mail": {"ldap-researcher@test.com"}, "memberOf": {"cn=researchers,dc=test"}},
+		},
+		{
+			DN:         "uid=participant,dc=test",
+			Password:   "ldap-secret",
+			Attributes: map[string][]string{"mail": {"ldap-participant@test.com"}, "memberOf": {"cn=researchers,dc=test"}},
+		},
+	}, func(filter string, e ldapserver.Entry) bool {
+		return strings.EqualFold(filter, strings.Replace(userFilter, "%s", e.Attributes["mail"][0], 1))
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer directory.Close()
+
+	registry := authbackend.NewRegistry(authbackend.Config{})
+	registry.Register(testInstanceID, authbackend.NewLDAPBackend(authbackend.LDAPConfig{
+		URL:        directory.URL(),
+		BaseDN:     "dc=test",
+		UserFilter: userFilter,
+		GroupRoleMapping: map[string]string{
+			"cn=admins,dc=test":      "ADMIN",
+			"cn=researchers,dc=test": "RESEARCHER",
+		},
+	}), []string{models.ACCOUNT_TYPE_LDAP, models.ACCOUNT_TYPE_EMAIL}, true)
+
+	s := userManagementServer{
+		userDBservice:   testUserDBService,
+		globalDBService: testGlobalDBService,
+		instances:       instances.NewStaticRegistry(testInstanceID),
+		instanceSettings: testSettingsStore(models.Intervals{
+			TokenExpiryInterval:      time.Second * 2,
+			VerificationCodeLifetime: 60,
+		}),
+		clients: &models.APIClients{
+			LoggingService: mockLoggingClient,
+		},
+		authBackends: registry,
+	}
+
+	localPw := "SuperSecurePassword123!§$"
+	hashedPw, err := pwhash.HashPassword(localPw)
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+	testUsers, err := addTestUsers([]models.User{
+		{
+			Account: models.Account{
+				Type:               models.ACCOUNT_TYPE_EMAIL,
+				AccountID:          "ldap-researcher@test.com",
+				AccountConfirmedAt: time.Now().Unix(),
+				Password:           hashedPw,
+			},
+			Roles:    []string{"PARTICIPANT", "ADMIN"},
+			Profiles: []models.Profile{{ID: primitive.NewObjectID(), MainProfile: true}},
+		},
+		{
+			Account: models.Account{
+				Type:               models.ACCOUNT_TYPE_EMAIL,
+				AccountID:          "ldap-participant@test.com",
+				AccountConfirmedAt: time.Now().Unix(),
+				Password:           hashedPw,
+			},
+			Roles:    []string{"PARTICIPANT"},
+			Profiles: []models.Profile{{ID: primitive.NewObjectID(), MainProfile: true}},
+		},
+	})
+	if err != nil {
+		t.Errorf("failed to create testusers: %s", err.Error())
+		return
+	}
+
+	t.Run("unknown user with directory account", func(t *testing.T) {
+		resp, err := s.LoginWithEmail(context.Background(), &api.LoginWithEmailMsg{
+			Email:      "ldap-new-admin@test.com",
+			Password:   "ldap-secret",
+			InstanceId: testInstanceID,
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if resp.Token == nil || resp.Token.AccessToken == "" {
+			t.Error("token missing")
+		}
+		user, err := testUserDBService.GetUserByAccountID(testInstanceID, "ldap-new-admin@test.com")
+		if err != nil {
+			t.Errorf("user not created: %v", err)
+			return
+		}
+		if user.Account.Type != models.ACCOUNT_TYPE_LDAP || len(user.Roles) != 1 || user.Roles[0] != "ADMIN" {
+			t.Errorf("unexpected user: %+v", user)
+		}
+	})
+
+	t.Run("unknown user with wrong directory password", func(t *testing.T) {
+		_, err := s.LoginWithEmail(context.Background(), &api.LoginWithEmailMsg{
+			Email:      "ldap-new-admin@test.com",
+			Password:   "wrong",
+			InstanceId: testInstanceID,
+		})
+		if ok, msg := shouldHaveGrpcErrorStatus(err, "invalid username and/or password"); !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("staff email account with local password", func(t *testing.T) {
+		_, err := s.LoginWithEmail(context.Background(), &api.LoginWithEmailMsg{
+			Email:      testUsers[0].Account.AccountID,
+			Password:   localPw,
+			InstanceId: testInstanceID,
+		})
+		if ok, msg := shouldHaveGrpcErrorStatus(err, "invalid username and/or password"); !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("staff email account with directory password", func(t *testing.T) {
+		_, err := s.LoginWithEmail(context.Background(), &api.LoginWithEmailMsg{
+			Email:      testUsers[0].Account.AccountID,
+			Password:   "ldap-secret",
+			InstanceId: testInstanceID,
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		user, err := testUserDBService.GetUserByID(testInstanceID, testUsers[0].ID.Hex())
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if len(user.Roles) != 2 || !user.HasRole("PARTICIPANT") || !user.HasRole("RESEARCHER") {
+			t.Errorf("roles not synced: %v", user.Roles)
+		}
+	})
+
+	t.Run("participant uses local password", func(t *testing.T) {
+		_, err := s.LoginWithEmail(context.Background(), &api.LoginWithEmailMsg{
+			Email:      testUsers[1].Account.AccountID,
+			Password:   localPw,
+			InstanceId: testInstanceID,
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("change password of directory account", func(t *testing.T) {
+		_, err := s.ChangePassword(context.Background(), &api.PasswordChangeMsg{
+			Token:       &api_types.TokenInfos{Id: testUsers[0].ID.Hex(), InstanceId: testInstanceID},
+			OldPassword: "ldap-secret",
+			NewPassword: localPw,
+		})
+		if status.Code(err) != codes.FailedPrecondition {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+}
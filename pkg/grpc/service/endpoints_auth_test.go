@@ -9,6 +9,7 @@ import (
 	api_types "github.com/influenzanet/go-utils/pkg/api_types"
 	"github.com/influenzanet/go-utils/pkg/constants"
 	"github.com/influenzanet/user-management-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/instances"
 	"github.com/influenzanet/user-management-service/pkg/models"
 	"github.com/influenzanet/user-management-service/pkg/pwhash"
 	"github.com/influenzanet/user-management-service/pkg/tokens"
@@ -27,15 +28,15 @@ func TestSendVerificationCode(t *testing.T) {
 	s := userManagementServer{
 		userDBservice:   testUserDBService,
 		globalDBService: testGlobalDBService,
-		instanceIDs:     []string{testInstanceID},
+		instances:       instances.NewStaticRegistry(testInstanceID),
 		clients: &models.APIClients{
 			MessagingService: mockMessagingClient,
 			LoggingService:   mockLoggingClient,
 		},
-		Intervals: models.Intervals{
+		instanceSettings: testSettingsStore(models.Intervals{
 			TokenExpiryInterval:      time.Second * 2,
 			VerificationCodeLifetime: 60,
-		},
+		}),
 	}
 
 	// Create Test User
@@ -118,10 +119,10 @@ func TestAutoValidateTempToken(t *testing.T) {
 	s := userManagementServer{
 		userDBservice:   testUserDBService,
 		globalDBService: testGlobalDBService,
-		Intervals: models.Intervals{
+		instanceSettings: testSettingsStore(models.Intervals{
 			TokenExpiryInterval:      time.Second * 2,
 			VerificationCodeLifetime: 60,
-		},
+		}),
 	}
 
 	// Create Test User
@@ -260,7 +261,7 @@ func TestAutoValidateTempToken(t *testing.T) {
 
 	t.Run("correct temptoken with access token same user", func(t *testing.T) {
 		accessToken, err := tokens.GenerateNewToken(
-			testUser.ID.Hex(), true, "profid", []string{}, testInstanceID, s.Intervals.TokenExpiryInterval, "", nil, []string{},
+			testUser.ID.Hex(), true, "profid", []string{}, testInstanceID, s.settings(testInstanceID).Intervals.TokenExpiryInterval, "", nil, []string{},
 		)
 		if err != nil {
 			t.Errorf("unexpected error: %v", err)
@@ -287,7 +288,7 @@ func TestAutoValidateTempToken(t *testing.T) {
 
 	t.Run("correct temptoken with access token different user", func(t *testing.T) {
 		accessToken, err := tokens.GenerateNewToken(
-			"different", true, "profid", []string{}, testInstanceID, s.Intervals.TokenExpiryInterval, "", nil, []string{},
+			"different", true, "profid", []string{}, testInstanceID, s.settings(testInstanceID).Intervals.TokenExpiryInterval, "", nil, []string{},
 		)
 		if err != nil {
 			t.Errorf("unexpected error: %v", err)
@@ -321,11 +322,11 @@ func TestLogin(t *testing.T) {
 	s := userManagementServer{
 		userDBservice:   testUserDBService,
 		globalDBService: testGlobalDBService,
-		instanceIDs:     []string{testInstanceID},
-		Intervals: models.Intervals{
+		instances:       instances.NewStaticRegistry(testInstanceID),
+		instanceSettings: testSettingsStore(models.Intervals{
 			TokenExpiryInterval:      time.Second * 2,
 			VerificationCodeLifetime: 60,
-		},
+		}),
 		clients: &models.APIClients{
 			LoggingService: mockLoggingClient,
 		},
@@ -587,16 +588,15 @@ func TestSignupWithEmail(t *testing.T) {
 	s := userManagementServer{
 		userDBservice:   testUserDBService,
 		globalDBService: testGlobalDBService,
-		instanceIDs:     []string{testInstanceID},
-		Intervals: models.Intervals{
+		instances:       instances.NewStaticRegistry(testInstanceID),
+		instanceSettings: testSettingsStore(models.Intervals{
 			TokenExpiryInterval:      time.Second * 2,
 			VerificationCodeLifetime: 60,
-		},
+		}),
 		clients: &models.APIClients{
 			MessagingService: mockMessagingClient,
 			LoggingService:   mockLoggingClient,
 		},
-		newUserCountLimit: 100,
 	}
 
 	wrongEmailFormatNewUserReq := &api.SignupWithEmailMsg{
@@ -653,11 +653,6 @@ func TestSignupWithEmail(t *testing.T) {
 	})
 
 	t.Run("with valid fields", func(t *testing.T) {
-		mockMessagingClient.EXPECT().SendInstantEmail(
-			gomock.Any(),
-			gomock.Any(),
-		).Return(nil, nil)
-
 		mockLoggingClient.EXPECT().SaveLogEvent(
 			gomock.Any(),
 			gomock.Any(),
@@ -683,10 +678,6 @@ func TestSignupWithEmail(t *testing.T) {
 	})
 
 	t.Run("with duplicate user (same email)", func(t *testing.T) {
-		mockMessagingClient.EXPECT().SendInstantEmail(
-			gomock.Any(),
-			gomock.Any(),
-		).Return(nil, nil)
 		mockLoggingClient.EXPECT().SaveLogEvent(
 			gomock.Any(),
 			gomock.Any(),
@@ -721,10 +712,10 @@ func TestVerifyAccountEndpoint(t *testing.T) {
 	s := userManagementServer{
 		userDBservice:   testUserDBService,
 		globalDBService: testGlobalDBService,
-		Intervals: models.Intervals{
+		instanceSettings: testSettingsStore(models.Intervals{
 			TokenExpiryInterval:      time.Second * 2,
 			VerificationCodeLifetime: 60,
-		},
+		}),
 		clients: &models.APIClients{
 			LoggingService: mockLoggingClient,
 		},
@@ -824,10 +815,7 @@ func TestVerifyAccountEndpoint(t *testing.T) {
 			UserID:     testUsers[0].ID.Hex(),
 			InstanceID: testInstanceID,
 			Purpose:    constants.TOKEN_PURPOSE_CONTACT_VERIFICATION,
-			Info: map[string]string{
-				"type":  "email",
-				"email": testUsers[0].ContactInfos[1].Email,
-			},
+			Info:       testUsers[0].ContactVerificationInfos(testUsers[0].ContactInfos[1].Email),
 			Expiration: tokens.GetExpirationTime(time.Hour * 24 * 30),
 		}
 		tempToken, err := s.globalDBService.AddTempToken(tempTokenInfos)
@@ -863,6 +851,7 @@ func TestVerifyAccountEndpoint(t *testing.T) {
 			UserID:     testUsers[0].ID.Hex(),
 			InstanceID: testInstanceID,
 			Purpose:    constants.TOKEN_PURPOSE_CONTACT_VERIFICATION,
+			// tokens created before hold the address itself
 			Info: map[string]string{
 				"type":  "email",
 				"email": testUsers[0].Account.AccountID,
@@ -901,10 +890,10 @@ func TestResendContactVerificationEndpoint(t *testing.T) {
 	s := userManagementServer{
 		userDBservice:   testUserDBService,
 		globalDBService: testGlobalDBService,
-		Intervals: models.Intervals{
+		instanceSettings: testSettingsStore(models.Intervals{
 			TokenExpiryInterval:      time.Second * 2,
 			VerificationCodeLifetime: 60,
-		},
+		}),
 		clients: &models.APIClients{
 			MessagingService: mockMessagingClient,
 		},
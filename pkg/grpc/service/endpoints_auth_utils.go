@@ -7,9 +7,18 @@ import (
 
 	"github.com/coneno/logger"
 	constants "github.com/influenzanet/go-utils/pkg/constants"
+	loggingAPI "github.com/influenzanet/logging-service/pkg/api"
 	messageAPI "github.com/influenzanet/messaging-service/pkg/api/messaging_service"
+	"github.com/influenzanet/user-management-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/authbackend"
+	"github.com/influenzanet/user-management-service/pkg/instancesettings"
 	"github.com/influenzanet/user-management-service/pkg/models"
+	"github.com/influenzanet/user-management-service/pkg/pwhash"
 	"github.com/influenzanet/user-management-service/pkg/tokens"
+	"github.com/influenzanet/user-management-service/pkg/tracing"
+	"github.com/influenzanet/user-management-service/pkg/utils"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.opentelemetry.io/otel/attribute"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -25,7 +34,7 @@ func (s *userManagementServer) generateAndSendVerificationCode(instanceID string
 		Code:      vc,
 		Attempts:  0,
 		CreatedAt: time.Now().Unix(),
-		ExpiresAt: time.Now().Unix() + s.Intervals.VerificationCodeLifetime,
+		ExpiresAt: time.Now().Unix() + s.settings(instanceID).Intervals.VerificationCodeLifetime,
 	}
 	user, err = s.userDBservice.UpdateUser(instanceID, user)
 	if err != nil {
@@ -59,10 +68,153 @@ func (s *userManagementServer) sendVerificationEmail(instanceID string, accountI
 }
 
 func (s *userManagementServer) isInstanceIDAllowed(instanceID string) bool {
-	for _, id := range s.instanceIDs {
-		if id == instanceID {
-			return true
+	return s.instances.Has(instanceID)
+}
+
+// verifyPassword checks the password with the auth backend configured for the account, or with the local
+// password hash. Roles managed by the backend are synced to the user. Returns false for wrong credentials.
+func (s *userManagementServer) verifyPassword(ctx context.Context, instanceID string, user *models.User, password string) (bool, error) {
+	_, span := tracing.StartSpan(ctx, "verify password")
+	defer span.End()
+
+	backend := s.authBackends.BackendForUser(instanceID, *user)
+	if backend == nil {
+		match, err := pwhash.ComparePasswordWithHash(user.Account.Password, password)
+		return err == nil && match, nil
+	}
+	span.SetAttributes(attribute.String("auth.backend", backend.Name()))
+
+	res, err := backend.Authenticate(user.Account.AccountID, password)
+	if err == authbackend.ErrInvalidCredentials {
+		return false, nil
+	}
+	if err == authbackend.ErrNoRoleMapped {
+		logger.Warning.Printf("SECURITY WARNING: %s login for %s denied - no role mapped", backend.Name(), user.ID.Hex())
+		return false, nil
+	}
+	if err != nil {
+		logger.Error.Printf("auth backend %s: %v", backend.Name(), err)
+		return false, status.Error(codes.Unavailable, "authentication backend not available")
+	}
+
+	if res.Roles != nil {
+		s.syncBackendRoles(instanceID, user, res.Roles)
+	}
+	return true, nil
+}
+
+// syncBackendRoles replaces the non-participant roles of the user with the ones resolved by the auth backend
+func (s *userManagementServer) syncBackendRoles(instanceID string, user *models.User, backendRoles []string) {
+	roles := []string{}
+	for _, r := range user.Roles {
+		if r == constants.USER_ROLE_PARTICIPANT {
+			roles = append(roles, r)
+		}
+	}
+	roles = append(roles, backendRoles...)
+
+	changed := len(roles) != len(user.Roles)
+	for _, r := range roles {
+		if !user.HasRole(r) {
+			changed = true
+			s.SaveLogEvent(instanceID, user.ID.Hex(), loggingAPI.LogEventType_SECURITY, constants.LOG_EVENT_ACCOUNT_ROLE_ADDED, r+" (auth backend)")
+		}
+	}
+	if !changed {
+		return
+	}
+	newUser := models.User{Roles: roles}
+	for _, r := range user.Roles {
+		if !newUser.HasRole(r) {
+			s.SaveLogEvent(instanceID, user.ID.Hex(), loggingAPI.LogEventType_SECURITY, constants.LOG_EVENT_ACCOUNT_ROLE_REMOVED, r+" (auth backend)")
+		}
+	}
+
+	user.Roles = roles
+	updatedUser, err := s.userDBservice.UpdateUser(instanceID, *user)
+	if err != nil {
+		logger.Error.Printf("syncBackendRoles: unexpected error when saving user -> %v", err)
+		return
+	}
+	*user = updatedUser
+}
+
+// createUserFromAuthBackend creates an account for an unknown user, if an auth backend of the instance accepts the credentials
+func (s *userManagementServer) createUserFromAuthBackend(instanceID string, accountID string, password string) (models.User, bool, error) {
+	backend := s.authBackends.BackendForNewUser(instanceID)
+	if backend == nil {
+		return models.User{}, false, nil
+	}
+
+	res, err := backend.Authenticate(accountID, password)
+	if err == authbackend.ErrInvalidCredentials || err == authbackend.ErrNoRoleMapped {
+		return models.User{}, false, nil
+	}
+	if err != nil {
+		logger.Error.Printf("auth backend %s: %v", backend.Name(), err)
+		return models.User{}, false, status.Error(codes.Unavailable, "authentication backend not available")
+	}
+
+	randomPW, err := tokens.GenerateUniqueTokenString()
+	if err != nil {
+		return models.User{}, false, status.Error(codes.Internal, "user creation failed")
+	}
+	roles := res.Roles
+	if roles == nil {
+		roles = []string{}
+	}
+	user := models.User{
+		Account: models.Account{
+			Type:                  models.ACCOUNT_TYPE_LDAP,
+			AccountID:             accountID,
+			AccountConfirmedAt:    time.Now().Unix(),
+			Password:              randomPW, // not used, just to not leave it empty
+			FailedLoginAttempts:   []int64{},
+			PasswordResetTriggers: []int64{},
+		},
+		Roles: roles,
+		Profiles: []models.Profile{
+			{
+				ID:                 primitive.NewObjectID(),
+				Alias:              utils.BlurEmailAddress(accountID),
+				ConsentConfirmedAt: time.Now().Unix(),
+				AvatarID:           "default",
+				MainProfile:        true,
+			},
+		},
+		Timestamps: models.Timestamps{
+			CreatedAt: time.Now().Unix(),
+		},
+	}
+	user.AddNewEmail(accountID, true)
+	user.ContactPreferences.SubscribedToNewsletter = false
+	user.ContactPreferences.SendNewsletterTo = []string{user.ContactInfos[0].ID.Hex()}
+	user.ContactPreferences.SubscribedToWeekly = false
+	weekdayStrategy := s.settings(instanceID).WeekdayStrategy
+	user.ContactPreferences.ReceiveWeeklyMessageDayOfWeek = int32(weekdayStrategy.Weekday())
+
+	id, err := s.userDBservice.AddUser(instanceID, user)
+	if err != nil {
+		logger.Error.Printf("ERROR: when creating new user: %s", err.Error())
+		return models.User{}, false, status.Error(codes.Internal, "user creation failed")
+	}
+	user.ID, _ = primitive.ObjectIDFromHex(id)
+	s.SaveLogEvent(instanceID, id, loggingAPI.LogEventType_LOG, constants.LOG_EVENT_ACCOUNT_CREATED, "created by auth backend "+backend.Name())
+	return user, true, nil
+}
+
+// consentsNeeded lists the consents the profiles of the user have to give, because they didn't accept the version
+// required by the instance
+func consentsNeeded(settings instancesettings.Settings, user models.User) []*api.ConsentNeeded {
+	needed := []*api.ConsentNeeded{}
+	for _, p := range user.Profiles {
+		for _, consentType := range settings.ConsentsNeeded(p) {
+			needed = append(needed, &api.ConsentNeeded{
+				ProfileId:       p.ID.Hex(),
+				ConsentType:     consentType,
+				RequiredVersion: settings.RequiredConsentVersions[consentType],
+			})
 		}
 	}
-	return false
+	return needed
 }
@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/coneno/logger"
+	"github.com/influenzanet/go-utils/pkg/api_types"
+	"github.com/influenzanet/go-utils/pkg/constants"
+	loggingAPI "github.com/influenzanet/logging-service/pkg/api"
+	messageAPI "github.com/influenzanet/messaging-service/pkg/api/messaging_service"
+	"github.com/influenzanet/user-management-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/dataexport"
+	"github.com/influenzanet/user-management-service/pkg/models"
+	"github.com/influenzanet/user-management-service/pkg/utils"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ExportMyData returns the data held about the user as a JSON archive, or emails them a link to download it
+func (s *userManagementServer) ExportMyData(ctx context.Context, req *api.DataExportReq) (*api.DataExport, error) {
+	if req == nil || utils.IsTokenEmpty(req.Token) {
+		return nil, status.Error(codes.InvalidArgument, "missing argument")
+	}
+	return s.exportData(req.Token, req.Token.Id, req.Delivery, "")
+}
+
+// ExportUserData is ExportMyData for admins handling the request of a user of their instance. The download link is
+// always sent to the user, admins never receive the archive.
+func (s *userManagementServer) ExportUserData(ctx context.Context, req *api.DataExportReq) (*api.DataExport, error) {
+	if req == nil || utils.IsTokenEmpty(req.Token) || req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing argument")
+	}
+	if !utils.CheckRoleInToken(req.Token, constants.USER_ROLE_ADMIN) {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+	return s.exportData(req.Token, req.UserId, api.DataExportReq_EMAIL, "by admin "+req.Token.Id+", ")
+}
+
+// DownloadDataExport returns the archive with the token sent by email, until it expires
+func (s *userManagementServer) DownloadDataExport(ctx context.Context, req *api.TempToken) (*api.DataExport, error) {
+	if req == nil || req.Token == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing argument")
+	}
+	tokenInfos, err := s.ValidateTempToken(req.Token, []string{tokenPurposeDataExport})
+	if err != nil {
+		logger.Warning.Printf("DownloadDataExport: %s", err.Error())
+		return nil, status.Error(codes.InvalidArgument, "invalid token")
+	}
+	// the account may have been deleted since the token was sent
+	if _, err := s.exportedUser(tokenInfos.InstanceID, tokenInfos.UserID); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.exportArchive(tokenInfos.InstanceID, tokenInfos.UserID)
+	if err != nil {
+		return nil, err
+	}
+	s.SaveLogEvent(tokenInfos.InstanceID, tokenInfos.UserID, loggingAPI.LogEventType_SECURITY, logEventDataExported, "download")
+	return resp, nil
+}
+
+// exportData returns the archive or emails the download link, logPrefix tells who requested it in the log event
+func (s *userManagementServer) exportData(token *api_types.TokenInfos, userID string, delivery api.DataExportReq_Delivery, logPrefix string) (*api.DataExport, error) {
+	instanceID := token.InstanceId
+	user, err := s.exportedUser(instanceID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if delivery == api.DataExportReq_EMAIL {
+		expiresAt := time.Now().Unix() + dataExportTokenLifetime
+		downloadToken, err := s.globalDBService.AddTempToken(models.TempToken{
+			UserID:     userID,
+			InstanceID: instanceID,
+			Purpose:    tokenPurposeDataExport,
+			Info: map[string]string{
+				"type": models.ACCOUNT_TYPE_EMAIL,
+			},
+			Expiration: expiresAt,
+		})
+		if err != nil {
+			logger.Error.Printf("exportData: %s", err.Error())
+			return nil, status.Error(codes.Internal, "download token couldn't be created")
+		}
+		email := models.NewOutgoingEmail(models.OUTGOING_EMAIL_INSTANT, &messageAPI.SendEmailReq{
+			InstanceId:  instanceID,
+			To:          []string{user.Account.AccountID},
+			MessageType: emailTypeDataExport,
+			ContentInfos: map[string]string{
+				"token":     downloadToken,
+				"expiresAt": strconv.FormatInt(expiresAt, 10),
+			},
+			PreferredLanguage: user.Account.PreferredLanguage,
+		})
+		if err := s.userDBservice.AddOutgoingEmail(instanceID, email); err != nil {
+			logger.Error.Printf("exportData: %s", err.Error())
+			return nil, status.Error(codes.Internal, "email couldn't be sent")
+		}
+		s.SaveLogEvent(instanceID, userID, loggingAPI.LogEventType_SECURITY, logEventDataExported, logPrefix+"sent by email")
+		return &api.DataExport{
+			ContentType:    dataexport.ContentType,
+			TokenExpiresAt: expiresAt,
+		}, nil
+	}
+
+	resp, err := s.exportArchive(instanceID, userID)
+	if err != nil {
+		return nil, err
+	}
+	s.SaveLogEvent(instanceID, userID, loggingAPI.LogEventType_SECURITY, logEventDataExported, logPrefix+"direct")
+	return resp, nil
+}
+
+// exportedUser returns the user whose data are exported, unless the account is pending deletion or anonymized
+func (s *userManagementServer) exportedUser(instanceID string, userID string) (models.User, error) {
+	user, err := s.userDBservice.GetUserByID(instanceID, userID)
+	if err == mongo.ErrNoDocuments {
+		return user, status.Error(codes.NotFound, "user not found")
+	}
+	if err != nil {
+		logger.Error.Printf("exportedUser: %s", err.Error())
+		return user, status.Error(codes.Internal, "user couldn't be read")
+	}
+	if user.Account.IsPendingDeletion() || user.Account.IsAnonymized() {
+		return user, status.Error(codes.FailedPrecondition, "account pending deletion")
+	}
+	return user, nil
+}
+
+func (s *userManagementServer) exportArchive(instanceID string, userID string) (*api.DataExport, error) {
+	archive, err := dataexport.New(s.userDBservice, s.globalDBService).Export(instanceID, userID)
+	if err != nil {
+		logger.Error.Printf("exportArchive: %s", err.Error())
+		return nil, status.Error(codes.Internal, "data couldn't be exported")
+	}
+	data, err := archive.Marshal()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &api.DataExport{
+		Archive:     data,
+		FileName:    archive.FileName(),
+		ContentType: dataexport.ContentType,
+	}, nil
+}
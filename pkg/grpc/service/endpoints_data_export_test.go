@@ -0,0 +1,178 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	api_types "github.com/influenzanet/go-utils/pkg/api_types"
+	"github.com/influenzanet/user-management-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/dataexport"
+	"github.com/influenzanet/user-management-service/pkg/models"
+	loggingMock "github.com/influenzanet/user-management-service/test/mocks/logging_service"
+)
+
+func TestDataExportEndpoints(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockLoggingClient := loggingMock.NewMockLoggingServiceApiClient(mockCtrl)
+
+	s := userManagementServer{
+		userDBservice:    testUserDBService,
+		globalDBService:  testGlobalDBService,
+		instanceSettings: testSettingsStore(models.Intervals{}),
+		clients: &models.APIClients{
+			LoggingService: mockLoggingClient,
+		},
+	}
+
+	testUsers, err := addTestUsers([]models.User{
+		{
+			Account: models.Account{
+				Type:      "email",
+				AccountID: "export_user_1@test.com",
+				Password:  "secret",
+			},
+		},
+		{
+			Account: models.Account{
+				Type:      "email",
+				AccountID: "export_user_2@test.com",
+			},
+		},
+	})
+	if err != nil {
+		t.Errorf("failed to create testusers: %s", err.Error())
+		return
+	}
+	userToken := &api_types.TokenInfos{
+		Id:         testUsers[0].ID.Hex(),
+		InstanceId: testInstanceID,
+		Payload: map[string]string{
+			"roles": "PARTICIPANT",
+		},
+	}
+	adminToken := &api_types.TokenInfos{
+		Id:         "testadmin",
+		InstanceId: testInstanceID,
+		Payload: map[string]string{
+			"roles": "PARTICIPANT,ADMIN",
+		},
+	}
+
+	t.Run("without payload", func(t *testing.T) {
+		_, err := s.ExportMyData(context.Background(), nil)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "missing argument")
+		if !ok {
+			t.Error(msg)
+		}
+		_, err = s.ExportUserData(context.Background(), &api.DataExportReq{Token: adminToken})
+		ok, msg = shouldHaveGrpcErrorStatus(err, "missing argument")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("export own data", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil)
+
+		resp, err := s.ExportMyData(context.Background(), &api.DataExportReq{Token: userToken})
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		archive := dataexport.Archive{}
+		if err := json.Unmarshal(resp.Archive, &archive); err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if archive.UserID != userToken.Id || archive.Account.AccountID != "export_user_1@test.com" {
+			t.Errorf("unexpected archive: %s", resp.Archive)
+		}
+	})
+
+	t.Run("export by non admin user", func(t *testing.T) {
+		_, err := s.ExportUserData(context.Background(), &api.DataExportReq{Token: userToken, UserId: testUsers[1].ID.Hex()})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "permission denied")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("export by admin with download token", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil).Times(2)
+
+		// the archive is never returned to the admin
+		resp, err := s.ExportUserData(context.Background(), &api.DataExportReq{
+			Token:    adminToken,
+			UserId:   testUsers[1].ID.Hex(),
+			Delivery: api.DataExportReq_DIRECT,
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if len(resp.Archive) > 0 || resp.TokenExpiresAt == 0 {
+			t.Errorf("unexpected response: %s", resp)
+		}
+
+		_, err = s.DownloadDataExport(context.Background(), &api.TempToken{Token: "wrong"})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "invalid token")
+		if !ok {
+			t.Error(msg)
+		}
+		tokens, err := testGlobalDBService.GetTempTokenForUser(testInstanceID, testUsers[1].ID.Hex(), tokenPurposeDataExport)
+		if err != nil || len(tokens) != 1 {
+			t.Errorf("unexpected download tokens: %v %v", tokens, err)
+			return
+		}
+		resp, err = s.DownloadDataExport(context.Background(), &api.TempToken{Token: tokens[0].Token})
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		archive := dataexport.Archive{}
+		if err := json.Unmarshal(resp.Archive, &archive); err != nil || archive.UserID != testUsers[1].ID.Hex() {
+			t.Errorf("unexpected archive: %s %v", resp.Archive, err)
+		}
+	})
+
+	t.Run("download after the account deletion was requested", func(t *testing.T) {
+		user, err := testUserDBService.GetUserByID(testInstanceID, testUsers[1].ID.Hex())
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		user.Account.DeletionScheduledAt = time.Now().Unix() + 3600
+		if _, err := testUserDBService.UpdateUser(testInstanceID, user); err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		tokens, err := testGlobalDBService.GetTempTokenForUser(testInstanceID, testUsers[1].ID.Hex(), tokenPurposeDataExport)
+		if err != nil || len(tokens) != 1 {
+			t.Errorf("unexpected download tokens: %v %v", tokens, err)
+			return
+		}
+		_, err = s.DownloadDataExport(context.Background(), &api.TempToken{Token: tokens[0].Token})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "account pending deletion")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("export of unknown user", func(t *testing.T) {
+		_, err := s.ExportUserData(context.Background(), &api.DataExportReq{Token: adminToken, UserId: "5f0c7b8e9d3e2a1b4c5d6e7f"})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "user not found")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+}
@@ -0,0 +1,61 @@
+package service
+
+import (
+	"context"
+
+	"github.com/coneno/logger"
+	"github.com/influenzanet/go-utils/pkg/constants"
+	loggingAPI "github.com/influenzanet/logging-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetFailedEmails lists the emails of the instance the messaging service did not accept after all retries
+func (s *userManagementServer) GetFailedEmails(ctx context.Context, req *api.FailedEmailsReq) (*api.OutgoingEmailList, error) {
+	if req == nil || utils.IsTokenEmpty(req.Token) {
+		return nil, status.Error(codes.InvalidArgument, "missing arguments")
+	}
+	if !utils.CheckRoleInToken(req.Token, constants.USER_ROLE_ADMIN) {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	limit := int64(req.Limit)
+	if limit <= 0 {
+		limit = defaultFailedEmailsLimit
+	}
+	emails, err := s.userDBservice.FindFailedOutgoingEmails(req.Token.InstanceId, limit)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	resp := &api.OutgoingEmailList{
+		Emails: make([]*api.OutgoingEmail, len(emails)),
+	}
+	for i, e := range emails {
+		resp.Emails[i] = e.ToAPI()
+	}
+	return resp, nil
+}
+
+// ResendFailedEmail puts a failed email back into the outbox, where it gets the full number of retries again
+func (s *userManagementServer) ResendFailedEmail(ctx context.Context, req *api.ResendFailedEmailReq) (*api.ServiceStatus, error) {
+	if req == nil || utils.IsTokenEmpty(req.Token) || req.EmailId == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing arguments")
+	}
+	if !utils.CheckRoleInToken(req.Token, constants.USER_ROLE_ADMIN) {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	if err := s.userDBservice.ResetFailedOutgoingEmail(req.Token.InstanceId, req.EmailId); err != nil {
+		logger.Warning.Printf("ResendFailedEmail: %s", err.Error())
+		return nil, status.Error(codes.NotFound, "no failed email found with the given id")
+	}
+
+	s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_LOG, logEventFailedEmailResent, req.EmailId)
+	return &api.ServiceStatus{
+		Status:  api.ServiceStatus_NORMAL,
+		Msg:     "email queued",
+		Version: apiVersion,
+	}, nil
+}
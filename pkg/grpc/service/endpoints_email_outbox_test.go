@@ -0,0 +1,111 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	api_types "github.com/influenzanet/go-utils/pkg/api_types"
+	messageAPI "github.com/influenzanet/messaging-service/pkg/api/messaging_service"
+	"github.com/influenzanet/user-management-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/models"
+	loggingMock "github.com/influenzanet/user-management-service/test/mocks/logging_service"
+)
+
+func TestFailedEmailsEndpoints(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockLoggingClient := loggingMock.NewMockLoggingServiceApiClient(mockCtrl)
+
+	s := userManagementServer{
+		userDBservice:    testUserDBService,
+		globalDBService:  testGlobalDBService,
+		instanceSettings: testSettingsStore(models.Intervals{}),
+		clients: &models.APIClients{
+			LoggingService: mockLoggingClient,
+		},
+	}
+
+	email := models.NewOutgoingEmail(models.OUTGOING_EMAIL_INSTANT, &messageAPI.SendEmailReq{
+		To:          []string{"failed_email@test.com"},
+		MessageType: "invitation",
+		ContentInfos: map[string]string{
+			"token": "secret",
+		},
+	})
+	email.Status = models.OUTGOING_EMAIL_STATUS_FAILED
+	email.LastError = "unavailable"
+	if err := testUserDBService.AddOutgoingEmail(testInstanceID, email); err != nil {
+		t.Errorf("failed to create testdata: %s", err.Error())
+		return
+	}
+
+	adminToken := &api_types.TokenInfos{
+		Id:         "testadmin",
+		InstanceId: testInstanceID,
+		Payload: map[string]string{
+			"roles": "PARTICIPANT,ADMIN",
+		},
+	}
+	participantToken := &api_types.TokenInfos{
+		Id:         "testuser",
+		InstanceId: testInstanceID,
+		Payload: map[string]string{
+			"roles": "PARTICIPANT",
+		},
+	}
+
+	t.Run("without payload", func(t *testing.T) {
+		_, err := s.GetFailedEmails(context.Background(), nil)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "missing arguments")
+		if !ok {
+			t.Error(msg)
+		}
+		_, err = s.ResendFailedEmail(context.Background(), &api.ResendFailedEmailReq{Token: adminToken})
+		ok, msg = shouldHaveGrpcErrorStatus(err, "missing arguments")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("with non admin user", func(t *testing.T) {
+		_, err := s.GetFailedEmails(context.Background(), &api.FailedEmailsReq{Token: participantToken})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "permission denied")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	emailID := ""
+	t.Run("list failed emails", func(t *testing.T) {
+		resp, err := s.GetFailedEmails(context.Background(), &api.FailedEmailsReq{Token: adminToken})
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if len(resp.Emails) < 1 || resp.Emails[0].LastError != "unavailable" {
+			t.Errorf("unexpected response: %s", resp)
+			return
+		}
+		emailID = resp.Emails[0].Id
+	})
+
+	t.Run("resend failed email", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil)
+
+		_, err := s.ResendFailedEmail(context.Background(), &api.ResendFailedEmailReq{Token: adminToken, EmailId: emailID})
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+
+		_, err = s.ResendFailedEmail(context.Background(), &api.ResendFailedEmailReq{Token: adminToken, EmailId: emailID})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "no failed email found with the given id")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+}
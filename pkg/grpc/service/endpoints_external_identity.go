@@ -0,0 +1,93 @@
+package service
+
+import (
+	"context"
+
+	"github.com/coneno/logger"
+	"github.com/influenzanet/go-utils/pkg/constants"
+	loggingAPI "github.com/influenzanet/logging-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/models"
+	"github.com/influenzanet/user-management-service/pkg/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *userManagementServer) LinkExternalIdentity(ctx context.Context, req *api.ExternalIdentityMsg) (*api.User, error) {
+	if req == nil || utils.IsTokenEmpty(req.Token) || req.Password == "" || req.Issuer == "" || req.Subject == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing argument")
+	}
+
+	user, err := s.userDBservice.GetUserByID(req.Token.InstanceId, req.Token.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user and/or password")
+	}
+
+	if user.Account.Type != models.ACCOUNT_TYPE_EMAIL {
+		return nil, status.Error(codes.InvalidArgument, "account is not email type")
+	}
+
+	match, err := s.verifyPassword(ctx, req.Token.InstanceId, &user, req.Password)
+	if err != nil {
+		return nil, err
+	}
+	if !match {
+		s.SaveLogEvent(req.Token.InstanceId, user.ID.Hex(), loggingAPI.LogEventType_SECURITY, constants.LOG_EVENT_AUTH_WRONG_PASSWORD, "link external identity endpoint")
+		return nil, status.Error(codes.InvalidArgument, "invalid user and/or password")
+	}
+
+	// is the identity still free to use?
+	otherUser, err := s.userDBservice.GetUserByFederatedIdentity(req.Token.InstanceId, req.Issuer, req.Subject)
+	if err == nil && otherUser.ID != user.ID {
+		logger.Warning.Printf("SECURITY WARNING: user %s tried to link external identity of user %s", user.ID.Hex(), otherUser.ID.Hex())
+		return nil, status.Error(codes.AlreadyExists, "identity already linked")
+	}
+
+	if err := user.AddFederatedIdentity(req.Issuer, req.Subject); err != nil {
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	}
+
+	updUser, err := s.userDBservice.UpdateUser(req.Token.InstanceId, user)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	s.SaveLogEvent(req.Token.InstanceId, updUser.ID.Hex(), loggingAPI.LogEventType_SECURITY, logEventExternalIdentityLinked, req.Issuer)
+	return updUser.ToAPI(), nil
+}
+
+func (s *userManagementServer) UnlinkExternalIdentity(ctx context.Context, req *api.ExternalIdentityMsg) (*api.User, error) {
+	if req == nil || utils.IsTokenEmpty(req.Token) || req.Password == "" || req.Issuer == "" || req.Subject == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing argument")
+	}
+
+	user, err := s.userDBservice.GetUserByID(req.Token.InstanceId, req.Token.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user and/or password")
+	}
+
+	if user.Account.Type != models.ACCOUNT_TYPE_EMAIL {
+		return nil, status.Error(codes.InvalidArgument, "account is not email type")
+	}
+
+	match, err := s.verifyPassword(ctx, req.Token.InstanceId, &user, req.Password)
+	if err != nil {
+		return nil, err
+	}
+	if !match {
+		s.SaveLogEvent(req.Token.InstanceId, user.ID.Hex(), loggingAPI.LogEventType_SECURITY, constants.LOG_EVENT_AUTH_WRONG_PASSWORD, "unlink external identity endpoint")
+		return nil, status.Error(codes.InvalidArgument, "invalid user and/or password")
+	}
+
+	if err := user.RemoveFederatedIdentity(req.Issuer, req.Subject); err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	updUser, err := s.userDBservice.UpdateUser(req.Token.InstanceId, user)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	s.SaveLogEvent(req.Token.InstanceId, updUser.ID.Hex(), loggingAPI.LogEventType_SECURITY, logEventExternalIdentityUnlinked, req.Issuer)
+	return updUser.ToAPI(), nil
+}
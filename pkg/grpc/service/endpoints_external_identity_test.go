@@ -0,0 +1,219 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	api_types "github.com/influenzanet/go-utils/pkg/api_types"
+	"github.com/influenzanet/user-management-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/instances"
+	"github.com/influenzanet/user-management-service/pkg/models"
+	"github.com/influenzanet/user-management-service/pkg/pwhash"
+	loggingMock "github.com/influenzanet/user-management-service/test/mocks/logging_service"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/status"
+)
+
+func TestLinkExternalIdentityEndpoint(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockLoggingClient := loggingMock.NewMockLoggingServiceApiClient(mockCtrl)
+
+	s := userManagementServer{
+		userDBservice:   testUserDBService,
+		globalDBService: testGlobalDBService,
+		instances:       instances.NewStaticRegistry(testInstanceID),
+		instanceSettings: testSettingsStore(models.Intervals{
+			TokenExpiryInterval:      time.Second * 2,
+			VerificationCodeLifetime: 60,
+		}),
+		clients: &models.APIClients{
+			LoggingService: mockLoggingClient,
+		},
+	}
+
+	currentPw := "SuperSecurePassword123!§$"
+	hashedPw, _ := pwhash.HashPassword(currentPw)
+
+	testUsers, err := addTestUsers([]models.User{
+		{
+			Account: models.Account{
+				Type:               models.ACCOUNT_TYPE_EMAIL,
+				AccountID:          "link_identity_1@test.com",
+				AccountConfirmedAt: time.Now().Unix(),
+				Password:           hashedPw,
+			},
+			Roles: []string{"RESEARCHER"},
+			Profiles: []models.Profile{
+				{ID: primitive.NewObjectID(), MainProfile: true},
+			},
+		},
+		{
+			Account: models.Account{
+				Type:      models.ACCOUNT_TYPE_EMAIL,
+				AccountID: "link_identity_2@test.com",
+				Password:  hashedPw,
+			},
+			FederatedIdentities: []models.FederatedIdentity{
+				{Issuer: "https://idp.test.com", Subject: "taken-subject"},
+			},
+		},
+		{
+			Account: models.Account{
+				Type:      models.ACCOUNT_TYPE_EXTERNAL,
+				AccountID: "external_identity@test.com",
+			},
+			FederatedIdentities: []models.FederatedIdentity{
+				{Issuer: "https://idp.test.com", Subject: "external-1"},
+			},
+			Profiles: []models.Profile{
+				{ID: primitive.NewObjectID(), MainProfile: true},
+			},
+		},
+	})
+	if err != nil {
+		t.Errorf("failed to create testusers: %s", err.Error())
+		return
+	}
+
+	token := &api_types.TokenInfos{
+		Id:         testUsers[0].ID.Hex(),
+		InstanceId: testInstanceID,
+	}
+
+	t.Run("without payload", func(t *testing.T) {
+		_, err := s.LinkExternalIdentity(context.Background(), nil)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "missing argument")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("with wrong password", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil)
+
+		_, err := s.LinkExternalIdentity(context.Background(), &api.ExternalIdentityMsg{
+			Token:    token,
+			Password: currentPw + "wrong",
+			Issuer:   "https://idp.test.com",
+			Subject:  "researcher-1",
+		})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "invalid user and/or password")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("with identity of other user", func(t *testing.T) {
+		_, err := s.LinkExternalIdentity(context.Background(), &api.ExternalIdentityMsg{
+			Token:    token,
+			Password: currentPw,
+			Issuer:   "https://idp.test.com",
+			Subject:  "taken-subject",
+		})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "identity already linked")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("with valid identity", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil)
+
+		resp, err := s.LinkExternalIdentity(context.Background(), &api.ExternalIdentityMsg{
+			Token:    token,
+			Password: currentPw,
+			Issuer:   "https://idp.test.com",
+			Subject:  "researcher-1",
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if len(resp.FederatedIdentities) != 1 || resp.FederatedIdentities[0].Subject != "researcher-1" {
+			t.Errorf("unexpected response: %v", resp)
+		}
+	})
+
+	t.Run("login with linked identity", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil)
+
+		resp, err := s.LoginWithExternalIDP(context.Background(), &api.LoginWithExternalIDPMsg{
+			InstanceId: testInstanceID,
+			Email:      "other-address@idp.test.com",
+			Role:       "RESEARCHER",
+			Issuer:     "https://idp.test.com",
+			Subject:    "researcher-1",
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if resp.User.Id != testUsers[0].ID.Hex() {
+			t.Errorf("wrong user logged in: %s", resp.User.Id)
+		}
+	})
+
+	t.Run("login with other identity of bound account", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil)
+
+		_, err := s.LoginWithExternalIDP(context.Background(), &api.LoginWithExternalIDPMsg{
+			InstanceId: testInstanceID,
+			Email:      "external_identity@test.com",
+			Role:       "PARTICIPANT",
+			Issuer:     "https://other-idp.test.com",
+			Subject:    "external-1",
+		})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "identity mismatch")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("unlink identity", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil)
+
+		resp, err := s.UnlinkExternalIdentity(context.Background(), &api.ExternalIdentityMsg{
+			Token:    token,
+			Password: currentPw,
+			Issuer:   "https://idp.test.com",
+			Subject:  "researcher-1",
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if len(resp.FederatedIdentities) != 0 {
+			t.Errorf("unexpected response: %v", resp)
+		}
+	})
+
+	t.Run("unlink not linked identity", func(t *testing.T) {
+		_, err := s.UnlinkExternalIdentity(context.Background(), &api.ExternalIdentityMsg{
+			Token:    token,
+			Password: currentPw,
+			Issuer:   "https://idp.test.com",
+			Subject:  "researcher-1",
+		})
+		if status.Convert(err).Message() != "identity not found" {
+			t.Errorf("wrong error: %v", err)
+		}
+	})
+}
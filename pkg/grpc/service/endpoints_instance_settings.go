@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+
+	"github.com/coneno/logger"
+	"github.com/influenzanet/go-utils/pkg/constants"
+	loggingAPI "github.com/influenzanet/logging-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/instancesettings"
+	"github.com/influenzanet/user-management-service/pkg/models"
+	"github.com/influenzanet/user-management-service/pkg/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// GetInstanceSettings returns the settings stored for the admin's instance and the settings in use
+func (s *userManagementServer) GetInstanceSettings(ctx context.Context, req *api.InstanceSettingsReq) (*api.InstanceSettingsResp, error) {
+	if req == nil || utils.IsTokenEmpty(req.Token) {
+		return nil, status.Error(codes.InvalidArgument, "missing arguments")
+	}
+	if !utils.CheckRoleInToken(req.Token, constants.USER_ROLE_ADMIN) {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	instanceID := req.Token.InstanceId
+	return &api.InstanceSettingsResp{
+		Settings:  s.instanceSettings.Overrides(instanceID).ToAPI(),
+		Effective: s.instanceSettings.Get(instanceID).ToAPI(),
+	}, nil
+}
+
+// UpdateInstanceSettings replaces the settings of the admin's instance, unset fields use the service configuration
+func (s *userManagementServer) UpdateInstanceSettings(ctx context.Context, req *api.UpdateInstanceSettingsReq) (*api.InstanceSettingsResp, error) {
+	if req == nil || utils.IsTokenEmpty(req.Token) || req.Settings == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing arguments")
+	}
+	if !utils.CheckRoleInToken(req.Token, constants.USER_ROLE_ADMIN) {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	instanceID := req.Token.InstanceId
+	overrides := models.InstanceSettingsFromAPI(req.Settings)
+	overrides.InstanceID = instanceID
+	overrides.UpdatedBy = req.Token.Id
+	if err := instancesettings.Validate(overrides); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	overrides, err := s.instanceSettings.Update(overrides)
+	if err != nil {
+		logger.Error.Printf("UpdateInstanceSettings: %v", err)
+		return nil, status.Error(codes.Internal, "settings couldn't be saved")
+	}
+
+	s.SaveLogEvent(instanceID, req.Token.Id, loggingAPI.LogEventType_LOG, logEventInstanceSettingsUpdated, protojson.Format(req.Settings))
+	return &api.InstanceSettingsResp{
+		Settings:  overrides.ToAPI(),
+		Effective: s.instanceSettings.Get(instanceID).ToAPI(),
+	}, nil
+}
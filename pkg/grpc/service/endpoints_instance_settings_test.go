@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	api_types "github.com/influenzanet/go-utils/pkg/api_types"
+	"github.com/influenzanet/user-management-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/instancesettings"
+	"github.com/influenzanet/user-management-service/pkg/models"
+	"github.com/influenzanet/user-management-service/pkg/utils"
+	loggingMock "github.com/influenzanet/user-management-service/test/mocks/logging_service"
+)
+
+func TestInstanceSettingsEndpoints(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockLoggingClient := loggingMock.NewMockLoggingServiceApiClient(mockCtrl)
+
+	store := instancesettings.NewStore(testGlobalDBService, instancesettings.Settings{
+		Intervals: models.Intervals{
+			TokenExpiryInterval:      time.Second * 2,
+			VerificationCodeLifetime: 60,
+		},
+		NewUserCountLimit:  100,
+		MaxProfiles:        instancesettings.DefaultMaxProfiles,
+		WeekdayStrategy:    utils.CreateWeekdayDefaultStrategy(),
+		SecondFactorPolicy: instancesettings.SecondFactorOptional,
+	})
+	s := userManagementServer{
+		userDBservice:    testUserDBService,
+		globalDBService:  testGlobalDBService,
+		instanceSettings: store,
+		clients: &models.APIClients{
+			LoggingService: mockLoggingClient,
+		},
+	}
+
+	adminToken := &api_types.TokenInfos{
+		Id:         "testadmin",
+		InstanceId: testInstanceID,
+		Payload: map[string]string{
+			"roles": "PARTICIPANT,ADMIN",
+		},
+	}
+	participantToken := &api_types.TokenInfos{
+		Id:         "testuser",
+		InstanceId: testInstanceID,
+		Payload: map[string]string{
+			"roles": "PARTICIPANT",
+		},
+	}
+
+	t.Run("without payload", func(t *testing.T) {
+		_, err := s.GetInstanceSettings(context.Background(), nil)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "missing arguments")
+		if !ok {
+			t.Error(msg)
+		}
+		_, err = s.UpdateInstanceSettings(context.Background(), &api.UpdateInstanceSettingsReq{Token: adminToken})
+		ok, msg = shouldHaveGrpcErrorStatus(err, "missing arguments")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("with non admin user", func(t *testing.T) {
+		_, err := s.GetInstanceSettings(context.Background(), &api.InstanceSettingsReq{Token: participantToken})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "permission denied")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("with invalid settings", func(t *testing.T) {
+		policy := "sometimes"
+		_, err := s.UpdateInstanceSettings(context.Background(), &api.UpdateInstanceSettingsReq{
+			Token:    adminToken,
+			Settings: &api.InstanceSettings{SecondFactorPolicy: &policy},
+		})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "second factor policy must be optional, required or disabled")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("update settings", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil)
+
+		maxProfiles := int32(2)
+		policy := instancesettings.SecondFactorRequired
+		resp, err := s.UpdateInstanceSettings(context.Background(), &api.UpdateInstanceSettingsReq{
+			Token:    adminToken,
+			Settings: &api.InstanceSettings{MaxProfiles: &maxProfiles, SecondFactorPolicy: &policy},
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if resp.Settings.UpdatedBy != adminToken.Id || resp.Settings.NewUserRateLimit != nil {
+			t.Errorf("unexpected settings: %s", resp.Settings)
+		}
+		if resp.Effective.GetMaxProfiles() != 2 || resp.Effective.GetNewUserRateLimit() != 100 {
+			t.Errorf("unexpected effective settings: %s", resp.Effective)
+		}
+		if !s.settings(testInstanceID).UseSecondFactor(false) {
+			t.Error("second factor should be required")
+		}
+		if s.settings(testInstanceID + "_other").UseSecondFactor(false) {
+			t.Error("other instances should use the defaults")
+		}
+
+		if err := store.Refresh(); err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		resp, err = s.GetInstanceSettings(context.Background(), &api.InstanceSettingsReq{Token: adminToken})
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if resp.Settings.GetSecondFactorPolicy() != policy || resp.Effective.GetMaxProfiles() != 2 {
+			t.Errorf("settings not loaded: %s", resp)
+		}
+	})
+}
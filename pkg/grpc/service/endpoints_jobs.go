@@ -0,0 +1,153 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/coneno/logger"
+	"github.com/influenzanet/go-utils/pkg/constants"
+	loggingAPI "github.com/influenzanet/logging-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/instancesettings"
+	"github.com/influenzanet/user-management-service/pkg/jobs"
+	"github.com/influenzanet/user-management-service/pkg/models"
+	"github.com/influenzanet/user-management-service/pkg/timer_event"
+	"github.com/influenzanet/user-management-service/pkg/utils"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetJobs lists the background jobs with their schedule and their last run for the admin's instance
+func (s *userManagementServer) GetJobs(ctx context.Context, req *api.JobsReq) (*api.JobList, error) {
+	if req == nil || utils.IsTokenEmpty(req.Token) {
+		return nil, status.Error(codes.InvalidArgument, "missing arguments")
+	}
+	if !utils.CheckRoleInToken(req.Token, constants.USER_ROLE_ADMIN) {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	now := time.Now()
+	resp := &api.JobList{Jobs: make([]*api.Job, len(s.jobs))}
+	for i, job := range s.jobs {
+		lastScheduled, err := s.globalDBService.GetLastScheduledJobRun(job.Name)
+		if err != nil && err != mongo.ErrNoDocuments {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		resp.Jobs[i] = &api.Job{
+			Name:     job.Name,
+			Schedule: job.Schedule,
+			Enabled:  job.Enabled,
+		}
+		if next := job.NextRun(lastScheduled.RequestedAt, now); !next.IsZero() {
+			resp.Jobs[i].NextRunAt = next.Unix()
+		}
+
+		runs, err := s.globalDBService.GetJobRuns(job.Name, req.Token.InstanceId, 1)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if len(runs) > 0 {
+			resp.Jobs[i].LastRun = runs[0].ToAPI(req.Token.InstanceId)
+		}
+	}
+	return resp, nil
+}
+
+// GetJobRuns returns the latest runs of a job, with the results for the admin's instance
+func (s *userManagementServer) GetJobRuns(ctx context.Context, req *api.JobRunsReq) (*api.JobRunList, error) {
+	if req == nil || utils.IsTokenEmpty(req.Token) || req.Job == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing arguments")
+	}
+	if !utils.CheckRoleInToken(req.Token, constants.USER_ROLE_ADMIN) {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+	if _, ok := jobs.Find(s.jobs, req.Job); !ok {
+		return nil, status.Error(codes.NotFound, "unknown job")
+	}
+
+	limit := int64(req.Limit)
+	if limit <= 0 {
+		limit = defaultJobRunsLimit
+	}
+	runs, err := s.globalDBService.GetJobRuns(req.Job, req.Token.InstanceId, limit)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	resp := &api.JobRunList{
+		Runs: make([]*api.JobRun, len(runs)),
+	}
+	for i, r := range runs {
+		resp.Runs[i] = r.ToAPI(req.Token.InstanceId)
+	}
+	return resp, nil
+}
+
+// TriggerJob requests a run of the job for the admin's instance. It is started within seconds by the service
+// instance running the jobs, unless the job is running already, then it starts after it.
+func (s *userManagementServer) TriggerJob(ctx context.Context, req *api.TriggerJobReq) (*api.JobRun, error) {
+	if req == nil || utils.IsTokenEmpty(req.Token) || req.Job == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing arguments")
+	}
+	if !utils.CheckRoleInToken(req.Token, constants.USER_ROLE_ADMIN) {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+	job, ok := jobs.Find(s.jobs, req.Job)
+	if !ok {
+		return nil, status.Error(codes.NotFound, "unknown job")
+	}
+	if !job.Enabled {
+		return nil, status.Error(codes.FailedPrecondition, "job is disabled")
+	}
+
+	instanceID := req.Token.InstanceId
+	run, err := s.globalDBService.AddJobRun(models.JobRun{
+		Job:         job.Name,
+		Trigger:     models.JOB_TRIGGER_MANUAL,
+		InstanceID:  instanceID,
+		RequestedBy: req.Token.Id,
+		Status:      models.JOB_RUN_STATUS_PENDING,
+		RequestedAt: time.Now().Unix(),
+	})
+	if err != nil {
+		logger.Error.Printf("TriggerJob: %v", err)
+		return nil, status.Error(codes.Internal, "run couldn't be requested")
+	}
+
+	s.SaveLogEvent(instanceID, req.Token.Id, loggingAPI.LogEventType_LOG, logEventJobTriggered, job.Name)
+	return run.ToAPI(instanceID), nil
+}
+
+// DryRunJob returns the accounts of the admin's instance the job would delete or notify, optionally with other
+// thresholds than the ones in use. Nothing is changed.
+func (s *userManagementServer) DryRunJob(ctx context.Context, req *api.DryRunJobReq) (*api.DryRunReport, error) {
+	if req == nil || utils.IsTokenEmpty(req.Token) || req.Job == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing arguments")
+	}
+	if !utils.CheckRoleInToken(req.Token, constants.USER_ROLE_ADMIN) {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	instanceID := req.Token.InstanceId
+	settings := s.settings(instanceID)
+	if req.Settings != nil {
+		overrides := models.InstanceSettingsFromAPI(req.Settings)
+		overrides.InstanceID = instanceID
+		if err := instancesettings.Validate(overrides); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		settings = settings.Apply(overrides)
+	}
+
+	report, err := timer_event.DryRun(s.userDBservice, req.Job, instanceID, settings, s.clients.StudyService != nil)
+	if err == timer_event.ErrNoDryRun {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		logger.Error.Printf("DryRunJob: %v", err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	s.SaveLogEvent(instanceID, req.Token.Id, loggingAPI.LogEventType_LOG, logEventJobDryRun, req.Job)
+	return report.ToAPI(), nil
+}
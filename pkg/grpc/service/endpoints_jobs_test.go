@@ -0,0 +1,193 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	api_types "github.com/influenzanet/go-utils/pkg/api_types"
+	"github.com/influenzanet/user-management-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/jobs"
+	"github.com/influenzanet/user-management-service/pkg/models"
+	loggingMock "github.com/influenzanet/user-management-service/test/mocks/logging_service"
+)
+
+func TestJobEndpoints(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockLoggingClient := loggingMock.NewMockLoggingServiceApiClient(mockCtrl)
+
+	tempTokenJob, err := jobs.NewConfig(jobs.CleanUpExpiredTempTokens, "@hourly", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reminderJob, err := jobs.NewConfig(jobs.ReminderToConfirmAccount, "0 3 * * *", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := userManagementServer{
+		userDBservice:    testUserDBService,
+		globalDBService:  testGlobalDBService,
+		instanceSettings: testSettingsStore(models.Intervals{}),
+		jobs:             []jobs.Config{tempTokenJob, reminderJob},
+		clients: &models.APIClients{
+			LoggingService: mockLoggingClient,
+		},
+	}
+
+	adminToken := &api_types.TokenInfos{
+		Id:         "testadmin",
+		InstanceId: testInstanceID,
+		Payload: map[string]string{
+			"roles": "PARTICIPANT,ADMIN",
+		},
+	}
+	participantToken := &api_types.TokenInfos{
+		Id:         "testuser",
+		InstanceId: testInstanceID,
+		Payload: map[string]string{
+			"roles": "PARTICIPANT",
+		},
+	}
+
+	t.Run("without payload", func(t *testing.T) {
+		_, err := s.GetJobs(context.Background(), nil)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "missing arguments")
+		if !ok {
+			t.Error(msg)
+		}
+		_, err = s.TriggerJob(context.Background(), &api.TriggerJobReq{Token: adminToken})
+		ok, msg = shouldHaveGrpcErrorStatus(err, "missing arguments")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("with non admin user", func(t *testing.T) {
+		_, err := s.TriggerJob(context.Background(), &api.TriggerJobReq{Token: participantToken, Job: tempTokenJob.Name})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "permission denied")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("with unknown or disabled job", func(t *testing.T) {
+		_, err := s.TriggerJob(context.Background(), &api.TriggerJobReq{Token: adminToken, Job: "wrong"})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "unknown job")
+		if !ok {
+			t.Error(msg)
+		}
+		_, err = s.TriggerJob(context.Background(), &api.TriggerJobReq{Token: adminToken, Job: reminderJob.Name})
+		ok, msg = shouldHaveGrpcErrorStatus(err, "job is disabled")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("trigger job", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil)
+
+		run, err := s.TriggerJob(context.Background(), &api.TriggerJobReq{Token: adminToken, Job: tempTokenJob.Name})
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if run.Status != models.JOB_RUN_STATUS_PENDING || run.RequestedBy != adminToken.Id {
+			t.Errorf("unexpected run: %s", run)
+		}
+
+		runs, err := s.GetJobRuns(context.Background(), &api.JobRunsReq{Token: adminToken, Job: tempTokenJob.Name})
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if len(runs.Runs) < 1 || runs.Runs[0].Id != run.Id {
+			t.Errorf("run not in history: %s", runs)
+		}
+
+		resp, err := s.GetJobs(context.Background(), &api.JobsReq{Token: adminToken})
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if len(resp.Jobs) != 2 || resp.Jobs[0].NextRunAt == 0 || resp.Jobs[0].LastRun.GetId() != run.Id {
+			t.Errorf("unexpected jobs: %s", resp)
+		}
+		if resp.Jobs[1].NextRunAt != 0 {
+			t.Errorf("disabled job should not be scheduled: %s", resp.Jobs[1])
+		}
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		_, err := s.DryRunJob(context.Background(), &api.DryRunJobReq{Token: adminToken, Job: jobs.ReminderToConfirmAccount})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "job has no dry run")
+		if !ok {
+			t.Error(msg)
+		}
+
+		userID, err := testUserDBService.AddUser(testInstanceID, models.User{
+			Account: models.Account{
+				Type:      "email",
+				AccountID: "dry-run-unverified@test.com",
+			},
+			Timestamps: models.Timestamps{CreatedAt: time.Now().Unix() - 100},
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil)
+		threshold := int64(50)
+		report, err := s.DryRunJob(context.Background(), &api.DryRunJobReq{
+			Token:    adminToken,
+			Job:      jobs.CleanUpUnverifiedUsers,
+			Settings: &api.InstanceSettings{CleanUpUnverifiedUsersAfter: &threshold},
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		found := false
+		for _, a := range report.Accounts {
+			found = found || a.UserId == userID
+		}
+		if !found || report.Settings.GetCleanUpUnverifiedUsersAfter() != threshold {
+			t.Errorf("unexpected report: %s", report)
+		}
+		if _, err := testUserDBService.GetUserByID(testInstanceID, userID); err != nil {
+			t.Errorf("user should not be deleted: %v", err)
+		}
+	})
+
+	t.Run("dry run keeps users marked for deletion without study service", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil)
+		notifyAfter := int64(3600)
+		deleteAfter := int64(3600)
+		report, err := s.DryRunJob(context.Background(), &api.DryRunJobReq{
+			Token: adminToken,
+			Job:   jobs.CleanupUsersMarkedForDeletion,
+			Settings: &api.InstanceSettings{
+				NotifyInactiveUsersAfter:        &notifyAfter,
+				DeleteAccountAfterNotifyingUser: &deleteAfter,
+			},
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if len(report.Accounts) != 0 || report.Note == "" {
+			t.Errorf("unexpected report: %s", report)
+		}
+	})
+}
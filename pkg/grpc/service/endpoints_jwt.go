@@ -9,6 +9,7 @@ import (
 	loggingAPI "github.com/influenzanet/logging-service/pkg/api"
 	"github.com/influenzanet/user-management-service/pkg/api"
 	"github.com/influenzanet/user-management-service/pkg/dbs/userdb"
+	"github.com/influenzanet/user-management-service/pkg/metrics"
 	"github.com/influenzanet/user-management-service/pkg/tokens"
 	"github.com/influenzanet/user-management-service/pkg/utils"
 	"google.golang.org/grpc/codes"
@@ -28,16 +29,7 @@ func (s *userManagementServer) ValidateJWT(ctx context.Context, req *api.JWTRequ
 		return nil, status.Error(codes.InvalidArgument, "invalid token")
 	}
 
-	return &api_types.TokenInfos{
-		Id:               parsedToken.ID,
-		InstanceId:       parsedToken.InstanceID,
-		IssuedAt:         parsedToken.IssuedAt,
-		AccountConfirmed: parsedToken.AccountConfirmed,
-		Payload:          parsedToken.Payload,
-		ProfilId:         parsedToken.ProfileID,
-		OtherProfileIds:  parsedToken.OtherProfileIDs,
-		TempToken:        parsedToken.TempTokenInfos.ToAPI(),
-	}, nil
+	return parsedToken.TokenInfos(), nil
 }
 
 func (s *userManagementServer) RenewJWT(ctx context.Context, req *api.RefreshJWTRequest) (*api.TokenResponse, error) {
@@ -49,6 +41,7 @@ func (s *userManagementServer) RenewJWT(ctx context.Context, req *api.RefreshJWT
 	parsedToken, _, err := tokens.ValidateToken(req.AccessToken)
 	if err != nil && !strings.Contains(err.Error(), "token is expired by") {
 		logger.Error.Printf("token refresh -> issue with acces token: %v", err.Error())
+		metrics.TokenRefreshFailed("invalid_access_token")
 		return nil, status.Error(codes.PermissionDenied, "refresh token error")
 	}
 
@@ -61,6 +54,16 @@ func (s *userManagementServer) RenewJWT(ctx context.Context, req *api.RefreshJWT
 		logger.Error.Printf("token refresh -> retrieving user failed with: %v", err.Error())
 		return nil, status.Error(codes.Internal, "refresh token error")
 	}
+	if user.Account.IsDeactivated() {
+		logger.Warning.Printf("token refresh -> account %s is deactivated", user.ID.Hex())
+		metrics.TokenRefreshFailed("account_deactivated")
+		return nil, status.Error(codes.PermissionDenied, "refresh token error")
+	}
+	if user.Account.IsPendingDeletion() {
+		logger.Warning.Printf("token refresh -> account %s is pending deletion", user.ID.Hex())
+		metrics.TokenRefreshFailed("account_pending_deletion")
+		return nil, status.Error(codes.PermissionDenied, "refresh token error")
+	}
 
 	// Generate new refresh token:
 	newRefreshToken, err := tokens.GenerateUniqueTokenString()
@@ -74,6 +77,7 @@ func (s *userManagementServer) RenewJWT(ctx context.Context, req *api.RefreshJWT
 	if err != nil {
 		logger.Error.Printf("token refresh -> failed to validate renew token (%s): %v", req.RefreshToken, err.Error())
 		s.SaveLogEvent(parsedToken.InstanceID, parsedToken.ID, loggingAPI.LogEventType_SECURITY, constants.LOG_EVENT_TOKEN_REFRESH_FAILED, "wrong refresh token, cannot renew")
+		metrics.TokenRefreshFailed("wrong_refresh_token")
 		return nil, status.Error(codes.Internal, "refresh token error")
 	}
 
@@ -95,7 +99,9 @@ func (s *userManagementServer) RenewJWT(ctx context.Context, req *api.RefreshJWT
 	mainProfileID, otherProfileIDs := utils.GetMainAndOtherProfiles(user)
 
 	// Generate new access token:
-	newToken, err := tokens.GenerateNewToken(parsedToken.ID, user.Account.AccountConfirmedAt > 0, mainProfileID, roles, parsedToken.InstanceID, s.Intervals.TokenExpiryInterval, username, nil, otherProfileIDs)
+	settings := s.settings(parsedToken.InstanceID)
+	tokenExpiryInterval := settings.Intervals.TokenExpiryInterval
+	newToken, err := tokens.GenerateNewToken(parsedToken.ID, user.Account.AccountConfirmedAt > 0, mainProfileID, roles, parsedToken.InstanceID, tokenExpiryInterval, username, nil, otherProfileIDs)
 	if err != nil {
 		logger.Error.Printf("renew token error: %v", err.Error())
 		return nil, status.Error(codes.Internal, err.Error())
@@ -109,15 +115,17 @@ func (s *userManagementServer) RenewJWT(ctx context.Context, req *api.RefreshJWT
 	}
 
 	s.SaveLogEvent(parsedToken.InstanceID, parsedToken.ID, loggingAPI.LogEventType_LOG, constants.LOG_EVENT_TOKEN_REFRESH_SUCCESS, "")
+	metrics.TokenRefreshed()
 
 	return &api.TokenResponse{
 		AccessToken:       newToken,
 		RefreshToken:      newRefreshToken,
 		AccountConfirmed:  user.Account.AccountConfirmedAt > 0,
-		ExpiresIn:         int32(s.Intervals.TokenExpiryInterval / time.Minute),
+		ExpiresIn:         int32(tokenExpiryInterval / time.Minute),
 		SelectedProfileId: parsedToken.ProfileID,
 		Profiles:          user.ToAPI().Profiles,
 		PreferredLanguage: user.Account.PreferredLanguage,
+		ConsentsNeeded:    consentsNeeded(settings, user),
 	}, nil
 }
 
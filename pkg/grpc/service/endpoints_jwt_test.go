@@ -18,10 +18,10 @@ func TestValidateJWT(t *testing.T) {
 	s := userManagementServer{
 		userDBservice:   testUserDBService,
 		globalDBService: testGlobalDBService,
-		Intervals: models.Intervals{
+		instanceSettings: testSettingsStore(models.Intervals{
 			TokenExpiryInterval:      time.Second * 2,
 			VerificationCodeLifetime: 60,
-		},
+		}),
 	}
 
 	t.Run("without payload", func(t *testing.T) {
@@ -41,14 +41,14 @@ func TestValidateJWT(t *testing.T) {
 		}
 	})
 
-	adminToken, err1 := tokens.GenerateNewToken("test-admin-id", true, "testprofid", []string{"PARTICIPANT", "ADMIN"}, testInstanceID, s.Intervals.TokenExpiryInterval, "", nil, []string{})
+	adminToken, err1 := tokens.GenerateNewToken("test-admin-id", true, "testprofid", []string{"PARTICIPANT", "ADMIN"}, testInstanceID, s.settings(testInstanceID).Intervals.TokenExpiryInterval, "", nil, []string{})
 	userToken, err2 := tokens.GenerateNewToken(
 		"test-user-id",
 		true,
 		"testprofid",
 		[]string{"PARTICIPANT"},
 		testInstanceID,
-		s.Intervals.TokenExpiryInterval,
+		s.settings(testInstanceID).Intervals.TokenExpiryInterval,
 		"",
 		&models.TempToken{UserID: "test-user-id", Purpose: "testpurpose"},
 		[]string{},
@@ -111,7 +111,7 @@ func TestValidateJWT(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping waiting for token test in short mode, since it has to wait for token expiration.")
 	}
-	time.Sleep(s.Intervals.TokenExpiryInterval + time.Second)
+	time.Sleep(s.settings(testInstanceID).Intervals.TokenExpiryInterval + time.Second)
 
 	t.Run("with expired token", func(t *testing.T) {
 		req := &api.JWTRequest{
@@ -133,10 +133,10 @@ func TestRenewJWT(t *testing.T) {
 	s := userManagementServer{
 		userDBservice:   testUserDBService,
 		globalDBService: testGlobalDBService,
-		Intervals: models.Intervals{
+		instanceSettings: testSettingsStore(models.Intervals{
 			TokenExpiryInterval:      time.Second * 2,
 			VerificationCodeLifetime: 60,
-		},
+		}),
 		clients: &models.APIClients{
 			LoggingService: mockLoggingClient,
 		},
@@ -163,7 +163,7 @@ func TestRenewJWT(t *testing.T) {
 
 	testUserDBService.CreateRenewToken(testInstanceID, testUsers[0].ID.Hex(), refreshToken, time.Now().Add(time.Hour).Unix())
 
-	userToken, err := tokens.GenerateNewToken(testUsers[0].ID.Hex(), true, "testprofid", []string{"PARTICIPANT"}, testInstanceID, s.Intervals.TokenExpiryInterval, "", nil, []string{})
+	userToken, err := tokens.GenerateNewToken(testUsers[0].ID.Hex(), true, "testprofid", []string{"PARTICIPANT"}, testInstanceID, s.settings(testInstanceID).Intervals.TokenExpiryInterval, "", nil, []string{})
 	if err != nil {
 		t.Errorf("unexpected error: %s", err)
 		return
@@ -256,7 +256,7 @@ func TestRenewJWT(t *testing.T) {
 		}
 	})
 
-	time.Sleep(s.Intervals.TokenExpiryInterval)
+	time.Sleep(s.settings(testInstanceID).Intervals.TokenExpiryInterval)
 
 	// Test with expired token
 	t.Run("with expired token", func(t *testing.T) {
@@ -289,10 +289,10 @@ func TestRevokeAllRefreshTokens(t *testing.T) {
 	s := userManagementServer{
 		userDBservice:   testUserDBService,
 		globalDBService: testGlobalDBService,
-		Intervals: models.Intervals{
+		instanceSettings: testSettingsStore(models.Intervals{
 			TokenExpiryInterval:      time.Second * 2,
 			VerificationCodeLifetime: 60,
-		},
+		}),
 	}
 	refreshToken := "TEST-REFRESH-TOKEN-STRING"
 	testUsers, err := addTestUsers([]models.User{
@@ -16,10 +16,10 @@ func TestGetOrCreateTemptokenEndpoint(t *testing.T) {
 	s := userManagementServer{
 		userDBservice:   testUserDBService,
 		globalDBService: testGlobalDBService,
-		Intervals: models.Intervals{
+		instanceSettings: testSettingsStore(models.Intervals{
 			TokenExpiryInterval:      time.Second * 2,
 			VerificationCodeLifetime: 60,
-		},
+		}),
 	}
 
 	testTempToken := models.TempToken{
@@ -91,10 +91,10 @@ func TestGenerateTempTokenEndpoint(t *testing.T) {
 	s := userManagementServer{
 		userDBservice:   testUserDBService,
 		globalDBService: testGlobalDBService,
-		Intervals: models.Intervals{
+		instanceSettings: testSettingsStore(models.Intervals{
 			TokenExpiryInterval:      time.Second * 2,
 			VerificationCodeLifetime: 60,
-		},
+		}),
 	}
 
 	testTempToken := &api_types.TempTokenInfo{
@@ -144,10 +144,10 @@ func TestGetTempTokensEndpoint(t *testing.T) {
 	s := userManagementServer{
 		userDBservice:   testUserDBService,
 		globalDBService: testGlobalDBService,
-		Intervals: models.Intervals{
+		instanceSettings: testSettingsStore(models.Intervals{
 			TokenExpiryInterval:      time.Second * 2,
 			VerificationCodeLifetime: 60,
-		},
+		}),
 	}
 
 	testTempToken := models.TempToken{
@@ -224,10 +224,10 @@ func TestDeleteTempTokenEndpoint(t *testing.T) {
 	s := userManagementServer{
 		userDBservice:   testUserDBService,
 		globalDBService: testGlobalDBService,
-		Intervals: models.Intervals{
+		instanceSettings: testSettingsStore(models.Intervals{
 			TokenExpiryInterval:      time.Second * 2,
 			VerificationCodeLifetime: 60,
-		},
+		}),
 	}
 
 	testTempToken := models.TempToken{
@@ -304,10 +304,10 @@ func TestPurgeUserTempTokensEndpoint(t *testing.T) {
 	s := userManagementServer{
 		userDBservice:   testUserDBService,
 		globalDBService: testGlobalDBService,
-		Intervals: models.Intervals{
+		instanceSettings: testSettingsStore(models.Intervals{
 			TokenExpiryInterval:      time.Second * 2,
 			VerificationCodeLifetime: 60,
-		},
+		}),
 	}
 
 	testTempToken := models.TempToken{
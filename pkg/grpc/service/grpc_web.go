@@ -0,0 +1,55 @@
+package service
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/improbable-eng/grpc-web/go/grpcweb"
+	"github.com/influenzanet/user-management-service/pkg/callerauth"
+	"github.com/influenzanet/user-management-service/pkg/edgeauth"
+	"google.golang.org/grpc"
+)
+
+// GRPCWebConfig controls serving the gRPC API to browsers over gRPC-Web
+type GRPCWebConfig struct {
+	// Port of the gRPC-Web endpoint, disabled if empty
+	Port string
+	// AllowedOrigins for CORS requests, "*" allows any origin
+	AllowedOrigins []string
+}
+
+// newGRPCWebServer wraps the gRPC server into an HTTP/1.1 server speaking gRPC-Web.
+// Server-streaming methods are sent as a chunked response.
+func newGRPCWebServer(server *grpc.Server, conf GRPCWebConfig) *http.Server {
+	wrapped := grpcweb.WrapServer(
+		server,
+		grpcweb.WithOriginFunc(func(origin string) bool {
+			return isOriginAllowed(conf.AllowedOrigins, origin)
+		}),
+		grpcweb.WithAllowedRequestHeaders([]string{"*"}),
+		grpcweb.WithCorsForRegisteredEndpointsOnly(true),
+	)
+
+	// browsers can't hold service credentials, calls are identified as coming from this endpoint. Users are
+	// identified by their access token, the token infos of the requests are replaced by the server interceptors.
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := callerauth.WithInternalCaller(r.Context(), callerauth.CallerGRPCWeb)
+		wrapped.ServeHTTP(w, r.WithContext(edgeauth.WithEdgeRequest(ctx)))
+	})
+
+	return &http.Server{
+		Addr:              ":" + conf.Port,
+		Handler:           handler,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+}
+
+func isOriginAllowed(allowedOrigins []string, origin string) bool {
+	for _, allowed := range allowedOrigins {
+		if allowed == "*" || strings.EqualFold(strings.TrimSuffix(allowed, "/"), origin) {
+			return true
+		}
+	}
+	return false
+}
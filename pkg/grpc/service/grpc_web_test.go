@@ -0,0 +1,60 @@
+package service
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/influenzanet/user-management-service/pkg/api"
+	"google.golang.org/grpc"
+)
+
+func TestGRPCWebServer(t *testing.T) {
+	server := grpc.NewServer()
+	api.RegisterUserManagementApiServer(server, &userManagementServer{})
+	webServer := newGRPCWebServer(server, GRPCWebConfig{AllowedOrigins: []string{"https://admin.example.org/"}})
+
+	statusPath := "/influenzanet.user_management_api.UserManagementApi/Status"
+
+	t.Run("call from allowed origin", func(t *testing.T) {
+		// empty message in a single uncompressed frame
+		req := httptest.NewRequest(http.MethodPost, statusPath, bytes.NewReader([]byte{0, 0, 0, 0, 0}))
+		req.Header.Set("Content-Type", "application/grpc-web+proto")
+		req.Header.Set("Origin", "https://admin.example.org")
+		rec := httptest.NewRecorder()
+		webServer.Handler.ServeHTTP(rec, req)
+
+		// trailers are sent in the body by gRPC-Web
+		if rec.Code != http.StatusOK || !bytes.Contains(rec.Body.Bytes(), []byte("grpc-status: 0")) {
+			t.Errorf("unexpected response: %d %v %s", rec.Code, rec.Header(), rec.Body.String())
+		}
+		if rec.Header().Get("Access-Control-Allow-Origin") != "https://admin.example.org" {
+			t.Errorf("CORS header missing: %v", rec.Header())
+		}
+	})
+
+	t.Run("preflight from other origin", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodOptions, statusPath, nil)
+		req.Header.Set("Origin", "https://evil.example.org")
+		req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+		rec := httptest.NewRecorder()
+		webServer.Handler.ServeHTTP(rec, req)
+
+		if rec.Header().Get("Access-Control-Allow-Origin") != "" {
+			t.Errorf("origin should not be allowed: %v", rec.Header())
+		}
+	})
+}
+
+func TestIsOriginAllowed(t *testing.T) {
+	if !isOriginAllowed([]string{"*"}, "https://any.example.org") {
+		t.Error("wildcard should allow any origin")
+	}
+	if !isOriginAllowed([]string{"https://Admin.example.org"}, "https://admin.example.org") {
+		t.Error("origins should be compared case-insensitively")
+	}
+	if isOriginAllowed([]string{}, "https://admin.example.org") {
+		t.Error("no origin should be allowed by default")
+	}
+}
@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/coneno/logger"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/influenzanet/user-management-service/pkg/api"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	healthCheckInterval = 15 * time.Second
+	healthCheckTimeout  = 5 * time.Second
+)
+
+// checkHealth returns an error if the DBs or the required downstream services can't be reached
+func (s *userManagementServer) checkHealth(ctx context.Context) error {
+	if err := s.userDBservice.Ping(); err != nil {
+		return fmt.Errorf("user DB: %v", err)
+	}
+	if err := s.globalDBService.Ping(); err != nil {
+		return fmt.Errorf("global DB: %v", err)
+	}
+
+	if s.clients == nil || s.clients.MessagingService == nil || s.clients.LoggingService == nil {
+		return errors.New("service clients not initialized")
+	}
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+	if _, err := s.clients.MessagingService.Status(ctx, &empty.Empty{}); err != nil {
+		return fmt.Errorf("messaging service: %v", err)
+	}
+	if _, err := s.clients.LoggingService.Status(ctx, &empty.Empty{}); err != nil {
+		return fmt.Errorf("logging service: %v", err)
+	}
+	return nil
+}
+
+// runHealthChecks updates the serving status until ctx is done
+func runHealthChecks(ctx context.Context, healthServer *health.Server, check func(ctx context.Context) error) {
+	updateHealthStatus(healthServer, check(ctx))
+
+	ticker := time.NewTicker(healthCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			updateHealthStatus(healthServer, check(ctx))
+		}
+	}
+}
+
+func updateHealthStatus(healthServer *health.Server, err error) {
+	status := healthpb.HealthCheckResponse_SERVING
+	if err != nil {
+		logger.Error.Printf("health check failed: %v", err)
+		status = healthpb.HealthCheckResponse_NOT_SERVING
+	}
+	// overall health and health of the UserManagementApi are the same
+	healthServer.SetServingStatus("", status)
+	healthServer.SetServingStatus(api.UserManagementApi_ServiceDesc.ServiceName, status)
+}
@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/influenzanet/user-management-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/models"
+	loggingMock "github.com/influenzanet/user-management-service/test/mocks/logging_service"
+	messageMock "github.com/influenzanet/user-management-service/test/mocks/messaging_service"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestCheckHealth(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockLoggingClient := loggingMock.NewMockLoggingServiceApiClient(mockCtrl)
+	mockMessagingClient := messageMock.NewMockMessagingServiceApiClient(mockCtrl)
+
+	s := userManagementServer{
+		userDBservice:    testUserDBService,
+		globalDBService:  testGlobalDBService,
+		instanceSettings: testSettingsStore(models.Intervals{}),
+		clients: &models.APIClients{
+			MessagingService: mockMessagingClient,
+			LoggingService:   mockLoggingClient,
+		},
+	}
+
+	t.Run("with all dependencies reachable", func(t *testing.T) {
+		mockMessagingClient.EXPECT().Status(gomock.Any(), gomock.Any()).Return(nil, nil)
+		mockLoggingClient.EXPECT().Status(gomock.Any(), gomock.Any()).Return(nil, nil)
+		if err := s.checkHealth(context.Background()); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("with messaging service not reachable", func(t *testing.T) {
+		mockMessagingClient.EXPECT().Status(gomock.Any(), gomock.Any()).Return(nil, errors.New("unavailable"))
+		if err := s.checkHealth(context.Background()); err == nil {
+			t.Error("should fail")
+		}
+	})
+
+	t.Run("with logging service not reachable", func(t *testing.T) {
+		mockMessagingClient.EXPECT().Status(gomock.Any(), gomock.Any()).Return(nil, nil)
+		mockLoggingClient.EXPECT().Status(gomock.Any(), gomock.Any()).Return(nil, errors.New("unavailable"))
+		if err := s.checkHealth(context.Background()); err == nil {
+			t.Error("should fail")
+		}
+	})
+}
+
+func TestUpdateHealthStatus(t *testing.T) {
+	healthServer := health.NewServer()
+
+	checkStatus := func(t *testing.T, expected healthpb.HealthCheckResponse_ServingStatus) {
+		for _, service := range []string{"", api.UserManagementApi_ServiceDesc.ServiceName} {
+			resp, err := healthServer.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			if resp.Status != expected {
+				t.Errorf("unexpected status for '%s': %s", service, resp.Status)
+			}
+		}
+	}
+
+	updateHealthStatus(healthServer, nil)
+	checkStatus(t, healthpb.HealthCheckResponse_SERVING)
+
+	updateHealthStatus(healthServer, errors.New("DB down"))
+	checkStatus(t, healthpb.HealthCheckResponse_NOT_SERVING)
+
+	updateHealthStatus(healthServer, nil)
+	healthServer.Shutdown()
+	checkStatus(t, healthpb.HealthCheckResponse_NOT_SERVING)
+}
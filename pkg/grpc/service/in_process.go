@@ -0,0 +1,28 @@
+package service
+
+import (
+	"context"
+	"net"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const (
+	// InProcessAddress is the target to dial with InProcessDialOption
+	InProcessAddress = "in-process"
+
+	inProcessBufferSize = 1 << 20
+)
+
+// NewInProcessListener creates the listener of the in-process gRPC server, passed to RunServer
+func NewInProcessListener() *bufconn.Listener {
+	return bufconn.Listen(inProcessBufferSize)
+}
+
+// InProcessDialOption connects a client to the in-process server, which doesn't need TLS or client certificates
+func InProcessDialOption(lis *bufconn.Listener) grpc.DialOption {
+	return grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+		return lis.DialContext(ctx)
+	})
+}
@@ -10,6 +10,7 @@ import (
 	loggingAPI "github.com/influenzanet/logging-service/pkg/api"
 	messageAPI "github.com/influenzanet/messaging-service/pkg/api/messaging_service"
 	"github.com/influenzanet/user-management-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/metrics"
 	"github.com/influenzanet/user-management-service/pkg/models"
 	"github.com/influenzanet/user-management-service/pkg/pwhash"
 	"github.com/influenzanet/user-management-service/pkg/tokens"
@@ -42,8 +43,18 @@ func (s *userManagementServer) InitiatePasswordReset(ctx context.Context, req *a
 		}, nil
 	}
 
+	if s.authBackends.BackendForUser(req.InstanceId, user) != nil {
+		// same response as for unknown accounts, the password is managed elsewhere
+		logger.Warning.Printf("password reset attempt for account %s managed by an authentication backend", user.ID.Hex())
+		return &api.ServiceStatus{
+			Msg:     "email sending triggered",
+			Version: apiVersion,
+			Status:  api.ServiceStatus_NORMAL,
+		}, nil
+	}
+
 	if utils.HasMoreAttemptsRecently(user.Account.PasswordResetTriggers, 5, passwordResetAttemptWindow) {
-		logger.Warning.Printf("SECURITY WARNING: password reset attempt blocked for email address for %s - too many tries recently", req.AccountId)
+		logger.Warning.Printf("SECURITY WARNING: password reset attempt blocked for email address for %s - too many tries recently", user.ID.Hex())
 		time.Sleep(time.Duration(rand.Intn(10)) * time.Second)
 		return nil, status.Error(codes.InvalidArgument, "account blocked for a while")
 	}
@@ -53,9 +64,6 @@ func (s *userManagementServer) InitiatePasswordReset(ctx context.Context, req *a
 		UserID:     user.ID.Hex(),
 		InstanceID: req.InstanceId,
 		Purpose:    constants.EMAIL_TYPE_PASSWORD_RESET,
-		Info: map[string]string{
-			"email": user.Account.AccountID,
-		},
 		Expiration: tokens.GetExpirationTime(time.Hour * 24),
 	}
 	tempToken, err := s.globalDBService.AddTempToken(tempTokenInfos)
@@ -85,6 +93,7 @@ func (s *userManagementServer) InitiatePasswordReset(ctx context.Context, req *a
 
 	// ---> Log Event
 	s.SaveLogEvent(req.InstanceId, user.ID.Hex(), loggingAPI.LogEventType_LOG, constants.LOG_EVENT_PASSWORD_RESET_INITIATED, "email sent")
+	metrics.PasswordResetInitiated()
 
 	return &api.ServiceStatus{
 		Msg:     "email sending triggered",
@@ -184,6 +193,7 @@ func (s *userManagementServer) ResetPassword(ctx context.Context, req *api.Reset
 
 	// ---> Log Event
 	s.SaveLogEvent(tokenInfos.InstanceID, user.ID.Hex(), loggingAPI.LogEventType_LOG, constants.LOG_EVENT_PASSWORD_RESET, "new password set after password reset")
+	metrics.PasswordResetCompleted()
 
 	return &api.ServiceStatus{
 		Version: apiVersion,
@@ -2,17 +2,32 @@ package service
 
 import (
 	"context"
+	"crypto/tls"
+	"fmt"
 	"net"
-	"os"
-	"os/signal"
+	"net/http"
+	"time"
 
 	"github.com/coneno/logger"
 	"github.com/influenzanet/user-management-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/authbackend"
+	"github.com/influenzanet/user-management-service/pkg/callerauth"
 	"github.com/influenzanet/user-management-service/pkg/dbs/globaldb"
 	"github.com/influenzanet/user-management-service/pkg/dbs/userdb"
+	"github.com/influenzanet/user-management-service/pkg/edgeauth"
+	"github.com/influenzanet/user-management-service/pkg/instances"
+	"github.com/influenzanet/user-management-service/pkg/instancesettings"
+	"github.com/influenzanet/user-management-service/pkg/jobs"
+	"github.com/influenzanet/user-management-service/pkg/metrics"
 	"github.com/influenzanet/user-management-service/pkg/models"
-	"github.com/influenzanet/user-management-service/pkg/utils"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/test/bufconn"
 )
 
 const (
@@ -22,13 +37,14 @@ const (
 
 type userManagementServer struct {
 	api.UnimplementedUserManagementApiServer
-	clients           *models.APIClients
-	userDBservice     *userdb.UserDBService
-	globalDBService   *globaldb.GlobalDBService
-	Intervals         models.Intervals
-	newUserCountLimit int64
-	weekdayStrategy   utils.WeekDayStrategy
-	instanceIDs       []string
+	clients          *models.APIClients
+	userDBservice    *userdb.UserDBService
+	globalDBService  *globaldb.GlobalDBService
+	instanceSettings *instancesettings.Store
+	instances        *instances.Registry
+	authBackends     *authbackend.Registry
+	// jobs are run by the timer service, the admins can see and trigger them
+	jobs []jobs.Config
 }
 
 // NewUserManagementServer creates a new service instance
@@ -36,63 +52,173 @@ func NewUserManagementServer(
 	clients *models.APIClients,
 	userDBservice *userdb.UserDBService,
 	globalDBservice *globaldb.GlobalDBService,
-	intervals models.Intervals,
-	newUserCountLimit int64,
-	weekdayStrategy utils.WeekDayStrategy,
-	instanceIDs []string,
+	instanceSettings *instancesettings.Store,
+	instances *instances.Registry,
+	authBackends *authbackend.Registry,
+	jobConfigs []jobs.Config,
 ) api.UserManagementApiServer {
 	return &userManagementServer{
-		clients:           clients,
-		userDBservice:     userDBservice,
-		globalDBService:   globalDBservice,
-		Intervals:         intervals,
-		newUserCountLimit: newUserCountLimit,
-		weekdayStrategy:   weekdayStrategy,
-		instanceIDs:       instanceIDs,
+		clients:          clients,
+		userDBservice:    userDBservice,
+		globalDBService:  globalDBservice,
+		instanceSettings: instanceSettings,
+		instances:        instances,
+		authBackends:     authBackends,
+		jobs:             jobConfigs,
 	}
 }
 
+// settings returns the settings in use for the instance
+func (s *userManagementServer) settings(instanceID string) instancesettings.Settings {
+	return s.instanceSettings.Get(instanceID)
+}
+
 // RunServer runs gRPC service to publish ToDo service
 func RunServer(ctx context.Context, port string,
 	clients *models.APIClients,
 	userDBservice *userdb.UserDBService,
 	globalDBservice *globaldb.GlobalDBService,
-	intervals models.Intervals,
-	newUserCountLimit int64,
-	weekdayStrategy utils.WeekDayStrategy,
-	instanceIDs []string,
+	instanceSettings *instancesettings.Store,
+	instances *instances.Registry,
+	authBackends *authbackend.Registry,
+	jobConfigs []jobs.Config,
+	callerAuth *callerauth.Authenticator,
+	grpcWebConf GRPCWebConfig,
+	enableReflection bool,
+	tlsConf *tls.Config,
+	inProcessListener *bufconn.Listener,
+	shutdownTimeout time.Duration,
 ) error {
 	lis, err := net.Listen("tcp", ":"+port)
 	if err != nil {
-		logger.Error.Fatalf("failed to listen: %v", err)
+		return fmt.Errorf("failed to listen: %w", err)
 	}
+	// a failing gRPC-Web server shuts the gRPC server down too, its error is returned
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	webErr := make(chan error, 1)
 
-	// register service
-	server := grpc.NewServer()
-	api.RegisterUserManagementApiServer(server, NewUserManagementServer(
+	unaryInterceptors := []grpc.UnaryServerInterceptor{metrics.UnaryServerInterceptor}
+	streamInterceptors := []grpc.StreamServerInterceptor{metrics.StreamServerInterceptor}
+	if callerAuth != nil {
+		unaryInterceptors = append(unaryInterceptors, callerAuth.UnaryServerInterceptor)
+		streamInterceptors = append(streamInterceptors, callerAuth.StreamServerInterceptor)
+	}
+	// gRPC-Web requests carry the token infos of the user's access token only
+	unaryInterceptors = append(unaryInterceptors, edgeauth.UnaryServerInterceptor)
+	streamInterceptors = append(streamInterceptors, edgeauth.StreamServerInterceptor)
+
+	serverOpts := []grpc.ServerOption{
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+	}
+	umServer := NewUserManagementServer(
 		clients,
 		userDBservice,
 		globalDBservice,
-		intervals,
-		newUserCountLimit,
-		weekdayStrategy,
-		instanceIDs,
-	))
-
-	// graceful shutdown
-	c := make(chan os.Signal, 1)
-	signal.Notify(c, os.Interrupt)
+		instanceSettings,
+		instances,
+		authBackends,
+		jobConfigs,
+	).(*userManagementServer)
+
+	// register service
+	transportCreds := insecure.NewCredentials()
+	if tlsConf != nil {
+		transportCreds = credentials.NewTLS(tlsConf)
+	}
+	server := grpc.NewServer(append(serverOpts, grpc.Creds(transportCreds))...)
+	api.RegisterUserManagementApiServer(server, umServer)
+
+	// the same service without TLS for the servers running in-process, e.g. the HTTP gateway
+	var inProcessServer *grpc.Server
+	if inProcessListener != nil {
+		inProcessServer = grpc.NewServer(serverOpts...)
+		api.RegisterUserManagementApiServer(inProcessServer, umServer)
+		go func() {
+			if err := inProcessServer.Serve(inProcessListener); err != nil {
+				logger.Error.Printf("in-process gRPC server: %v", err)
+			}
+		}()
+	}
+
+	// standard health checking (grpc.health.v1)
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(server, healthServer)
+	healthCtx, stopHealthChecks := context.WithCancel(ctx)
+	defer stopHealthChecks()
+	go runHealthChecks(healthCtx, healthServer, umServer.checkHealth)
+
+	if enableReflection {
+		logger.Info.Println("gRPC server reflection is enabled")
+		reflection.Register(server)
+	}
+
+	var webServer *http.Server
+	if grpcWebConf.Port != "" {
+		webServer = newGRPCWebServer(server, grpcWebConf)
+		go func() {
+			logger.Debug.Println("starting gRPC-Web server...")
+			logger.Debug.Println("wait connections on port " + grpcWebConf.Port)
+			if err := webServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				webErr <- fmt.Errorf("gRPC-Web server: %w", err)
+				cancel()
+			}
+		}()
+	}
+
+	// graceful shutdown, when ctx is cancelled (SIGINT or SIGTERM)
+	shutdownDone := make(chan struct{})
 	go func() {
-		for range c {
-			// sig is a ^C, handle it
-			logger.Debug.Println("shutting down gRPC server...")
-			server.GracefulStop()
-			<-ctx.Done()
+		defer close(shutdownDone)
+		<-ctx.Done()
+		stopHealthChecks()
+		healthServer.Shutdown()
+
+		drainCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		if webServer != nil {
+			logger.Debug.Println("shutting down gRPC-Web server...")
+			if err := webServer.Shutdown(drainCtx); err != nil {
+				logger.Error.Printf("gRPC-Web server shutdown: %v", err)
+			}
+		}
+		logger.Debug.Println("shutting down gRPC server...")
+		if inProcessServer != nil {
+			gracefulStop(drainCtx, inProcessServer)
 		}
+		gracefulStop(drainCtx, server)
+		logger.Info.Println("gRPC server stopped")
 	}()
 
 	// start gRPC server
 	logger.Debug.Println("starting gRPC server...")
 	logger.Debug.Println("wait connections on port " + port)
-	return server.Serve(lis)
+	if err := server.Serve(lis); err != nil {
+		return err
+	}
+	<-shutdownDone
+	select {
+	case err := <-webErr:
+		return err
+	default:
+		return nil
+	}
+}
+
+// gracefulStop waits for the in-flight RPCs to complete, those still running when ctx is done are cancelled
+func gracefulStop(ctx context.Context, server *grpc.Server) {
+	stopped := make(chan struct{})
+	go func() {
+		server.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		logger.Warning.Println("shutdown timeout reached, cancelling remaining RPCs")
+		server.Stop()
+		<-stopped
+	}
 }
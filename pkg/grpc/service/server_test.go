@@ -11,7 +11,9 @@ import (
 	"github.com/coneno/logger"
 	"github.com/influenzanet/user-management-service/pkg/dbs/globaldb"
 	"github.com/influenzanet/user-management-service/pkg/dbs/userdb"
+	"github.com/influenzanet/user-management-service/pkg/instancesettings"
 	"github.com/influenzanet/user-management-service/pkg/models"
+	"github.com/influenzanet/user-management-service/pkg/utils"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"google.golang.org/grpc/status"
 )
@@ -136,6 +138,20 @@ func shouldHaveGrpcErrorStatus(err error, expectedError string) (bool, string) {
 	return true, ""
 }
 
+// testSettingsStore returns a store using the default settings with intervals for every instance
+func testSettingsStore(intervals models.Intervals) *instancesettings.Store {
+	return instancesettings.NewStore(testGlobalDBService, instancesettings.Settings{
+		Intervals:          intervals,
+		NewUserCountLimit:  100,
+		MaxProfiles:        instancesettings.DefaultMaxProfiles,
+		WeekdayStrategy:    utils.CreateWeekdayDefaultStrategy(),
+		SecondFactorPolicy: instancesettings.SecondFactorOptional,
+
+		AccountDeletionGracePeriod: instancesettings.DefaultAccountDeletionGracePeriod,
+		RetentionAction:            instancesettings.RetentionActionDelete,
+	})
+}
+
 func addTestUsers(userDefs []models.User) (users []models.User, err error) {
 	for _, uc := range userDefs {
 		ID, err := testUserDBService.AddUser(testInstanceID, uc)
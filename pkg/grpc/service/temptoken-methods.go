@@ -9,7 +9,7 @@ import (
 )
 
 func (s *userManagementServer) CleanExpiredTemptokens(offset int64) {
-	err := s.globalDBService.DeleteTempTokensExpireBefore("", "", time.Now().Unix()-offset)
+	_, err := s.globalDBService.DeleteTempTokensExpireBefore("", "", time.Now().Unix()-offset)
 	if err != nil {
 		logger.Error.Printf("unexpected error while deleting expired temp tokens: %v", err)
 		return
@@ -83,40 +83,35 @@ func (s *userManagementServer) CreateUser(ctx context.Context, req *api.CreateUs
 		}
 	}
 
+	instanceID := req.Token.InstanceId
+	settings := s.settings(instanceID)
+
 	newUser.AddNewEmail(req.AccountId, false)
-	if req.Use_2Fa {
+	if settings.UseSecondFactor(req.Use_2Fa) {
 		newUser.Account.AuthType = "2FA"
 	}
 	newUser.ContactPreferences.SubscribedToNewsletter = false
 	newUser.ContactPreferences.SendNewsletterTo = []string{newUser.ContactInfos[0].ID.Hex()}
 	newUser.ContactPreferences.SubscribedToWeekly = false
-	newUser.ContactPreferences.ReceiveWeeklyMessageDayOfWeek = int32(s.weekdayStrategy.Weekday())
+	newUser.ContactPreferences.ReceiveWeeklyMessageDayOfWeek = int32(settings.WeekdayStrategy.Weekday())
 
-	instanceID := req.Token.InstanceId
-	id, err := s.userDBservice.AddUser(instanceID, newUser)
-	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
-	}
-	newUser.ID, _ = primitive.ObjectIDFromHex(id)
+	newUser.ID = primitive.NewObjectID()
 
 	// TempToken for contact verification:
 	tempTokenInfos := models.TempToken{
-		UserID:     id,
+		UserID:     newUser.ID.Hex(),
 		InstanceID: instanceID,
 		Purpose:    constants.TOKEN_PURPOSE_INVITATION,
-		Info: map[string]string{
-			"type":  "email",
-			"email": newUser.Account.AccountID,
-		},
-		Expiration: tokens.GetExpirationTime(s.Intervals.InvitationTokenLifetime),
+		Info:       newUser.ContactVerificationInfos(newUser.Account.AccountID),
+		Expiration: tokens.GetExpirationTime(settings.Intervals.InvitationTokenLifetime),
 	}
 	tempToken, err := s.globalDBService.AddTempToken(tempTokenInfos)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	// ---> Trigger message sending
-	_, err = s.clients.MessagingService.SendInstantEmail(ctx, &messageAPI.SendEmailReq{
+	// invitation is queued together with the user, so it is sent even if the messaging service is down
+	invitation := models.NewOutgoingEmail(models.OUTGOING_EMAIL_INSTANT, &messageAPI.SendEmailReq{
 		InstanceId:  instanceID,
 		To:          []string{newUser.Account.AccountID},
 		MessageType: constants.EMAIL_TYPE_INVITATION,
@@ -126,12 +121,14 @@ func (s *userManagementServer) CreateUser(ctx context.Context, req *api.CreateUs
 		PreferredLanguage: newUser.Account.PreferredLanguage,
 		UseLowPrio:        true,
 	})
-	if err != nil {
-		logger.Error.Printf("CreateUser: %s", err.Error())
+	if _, err := s.userDBservice.AddUserWithOutgoingEmails(instanceID, newUser, []models.OutgoingEmail{invitation}); err != nil {
+		if err := s.globalDBService.DeleteTempToken(tempToken); err != nil {
+			logger.Error.Printf("CreateUser: %s", err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
 	}
-	// <---
 
-	s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_LOG, constants.LOG_EVENT_ACCOUNT_CREATED, "by admin - "+newUser.ID.Hex()+" - "+newUser.Account.AccountID)
+	s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_LOG, constants.LOG_EVENT_ACCOUNT_CREATED, "by admin - "+newUser.ID.Hex())
 
 	return newUser.ToAPI(), nil
 }
@@ -156,7 +153,7 @@ func (s *userManagementServer) AddRoleForUser(ctx context.Context, req *api.Role
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_LOG, constants.LOG_EVENT_ACCOUNT_ROLE_ADDED, user.Account.AccountID+"("+user.ID.Hex()+") + "+req.Role)
+	s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_LOG, constants.LOG_EVENT_ACCOUNT_ROLE_ADDED, user.ID.Hex()+" + "+req.Role)
 
 	return user.ToAPI(), nil
 }
@@ -180,7 +177,7 @@ func (s *userManagementServer) RemoveRoleForUser(ctx context.Context, req *api.R
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_LOG, constants.LOG_EVENT_ACCOUNT_ROLE_REMOVED, user.Account.AccountID+"("+user.ID.Hex()+") - "+req.Role)
+	s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_LOG, constants.LOG_EVENT_ACCOUNT_ROLE_REMOVED, user.ID.Hex()+" - "+req.Role)
 	return user.ToAPI(), nil
 }
 
@@ -210,7 +207,8 @@ func (s *userManagementServer) StreamUsers(req *api.StreamUsersMsg, stream api.U
 		return status.Error(codes.InvalidArgument, "missing arguments")
 	}
 
-	ctx := context.Background()
+	// cancelled when the client goes away, e.g. a closed browser tab
+	ctx := stream.Context()
 
 	sendUserOverGrpc := func(instanceID string, user models.User, args ...interface{}) error {
 		if len(args) != 1 {
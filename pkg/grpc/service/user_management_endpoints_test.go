@@ -23,10 +23,10 @@ func TestCreateUserEndpoint(t *testing.T) {
 	s := userManagementServer{
 		userDBservice:   testUserDBService,
 		globalDBService: testGlobalDBService,
-		Intervals: models.Intervals{
+		instanceSettings: testSettingsStore(models.Intervals{
 			TokenExpiryInterval:      time.Second * 2,
 			VerificationCodeLifetime: 60,
-		},
+		}),
 		clients: &models.APIClients{
 			MessagingService: mockMessagingClient,
 			LoggingService:   mockLoggingClient,
@@ -70,10 +70,6 @@ func TestCreateUserEndpoint(t *testing.T) {
 	})
 
 	t.Run("with valid arguments", func(t *testing.T) {
-		mockMessagingClient.EXPECT().SendInstantEmail(
-			gomock.Any(),
-			gomock.Any(),
-		).Return(nil, nil)
 		mockLoggingClient.EXPECT().SaveLogEvent(
 			gomock.Any(),
 			gomock.Any(),
@@ -106,6 +102,10 @@ func TestCreateUserEndpoint(t *testing.T) {
 			t.Errorf("unexpected response: %s", resp)
 			return
 		}
+		count, err := testUserDBService.CountOutgoingEmails(testInstanceID, models.OUTGOING_EMAIL_STATUS_PENDING)
+		if err != nil || count < 1 {
+			t.Errorf("invitation should be in the outbox: %d %v", count, err)
+		}
 	})
 
 	t.Run("with already existing user", func(t *testing.T) {
@@ -137,10 +137,10 @@ func TestAddRoleForUserEndpoint(t *testing.T) {
 	s := userManagementServer{
 		userDBservice:   testUserDBService,
 		globalDBService: testGlobalDBService,
-		Intervals: models.Intervals{
+		instanceSettings: testSettingsStore(models.Intervals{
 			TokenExpiryInterval:      time.Second * 2,
 			VerificationCodeLifetime: 60,
-		},
+		}),
 		clients: &models.APIClients{
 			LoggingService: mockLoggingClient,
 		},
@@ -253,10 +253,10 @@ func TestRemoveRoleForUserEndpoint(t *testing.T) {
 	s := userManagementServer{
 		userDBservice:   testUserDBService,
 		globalDBService: testGlobalDBService,
-		Intervals: models.Intervals{
+		instanceSettings: testSettingsStore(models.Intervals{
 			TokenExpiryInterval:      time.Second * 2,
 			VerificationCodeLifetime: 60,
-		},
+		}),
 		clients: &models.APIClients{
 			LoggingService: mockLoggingClient,
 		},
@@ -364,10 +364,10 @@ func TestFindNonParticipantUsersEndpoint(t *testing.T) {
 	s := userManagementServer{
 		userDBservice:   testUserDBService,
 		globalDBService: testGlobalDBService,
-		Intervals: models.Intervals{
+		instanceSettings: testSettingsStore(models.Intervals{
 			TokenExpiryInterval:      time.Second * 2,
 			VerificationCodeLifetime: 60,
-		},
+		}),
 	}
 
 	_, err := addTestUsers([]models.User{
@@ -469,10 +469,10 @@ func TestStreamUsersEndpoint(t *testing.T) {
 	s := userManagementServer{
 		userDBservice:   testUserDBService,
 		globalDBService: testGlobalDBService,
-		Intervals: models.Intervals{
+		instanceSettings: testSettingsStore(models.Intervals{
 			TokenExpiryInterval:      time.Second * 2,
 			VerificationCodeLifetime: 60,
-		},
+		}),
 	}
 
 	_, err := addTestUsers([]models.User{
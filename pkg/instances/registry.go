@@ -0,0 +1,143 @@
+// Package instances keeps the list of instances served by the service up to date while it runs.
+package instances
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/coneno/logger"
+	"github.com/influenzanet/user-management-service/pkg/dbs/globaldb"
+)
+
+// PollInterval is how long it takes to notice an instance that was added, disabled or removed
+const PollInterval = 30 * time.Second
+
+// Registry holds the enabled instances of the global DB. Instances are disabled by setting "disabled" to true in
+// their document of the instances collection.
+type Registry struct {
+	load func() ([]string, error)
+
+	mu        sync.RWMutex
+	ids       []string
+	onAdded   []func(instanceID string)
+	onRemoved []func(instanceID string)
+}
+
+// NewRegistry creates a registry for the instances of the global DB. Register the callbacks, then call Refresh
+// to load the instances.
+func NewRegistry(globalDBService *globaldb.GlobalDBService) *Registry {
+	return newRegistry(globalDBService.GetEnabledInstanceIDs)
+}
+
+// NewStaticRegistry creates a registry with a fixed list of instances
+func NewStaticRegistry(instanceIDs ...string) *Registry {
+	r := newRegistry(func() ([]string, error) { return instanceIDs, nil })
+	_ = r.Refresh()
+	return r
+}
+
+func newRegistry(load func() ([]string, error)) *Registry {
+	return &Registry{load: load, ids: []string{}}
+}
+
+// OnAdded registers f to be called for each new instance, including the instances found by the first Refresh
+func (r *Registry) OnAdded(f func(instanceID string)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.onAdded = append(r.onAdded, f)
+}
+
+// OnRemoved registers f to be called for each instance that was disabled or removed
+func (r *Registry) OnRemoved(f func(instanceID string)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.onRemoved = append(r.onRemoved, f)
+}
+
+// IDs returns the IDs of the instances, sorted
+func (r *Registry) IDs() []string {
+	if r == nil {
+		return []string{}
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return append([]string{}, r.ids...)
+}
+
+// Has tells if the instance is enabled
+func (r *Registry) Has(instanceID string) bool {
+	if r == nil {
+		return false
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	i := sort.SearchStrings(r.ids, instanceID)
+	return i < len(r.ids) && r.ids[i] == instanceID
+}
+
+// Refresh loads the instances and calls the callbacks for the changes. On error, the current instances are kept.
+func (r *Registry) Refresh() error {
+	ids, err := r.load()
+	if err != nil {
+		return err
+	}
+	ids = append([]string{}, ids...)
+	sort.Strings(ids)
+
+	r.mu.Lock()
+	added, removed := diff(r.ids, ids)
+	r.ids = ids
+	onAdded, onRemoved := r.onAdded, r.onRemoved
+	r.mu.Unlock()
+
+	for _, instanceID := range added {
+		logger.Info.Printf("instance %s added", instanceID)
+		for _, f := range onAdded {
+			f(instanceID)
+		}
+	}
+	for _, instanceID := range removed {
+		logger.Info.Printf("instance %s disabled or removed", instanceID)
+		for _, f := range onRemoved {
+			f(instanceID)
+		}
+	}
+	return nil
+}
+
+// Run refreshes the instances until ctx is done
+func (r *Registry) Run(ctx context.Context) {
+	ticker := time.NewTicker(PollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := r.Refresh(); err != nil {
+				logger.Error.Printf("instances: %v", err)
+			}
+		}
+	}
+}
+
+// diff returns the IDs only in next and only in current, both must be sorted
+func diff(current []string, next []string) (added []string, removed []string) {
+	i, j := 0, 0
+	for i < len(current) || j < len(next) {
+		switch {
+		case j == len(next) || (i < len(current) && current[i] < next[j]):
+			removed = append(removed, current[i])
+			i++
+		case i == len(current) || next[j] < current[i]:
+			added = append(added, next[j])
+			j++
+		default:
+			i++
+			j++
+		}
+	}
+	return
+}
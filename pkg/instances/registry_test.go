@@ -0,0 +1,60 @@
+package instances
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	added, removed := diff([]string{"a", "b", "d"}, []string{"b", "c", "d", "e"})
+	if !reflect.DeepEqual(added, []string{"c", "e"}) || !reflect.DeepEqual(removed, []string{"a"}) {
+		t.Errorf("unexpected diff: added %v, removed %v", added, removed)
+	}
+	added, removed = diff(nil, nil)
+	if len(added) != 0 || len(removed) != 0 {
+		t.Errorf("unexpected diff: added %v, removed %v", added, removed)
+	}
+}
+
+func TestRefresh(t *testing.T) {
+	ids := []string{"de", "ch"}
+	var loadErr error
+	r := newRegistry(func() ([]string, error) { return ids, loadErr })
+
+	added := []string{}
+	removed := []string{}
+	r.OnAdded(func(instanceID string) { added = append(added, instanceID) })
+	r.OnRemoved(func(instanceID string) { removed = append(removed, instanceID) })
+
+	if err := r.Refresh(); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(added, []string{"ch", "de"}) || !r.Has("de") || r.Has("fr") {
+		t.Errorf("instances not loaded: %v", r.IDs())
+	}
+
+	ids = []string{"ch", "fr"}
+	if err := r.Refresh(); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(removed, []string{"de"}) || !r.Has("fr") || r.Has("de") {
+		t.Errorf("unexpected instances: %v, removed %v", r.IDs(), removed)
+	}
+
+	loadErr = errors.New("unavailable")
+	ids = nil
+	if err := r.Refresh(); err == nil {
+		t.Error("error expected")
+	}
+	if !reflect.DeepEqual(r.IDs(), []string{"ch", "fr"}) {
+		t.Errorf("instances should be kept on error: %v", r.IDs())
+	}
+}
+
+func TestNilRegistry(t *testing.T) {
+	var r *Registry
+	if r.Has("de") || len(r.IDs()) != 0 {
+		t.Error("nil registry should have no instance")
+	}
+}
@@ -0,0 +1,240 @@
+package instancesettings
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/influenzanet/user-management-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/models"
+	"github.com/influenzanet/user-management-service/pkg/utils"
+)
+
+// Second factor policies
+const (
+	// SecondFactorOptional lets users choose to use a second factor, the default
+	SecondFactorOptional = "optional"
+	// SecondFactorRequired asks every user for a verification code on login
+	SecondFactorRequired = "required"
+	// SecondFactorDisabled never asks for a verification code
+	SecondFactorDisabled = "disabled"
+)
+
+// Retention actions, what the jobs do with inactive and unverified accounts
+const (
+	// RetentionActionDelete deletes the accounts together with the data of their profiles, the default
+	RetentionActionDelete = "delete"
+	// RetentionActionAnonymize keeps the accounts and their profiles, only the personal data is removed
+	RetentionActionAnonymize = "anonymize"
+)
+
+// DefaultMaxProfiles is the number of profiles a user can add, unless set otherwise
+const DefaultMaxProfiles = 6
+
+// DefaultAccountDeletionGracePeriod is how long an account can be restored after its deletion was requested, in
+// seconds, unless set otherwise
+const DefaultAccountDeletionGracePeriod = 14 * 24 * 60 * 60
+
+// Settings in use for an instance
+type Settings struct {
+	Intervals                 models.Intervals
+	NewUserCountLimit         int64
+	MaxProfiles               int
+	WeekdayAssignationWeights string
+	WeekdayStrategy           utils.WeekDayStrategy
+	SecondFactorPolicy        string
+
+	// thresholds of the timer jobs, in seconds
+	CleanUpUnverifiedUsersAfter       int64
+	ReminderToUnverifiedAccountsAfter int64
+	NotifyInactiveUsersAfter          int64
+	DeleteAccountAfterNotifyingUser   int64
+	// AccountDeletionGracePeriod is how long participants can restore their account after requesting its deletion
+	AccountDeletionGracePeriod int64
+	RetentionAction            string
+	// RequiredConsentVersions is the minimum version of each consent type profiles must have accepted, by consent type
+	RequiredConsentVersions map[string]string
+}
+
+// Apply returns the settings with the fields set in overrides replaced, overrides must be valid
+func (s Settings) Apply(overrides models.InstanceSettings) Settings {
+	if overrides.AccessTokenLifetime != nil {
+		s.Intervals.TokenExpiryInterval = time.Duration(*overrides.AccessTokenLifetime) * time.Second
+	}
+	if overrides.VerificationCodeLifetime != nil {
+		s.Intervals.VerificationCodeLifetime = *overrides.VerificationCodeLifetime
+	}
+	if overrides.InvitationTokenLifetime != nil {
+		s.Intervals.InvitationTokenLifetime = time.Duration(*overrides.InvitationTokenLifetime) * time.Second
+	}
+	if overrides.ContactVerificationTokenLifetime != nil {
+		s.Intervals.ContactVerificationTokenLifetime = time.Duration(*overrides.ContactVerificationTokenLifetime) * time.Second
+	}
+	if overrides.NewUserRateLimit != nil {
+		s.NewUserCountLimit = *overrides.NewUserRateLimit
+	}
+	if overrides.MaxProfiles != nil {
+		s.MaxProfiles = int(*overrides.MaxProfiles)
+	}
+	if overrides.WeekdayAssignationWeights != nil {
+		if strategy, err := weekdayStrategy(*overrides.WeekdayAssignationWeights); err == nil {
+			s.WeekdayAssignationWeights = *overrides.WeekdayAssignationWeights
+			s.WeekdayStrategy = strategy
+		}
+	}
+	if overrides.SecondFactorPolicy != nil {
+		s.SecondFactorPolicy = *overrides.SecondFactorPolicy
+	}
+	if overrides.CleanUpUnverifiedUsersAfter != nil {
+		s.CleanUpUnverifiedUsersAfter = *overrides.CleanUpUnverifiedUsersAfter
+	}
+	if overrides.ReminderToUnverifiedAccountsAfter != nil {
+		s.ReminderToUnverifiedAccountsAfter = *overrides.ReminderToUnverifiedAccountsAfter
+	}
+	if overrides.NotifyInactiveUsersAfter != nil {
+		s.NotifyInactiveUsersAfter = *overrides.NotifyInactiveUsersAfter
+	}
+	if overrides.DeleteAccountAfterNotifyingUser != nil {
+		s.DeleteAccountAfterNotifyingUser = *overrides.DeleteAccountAfterNotifyingUser
+	}
+	if overrides.AccountDeletionGracePeriod != nil {
+		s.AccountDeletionGracePeriod = *overrides.AccountDeletionGracePeriod
+	}
+	if overrides.RetentionAction != nil {
+		s.RetentionAction = *overrides.RetentionAction
+	}
+	if overrides.RequiredConsentVersions != nil {
+		s.RequiredConsentVersions = overrides.RequiredConsentVersions
+	}
+	return s
+}
+
+// UseSecondFactor tells if a verification code is needed, when the user chose to use one or not
+func (s Settings) UseSecondFactor(chosen bool) bool {
+	switch s.SecondFactorPolicy {
+	case SecondFactorRequired:
+		return true
+	case SecondFactorDisabled:
+		return false
+	default:
+		return chosen
+	}
+}
+
+// HandlesInactiveUsers tells if inactive users are notified and then deleted, which needs both thresholds
+func (s Settings) HandlesInactiveUsers() bool {
+	return s.NotifyInactiveUsersAfter > 0 && s.DeleteAccountAfterNotifyingUser > 0
+}
+
+// ConsentsNeeded returns the consent types, sorted, the profile has to accept in their required version
+func (s Settings) ConsentsNeeded(p models.Profile) []string {
+	consentTypes := []string{}
+	for consentType, version := range s.RequiredConsentVersions {
+		if p.NeedsConsent(consentType, version) {
+			consentTypes = append(consentTypes, consentType)
+		}
+	}
+	sort.Strings(consentTypes)
+	return consentTypes
+}
+
+// AnonymizesAccounts tells if the jobs anonymize inactive and unverified accounts instead of deleting them
+func (s Settings) AnonymizesAccounts() bool {
+	return s.RetentionAction == RetentionActionAnonymize
+}
+
+func (s Settings) ToAPI() *api.InstanceSettings {
+	accessTokenLifetime := int64(s.Intervals.TokenExpiryInterval / time.Second)
+	invitationTokenLifetime := int64(s.Intervals.InvitationTokenLifetime / time.Second)
+	contactVerificationTokenLifetime := int64(s.Intervals.ContactVerificationTokenLifetime / time.Second)
+	maxProfiles := int32(s.MaxProfiles)
+	return &api.InstanceSettings{
+		AccessTokenLifetime:               &accessTokenLifetime,
+		VerificationCodeLifetime:          &s.Intervals.VerificationCodeLifetime,
+		InvitationTokenLifetime:           &invitationTokenLifetime,
+		ContactVerificationTokenLifetime:  &contactVerificationTokenLifetime,
+		NewUserRateLimit:                  &s.NewUserCountLimit,
+		MaxProfiles:                       &maxProfiles,
+		WeekdayAssignationWeights:         &s.WeekdayAssignationWeights,
+		SecondFactorPolicy:                &s.SecondFactorPolicy,
+		CleanUpUnverifiedUsersAfter:       &s.CleanUpUnverifiedUsersAfter,
+		ReminderToUnverifiedAccountsAfter: &s.ReminderToUnverifiedAccountsAfter,
+		NotifyInactiveUsersAfter:          &s.NotifyInactiveUsersAfter,
+		DeleteAccountAfterNotifyingUser:   &s.DeleteAccountAfterNotifyingUser,
+		AccountDeletionGracePeriod:        &s.AccountDeletionGracePeriod,
+		RetentionAction:                   &s.RetentionAction,
+		RequiredConsentVersions:           s.RequiredConsentVersions,
+	}
+}
+
+// Validate checks the values of the fields set in overrides
+func Validate(overrides models.InstanceSettings) error {
+	positive := map[string]*int64{
+		"access token lifetime":                 overrides.AccessTokenLifetime,
+		"verification code lifetime":            overrides.VerificationCodeLifetime,
+		"invitation token lifetime":             overrides.InvitationTokenLifetime,
+		"contact verification token lifetime":   overrides.ContactVerificationTokenLifetime,
+		"new user rate limit":                   overrides.NewUserRateLimit,
+		"clean up unverified users after":       overrides.CleanUpUnverifiedUsersAfter,
+		"reminder to unverified accounts after": overrides.ReminderToUnverifiedAccountsAfter,
+		"account deletion grace period":         overrides.AccountDeletionGracePeriod,
+	}
+	for name, value := range positive {
+		if value != nil && *value < 1 {
+			return fmt.Errorf("%s must be positive", name)
+		}
+	}
+	if overrides.NotifyInactiveUsersAfter != nil && *overrides.NotifyInactiveUsersAfter < 0 {
+		return fmt.Errorf("notify inactive users after must not be negative")
+	}
+	if overrides.DeleteAccountAfterNotifyingUser != nil && *overrides.DeleteAccountAfterNotifyingUser < 0 {
+		return fmt.Errorf("delete account after notifying user must not be negative")
+	}
+	if overrides.MaxProfiles != nil && *overrides.MaxProfiles < 1 {
+		return fmt.Errorf("max profiles must be positive")
+	}
+	if overrides.WeekdayAssignationWeights != nil {
+		if _, err := weekdayStrategy(*overrides.WeekdayAssignationWeights); err != nil {
+			return fmt.Errorf("weekday assignation weights: %v", err)
+		}
+	}
+	if overrides.SecondFactorPolicy != nil {
+		switch *overrides.SecondFactorPolicy {
+		case SecondFactorOptional, SecondFactorRequired, SecondFactorDisabled:
+		default:
+			return fmt.Errorf("second factor policy must be %s, %s or %s", SecondFactorOptional, SecondFactorRequired, SecondFactorDisabled)
+		}
+	}
+	if overrides.RetentionAction != nil {
+		switch *overrides.RetentionAction {
+		case RetentionActionDelete, RetentionActionAnonymize:
+		default:
+			return fmt.Errorf("retention action must be %s or %s", RetentionActionDelete, RetentionActionAnonymize)
+		}
+	}
+	for consentType, version := range overrides.RequiredConsentVersions {
+		if consentType == "" || version == "" {
+			return fmt.Errorf("required consent versions must have a consent type and a version")
+		}
+	}
+	return nil
+}
+
+// weekdayStrategy assigns random weekdays if weights is empty
+func weekdayStrategy(weights string) (utils.WeekDayStrategy, error) {
+	if weights == "" {
+		return utils.CreateWeekdayDefaultStrategy(), nil
+	}
+	w, err := utils.ParseWeeklyWeight(weights)
+	if err != nil {
+		return utils.WeekDayStrategy{}, err
+	}
+	total := 0
+	for _, v := range w {
+		total += v
+	}
+	if total == 0 {
+		return utils.WeekDayStrategy{}, fmt.Errorf("at least one day must have a weight")
+	}
+	return utils.CreateWeekdayWeightedStrategy(w), nil
+}
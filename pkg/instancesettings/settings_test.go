@@ -0,0 +1,122 @@
+package instancesettings
+
+import (
+	"testing"
+	"time"
+
+	"github.com/influenzanet/user-management-service/pkg/models"
+	"github.com/influenzanet/user-management-service/pkg/utils"
+)
+
+func testDefaults() Settings {
+	return Settings{
+		Intervals: models.Intervals{
+			TokenExpiryInterval:      55 * time.Minute,
+			VerificationCodeLifetime: 900,
+		},
+		NewUserCountLimit:  100,
+		MaxProfiles:        6,
+		WeekdayStrategy:    utils.CreateWeekdayDefaultStrategy(),
+		SecondFactorPolicy: SecondFactorOptional,
+	}
+}
+
+func TestApply(t *testing.T) {
+	t.Run("without overrides", func(t *testing.T) {
+		s := testDefaults().Apply(models.InstanceSettings{})
+		if s.Intervals.TokenExpiryInterval != 55*time.Minute || s.NewUserCountLimit != 100 || s.MaxProfiles != 6 {
+			t.Errorf("defaults expected: %+v", s)
+		}
+	})
+
+	t.Run("with overrides", func(t *testing.T) {
+		lifetime := int64(600)
+		maxProfiles := int32(2)
+		weights := "Mon=1"
+		s := testDefaults().Apply(models.InstanceSettings{
+			AccessTokenLifetime:       &lifetime,
+			MaxProfiles:               &maxProfiles,
+			WeekdayAssignationWeights: &weights,
+		})
+		if s.Intervals.TokenExpiryInterval != 10*time.Minute {
+			t.Errorf("unexpected token lifetime: %s", s.Intervals.TokenExpiryInterval)
+		}
+		if s.Intervals.VerificationCodeLifetime != 900 {
+			t.Errorf("unset field should keep default: %d", s.Intervals.VerificationCodeLifetime)
+		}
+		if s.MaxProfiles != 2 {
+			t.Errorf("unexpected max profiles: %d", s.MaxProfiles)
+		}
+		if s.WeekdayStrategy.Weekday() != int(time.Monday) {
+			t.Error("weekday strategy not applied")
+		}
+	})
+}
+
+func TestUseSecondFactor(t *testing.T) {
+	s := testDefaults()
+	if s.UseSecondFactor(false) || !s.UseSecondFactor(true) {
+		t.Error("optional policy should follow the user's choice")
+	}
+	s.SecondFactorPolicy = SecondFactorRequired
+	if !s.UseSecondFactor(false) {
+		t.Error("required policy should always use second factor")
+	}
+	s.SecondFactorPolicy = SecondFactorDisabled
+	if s.UseSecondFactor(true) {
+		t.Error("disabled policy should never use second factor")
+	}
+}
+
+func TestConsentsNeeded(t *testing.T) {
+	s := testDefaults()
+	p := models.Profile{ConsentRecords: []models.ConsentRecord{
+		{ConsentType: "participation", Version: "1", AcceptedAt: 10},
+		{ConsentType: "data-sharing", Version: "1", AcceptedAt: 10, WithdrawnAt: 20},
+		{ConsentType: "participation", Version: "2", AcceptedAt: 30},
+	}}
+	if needed := s.ConsentsNeeded(p); len(needed) != 0 {
+		t.Errorf("no consent should be needed without required versions: %v", needed)
+	}
+
+	s.RequiredConsentVersions = map[string]string{"participation": "2", "data-sharing": "1", "newsletter": "1"}
+	needed := s.ConsentsNeeded(p)
+	if len(needed) != 2 || needed[0] != "data-sharing" || needed[1] != "newsletter" {
+		t.Errorf("withdrawn and missing consents should be needed: %v", needed)
+	}
+
+	s.RequiredConsentVersions = map[string]string{"participation": "3"}
+	if needed := s.ConsentsNeeded(p); len(needed) != 1 || needed[0] != "participation" {
+		t.Errorf("consent of an older version should be needed: %v", needed)
+	}
+
+	s.RequiredConsentVersions = map[string]string{"participation": "1"}
+	if needed := s.ConsentsNeeded(p); len(needed) != 0 {
+		t.Errorf("consent of a newer version should not be needed: %v", needed)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	zero := int64(0)
+	zeroProfiles := int32(0)
+	noWeight := "Mon=0,Tue=0"
+	policy := "sometimes"
+	action := "archive"
+
+	if err := Validate(models.InstanceSettings{NotifyInactiveUsersAfter: &zero}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	for name, s := range map[string]models.InstanceSettings{
+		"zero lifetime":      {AccessTokenLifetime: &zero},
+		"zero rate limit":    {NewUserRateLimit: &zero},
+		"zero profiles":      {MaxProfiles: &zeroProfiles},
+		"no weekday weight":  {WeekdayAssignationWeights: &noWeight},
+		"unknown 2FA policy": {SecondFactorPolicy: &policy},
+		"unknown action":     {RetentionAction: &action},
+		"consent version":    {RequiredConsentVersions: map[string]string{"participation": ""}},
+	} {
+		if err := Validate(s); err == nil {
+			t.Errorf("%s: should be invalid", name)
+		}
+	}
+}
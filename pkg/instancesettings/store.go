@@ -0,0 +1,123 @@
+package instancesettings
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/coneno/logger"
+	"github.com/influenzanet/user-management-service/pkg/dbs/globaldb"
+	"github.com/influenzanet/user-management-service/pkg/models"
+)
+
+// RefreshInterval is how long changes made through another service instance take to be used
+const RefreshInterval = time.Minute
+
+// Store caches the settings of the instances stored in the global DB
+type Store struct {
+	globalDBService *globaldb.GlobalDBService
+	defaults        Settings
+
+	mu        sync.RWMutex
+	overrides map[string]models.InstanceSettings
+	settings  map[string]Settings
+}
+
+// NewStore creates a store using defaults for the settings not set for an instance. Call Refresh to load the settings.
+func NewStore(globalDBService *globaldb.GlobalDBService, defaults Settings) *Store {
+	return &Store{
+		globalDBService: globalDBService,
+		defaults:        defaults,
+		overrides:       map[string]models.InstanceSettings{},
+		settings:        map[string]Settings{},
+	}
+}
+
+// Get returns the settings in use for the instance
+func (s *Store) Get(instanceID string) Settings {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if settings, ok := s.settings[instanceID]; ok {
+		return settings
+	}
+	return s.defaults
+}
+
+// Overrides returns the settings stored for the instance
+func (s *Store) Overrides(instanceID string) models.InstanceSettings {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if overrides, ok := s.overrides[instanceID]; ok {
+		return overrides
+	}
+	return models.InstanceSettings{InstanceID: instanceID}
+}
+
+// Refresh loads the settings of all instances from the DB
+func (s *Store) Refresh() error {
+	all, err := s.globalDBService.GetAllInstanceSettings()
+	if err != nil {
+		return err
+	}
+	s.load(all)
+	return nil
+}
+
+// load replaces the cached settings by all. Invalid settings are skipped, the instance keeps its last valid settings
+// or the defaults.
+func (s *Store) load(all []models.InstanceSettings) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	overrides := make(map[string]models.InstanceSettings, len(all))
+	settings := make(map[string]Settings, len(all))
+	for _, o := range all {
+		if err := Validate(o); err != nil {
+			logger.Error.Printf("instance settings of %s are invalid and not used: %v", o.InstanceID, err)
+			if previous, ok := s.overrides[o.InstanceID]; ok {
+				overrides[o.InstanceID] = previous
+				settings[o.InstanceID] = s.settings[o.InstanceID]
+			}
+			continue
+		}
+		overrides[o.InstanceID] = o
+		settings[o.InstanceID] = s.defaults.Apply(o)
+	}
+	s.overrides = overrides
+	s.settings = settings
+}
+
+// Run refreshes the settings until ctx is done
+func (s *Store) Run(ctx context.Context) {
+	if err := s.globalDBService.CreateIndexForInstanceSettings(); err != nil {
+		logger.Error.Printf("instance settings: failed to create index: %v", err)
+	}
+
+	ticker := time.NewTicker(RefreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.Refresh(); err != nil {
+				logger.Error.Printf("instance settings: %v", err)
+			}
+		}
+	}
+}
+
+// Update stores the settings of overrides.InstanceID, replacing the previous ones. The settings must be valid.
+// Other service instances use them after their next refresh.
+func (s *Store) Update(overrides models.InstanceSettings) (models.InstanceSettings, error) {
+	overrides.UpdatedAt = time.Now().Unix()
+	if err := s.globalDBService.ReplaceInstanceSettings(overrides); err != nil {
+		return overrides, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.overrides[overrides.InstanceID] = overrides
+	s.settings[overrides.InstanceID] = s.defaults.Apply(overrides)
+	return overrides, nil
+}
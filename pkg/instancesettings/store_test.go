@@ -0,0 +1,37 @@
+package instancesettings
+
+import (
+	"testing"
+
+	"github.com/influenzanet/user-management-service/pkg/models"
+)
+
+func TestStoreLoad(t *testing.T) {
+	store := NewStore(nil, testDefaults())
+	valid := int32(2)
+	invalid := int32(0)
+
+	store.load([]models.InstanceSettings{
+		{InstanceID: "first", MaxProfiles: &valid},
+		{InstanceID: "second", MaxProfiles: &invalid},
+	})
+	if s := store.Get("first"); s.MaxProfiles != 2 {
+		t.Errorf("valid settings should be used: %d", s.MaxProfiles)
+	}
+	if s := store.Get("second"); s.MaxProfiles != testDefaults().MaxProfiles {
+		t.Errorf("invalid settings should not be used: %d", s.MaxProfiles)
+	}
+	if o := store.Overrides("second"); o.MaxProfiles != nil {
+		t.Errorf("invalid settings should not be kept: %+v", o)
+	}
+
+	store.load([]models.InstanceSettings{
+		{InstanceID: "first", MaxProfiles: &invalid},
+	})
+	if s := store.Get("first"); s.MaxProfiles != 2 {
+		t.Errorf("last valid settings should be kept: %d", s.MaxProfiles)
+	}
+	if o := store.Overrides("first"); o.MaxProfiles == nil || *o.MaxProfiles != 2 {
+		t.Errorf("last valid settings should be kept: %+v", o)
+	}
+}
@@ -0,0 +1,80 @@
+// Package jobs names the background jobs of the service and parses their schedules.
+package jobs
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// Names of the jobs, also used in metrics and in the run history
+const (
+	CleanUpUnverifiedUsers        = "cleanup_unverified_users"
+	ReminderToConfirmAccount      = "reminder_to_confirm_account"
+	DetectAndNotifyInactiveUsers  = "detect_and_notify_inactive_users"
+	CleanupUsersMarkedForDeletion = "cleanup_users_marked_for_deletion"
+	CleanUpExpiredTempTokens      = "cleanup_expired_temp_tokens"
+	CompleteAccountDeletions      = "complete_account_deletions"
+	ResumeAccountErasures         = "resume_account_erasures"
+)
+
+// Names lists all jobs, in the order they are started when they are due at the same time
+var Names = []string{
+	CleanUpUnverifiedUsers,
+	ReminderToConfirmAccount,
+	DetectAndNotifyInactiveUsers,
+	CleanupUsersMarkedForDeletion,
+	CleanUpExpiredTempTokens,
+	CompleteAccountDeletions,
+	ResumeAccountErasures,
+}
+
+// Config of a job
+type Config struct {
+	Name string
+	// Schedule is a cron expression with five fields (minute, hour, day of month, month, day of week) or a
+	// descriptor like "@hourly" or "@every 90m". Times are in the local time zone of the service.
+	Schedule string
+	Enabled  bool
+
+	schedule cron.Schedule
+}
+
+// NewConfig parses the schedule of a job
+func NewConfig(name string, schedule string, enabled bool) (Config, error) {
+	known := false
+	for _, n := range Names {
+		known = known || n == name
+	}
+	if !known {
+		return Config{}, fmt.Errorf("unknown job '%s'", name)
+	}
+	s, err := cron.ParseStandard(schedule)
+	if err != nil {
+		return Config{}, fmt.Errorf("invalid schedule '%s': %v", schedule, err)
+	}
+	return Config{Name: name, Schedule: schedule, Enabled: enabled, schedule: s}, nil
+}
+
+// NextRun returns when the job is due after its last scheduled run, 0 if it never ran. Without a previous run, the
+// job is due at the next time of its schedule after now. The zero time is returned for disabled jobs.
+func (c Config) NextRun(lastRun int64, now time.Time) time.Time {
+	if !c.Enabled || c.schedule == nil {
+		return time.Time{}
+	}
+	if lastRun == 0 {
+		return c.schedule.Next(now)
+	}
+	return c.schedule.Next(time.Unix(lastRun, 0))
+}
+
+// Find returns the config of the named job
+func Find(configs []Config, name string) (Config, bool) {
+	for _, c := range configs {
+		if c.Name == name {
+			return c, true
+		}
+	}
+	return Config{}, false
+}
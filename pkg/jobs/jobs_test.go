@@ -0,0 +1,47 @@
+package jobs
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewConfig(t *testing.T) {
+	if _, err := NewConfig("unknown", "@hourly", true); err == nil {
+		t.Error("unknown job should be rejected")
+	}
+	if _, err := NewConfig(CleanUpUnverifiedUsers, "every day", true); err == nil {
+		t.Error("invalid schedule should be rejected")
+	}
+	if _, err := NewConfig(CleanUpUnverifiedUsers, "0 3 * * *", true); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestNextRun(t *testing.T) {
+	now := time.Date(2024, 3, 1, 10, 30, 0, 0, time.Local)
+
+	t.Run("cron expression", func(t *testing.T) {
+		c, _ := NewConfig(CleanUpExpiredTempTokens, "0 3 * * *", true)
+		if next := c.NextRun(0, now); !next.Equal(time.Date(2024, 3, 2, 3, 0, 0, 0, time.Local)) {
+			t.Errorf("unexpected next run: %s", next)
+		}
+	})
+
+	t.Run("interval from last run", func(t *testing.T) {
+		c, _ := NewConfig(CleanUpExpiredTempTokens, "@every 90m", true)
+		lastRun := now.Add(-2 * time.Hour)
+		if next := c.NextRun(lastRun.Unix(), now); !next.Equal(lastRun.Add(90 * time.Minute)) {
+			t.Errorf("missed run should be due: %s", next)
+		}
+		if next := c.NextRun(0, now); !next.Equal(now.Add(90 * time.Minute)) {
+			t.Errorf("unexpected first run: %s", next)
+		}
+	})
+
+	t.Run("disabled job", func(t *testing.T) {
+		c, _ := NewConfig(CleanUpExpiredTempTokens, "@hourly", false)
+		if next := c.NextRun(0, now); !next.IsZero() {
+			t.Errorf("disabled job should not run: %s", next)
+		}
+	})
+}
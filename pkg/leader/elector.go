@@ -0,0 +1,181 @@
+// Package leader elects one service instance to run a task, through a lease in the global DB.
+package leader
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/coneno/logger"
+	"github.com/influenzanet/user-management-service/pkg/dbs/globaldb"
+	"github.com/influenzanet/user-management-service/pkg/models"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const (
+	// LeaseDuration is how long another service instance takes over after the leader crashed
+	LeaseDuration = 30 * time.Second
+	// heartbeatInterval must leave time for a few renewal attempts within LeaseDuration
+	heartbeatInterval = 10 * time.Second
+)
+
+// ErrNotLeader is returned by Check when this service instance doesn't hold the lease
+var ErrNotLeader = errors.New("not the leader")
+
+// leases is implemented by the global DB
+type leases interface {
+	AcquireLease(name string, holder string, duration time.Duration) (models.Lease, error)
+	RenewLease(name string, holder string, token int64, duration time.Duration) error
+	ReleaseLease(name string, holder string, token int64) error
+	CheckLease(name string, holder string, token int64) error
+}
+
+// Elector campaigns for a lease and runs a task while holding it. Clocks of the service instances must be in sync
+// within a few seconds.
+type Elector struct {
+	leases leases
+	name   string
+	holder string
+
+	mu    sync.Mutex
+	token int64
+	// deadline after which the lease may have been taken over, if it couldn't be renewed
+	deadline time.Time
+}
+
+// NewElector creates an elector for the lease called name
+func NewElector(globalDBService *globaldb.GlobalDBService, name string) *Elector {
+	return newElector(globalDBService, name, holderID())
+}
+
+func newElector(leases leases, name string, holder string) *Elector {
+	return &Elector{leases: leases, name: name, holder: holder}
+}
+
+// holderID identifies this process, the random part distinguishes restarts on the same host
+func holderID() string {
+	host, err := os.Hostname()
+	if err != nil {
+		host = "unknown"
+	}
+	b := make([]byte, 4)
+	_, _ = rand.Read(b)
+	return host + "-" + hex.EncodeToString(b)
+}
+
+// Run calls lead whenever this service instance becomes the leader, until ctx is done. The context passed to lead
+// is cancelled when the lease is lost, and lead must return then. The lease is released when ctx is done.
+func (e *Elector) Run(ctx context.Context, lead func(ctx context.Context)) {
+	var stopLeading func()
+	ticker := time.NewTicker(heartbeatInterval)
+	defer ticker.Stop()
+	for {
+		if stopLeading == nil {
+			if e.acquire() {
+				stopLeading = startLeading(ctx, lead)
+			}
+		} else if !e.renew() {
+			stopLeading()
+			stopLeading = nil
+		}
+
+		select {
+		case <-ctx.Done():
+			if stopLeading != nil {
+				stopLeading()
+				e.release()
+			}
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// startLeading runs lead in the background, the returned function stops it and waits for it to return
+func startLeading(ctx context.Context, lead func(ctx context.Context)) func() {
+	leadCtx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		lead(leadCtx)
+	}()
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
+func (e *Elector) acquire() bool {
+	lease, err := e.leases.AcquireLease(e.name, e.holder, LeaseDuration)
+	if err != nil {
+		if err != mongo.ErrNoDocuments {
+			logger.Error.Printf("leader election %s: %v", e.name, err)
+		}
+		return false
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.token = lease.Token
+	e.deadline = time.Now().Add(LeaseDuration)
+	logger.Info.Printf("leader election %s: %s is the leader (token %d)", e.name, e.holder, lease.Token)
+	return true
+}
+
+// renew returns false if the lease is lost
+func (e *Elector) renew() bool {
+	e.mu.Lock()
+	token := e.token
+	deadline := e.deadline
+	e.mu.Unlock()
+
+	err := e.leases.RenewLease(e.name, e.holder, token, LeaseDuration)
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	switch {
+	case err == nil:
+		e.deadline = time.Now().Add(LeaseDuration)
+		return true
+	case err != mongo.ErrNoDocuments && time.Now().Add(heartbeatInterval).Before(deadline):
+		// keep leading while the lease can't have expired yet
+		logger.Warning.Printf("leader election %s: failed to renew lease, retrying: %v", e.name, err)
+		return true
+	default:
+		logger.Warning.Printf("leader election %s: %s lost the lease: %v", e.name, e.holder, err)
+		e.token = 0
+		return false
+	}
+}
+
+func (e *Elector) release() {
+	e.mu.Lock()
+	token := e.token
+	e.token = 0
+	e.mu.Unlock()
+	if err := e.leases.ReleaseLease(e.name, e.holder, token); err != nil {
+		logger.Error.Printf("leader election %s: failed to release lease: %v", e.name, err)
+	}
+}
+
+// Check returns nil if this service instance still holds the lease. Call it before each step of the task, so that
+// a former leader which didn't notice the loss of the lease yet doesn't act anymore (fencing).
+func (e *Elector) Check() error {
+	e.mu.Lock()
+	token := e.token
+	e.mu.Unlock()
+	if token == 0 {
+		return ErrNotLeader
+	}
+
+	if err := e.leases.CheckLease(e.name, e.holder, token); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return ErrNotLeader
+		}
+		return err
+	}
+	return nil
+}
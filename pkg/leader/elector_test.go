@@ -0,0 +1,141 @@
+package leader
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/influenzanet/user-management-service/pkg/models"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// memoryLeases behaves like the global DB
+type memoryLeases struct {
+	mu    sync.Mutex
+	lease models.Lease
+	err   error
+}
+
+func (m *memoryLeases) AcquireLease(name string, holder string, duration time.Duration) (models.Lease, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.err != nil {
+		return models.Lease{}, m.err
+	}
+	now := time.Now().Unix()
+	if m.lease.Holder != "" && m.lease.ExpiresAt > now {
+		return models.Lease{}, mongo.ErrNoDocuments
+	}
+	m.lease = models.Lease{Name: name, Holder: holder, Token: m.lease.Token + 1, AcquiredAt: now, ExpiresAt: now + int64(duration.Seconds())}
+	return m.lease, nil
+}
+
+func (m *memoryLeases) RenewLease(name string, holder string, token int64, duration time.Duration) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.err != nil {
+		return m.err
+	}
+	if m.lease.Holder != holder || m.lease.Token != token {
+		return mongo.ErrNoDocuments
+	}
+	m.lease.ExpiresAt = time.Now().Unix() + int64(duration.Seconds())
+	return nil
+}
+
+func (m *memoryLeases) ReleaseLease(name string, holder string, token int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.lease.Holder == holder && m.lease.Token == token {
+		m.lease.ExpiresAt = 0
+	}
+	return nil
+}
+
+func (m *memoryLeases) CheckLease(name string, holder string, token int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.lease.Holder != holder || m.lease.Token != token || m.lease.ExpiresAt <= time.Now().Unix() {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+func (m *memoryLeases) expire() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.lease.ExpiresAt = 0
+}
+
+func TestElection(t *testing.T) {
+	db := &memoryLeases{}
+	a := newElector(db, "jobs", "a")
+	b := newElector(db, "jobs", "b")
+
+	if !a.acquire() || b.acquire() {
+		t.Fatal("only the first replica should be the leader")
+	}
+	if a.Check() != nil || b.Check() != ErrNotLeader {
+		t.Error("unexpected check result")
+	}
+	if !a.renew() {
+		t.Error("leader should renew its lease")
+	}
+
+	t.Run("take over after expiry", func(t *testing.T) {
+		// a crashed and didn't renew
+		db.expire()
+		if !b.acquire() {
+			t.Fatal("lease should be taken over")
+		}
+		if a.Check() != ErrNotLeader {
+			t.Error("former leader should be fenced")
+		}
+		if a.renew() {
+			t.Error("former leader should notice the loss of the lease")
+		}
+		if a.Check() != ErrNotLeader || b.Check() != nil {
+			t.Error("unexpected check result")
+		}
+	})
+
+	t.Run("DB unavailable", func(t *testing.T) {
+		db.err = errors.New("unavailable")
+		if !b.renew() {
+			t.Error("leader should keep the lease until it may have expired")
+		}
+		b.deadline = time.Now()
+		if b.renew() {
+			t.Error("leader should give up when the lease may have expired")
+		}
+		db.err = nil
+	})
+
+	t.Run("release", func(t *testing.T) {
+		if b.acquire() {
+			t.Fatal("lease given up should only be acquired after it expired")
+		}
+		db.expire()
+		if !b.acquire() {
+			t.Fatal("lease should be acquired again")
+		}
+		b.release()
+		if !a.acquire() {
+			t.Error("released lease should be acquired right away")
+		}
+	})
+}
+
+func TestStartLeading(t *testing.T) {
+	stopped := false
+	stop := startLeading(context.Background(), func(ctx context.Context) {
+		<-ctx.Done()
+		stopped = true
+	})
+	stop()
+	if !stopped {
+		t.Error("stop should wait for the task")
+	}
+}
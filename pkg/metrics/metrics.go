@@ -0,0 +1,264 @@
+// Package metrics collects the Prometheus metrics of the service and serves them over HTTP.
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/coneno/logger"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const (
+	namespace = "user_management"
+
+	// Path the metrics are served at
+	Path = "/metrics"
+
+	userCountInterval = 5 * time.Minute
+)
+
+// Registry holds all metrics of the service
+var Registry = prometheus.NewRegistry()
+
+var (
+	rpcDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "rpc_duration_seconds",
+		Help:      "Duration of gRPC calls by method and status code.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "code"})
+
+	loginAttempts = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "login_attempts_total",
+		Help:      "Login attempts by method and result (success or failure reason).",
+	}, []string{"method", "result"})
+
+	secondFactorChallenges = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "second_factor_challenges_total",
+		Help:      "Verification codes requested from users logging in with 2FA.",
+	})
+
+	passwordResets = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "password_resets_total",
+		Help:      "Password resets by step (initiated, completed).",
+	}, []string{"step"})
+
+	tokenRefreshes = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "token_refreshes_total",
+		Help:      "Token refreshes by result (success or failure reason).",
+	}, []string{"result"})
+
+	users = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "users",
+		Help:      "Number of user accounts per instance.",
+	}, []string{"instance_id"})
+
+	jobDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "job_duration_seconds",
+		Help:      "Run duration of the background jobs.",
+		Buckets:   []float64{0.1, 0.5, 1, 5, 10, 30, 60, 300, 900, 1800},
+	}, []string{"job"})
+
+	jobAffectedUsers = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "job_affected_users_total",
+		Help:      "Users affected by the background jobs, per instance.",
+	}, []string{"job", "instance_id"})
+
+	auditOutboxBacklog = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "audit_outbox_backlog",
+		Help:      "Log events not delivered to the logging service yet.",
+	})
+
+	auditDeliveryFailures = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "audit_delivery_failures_total",
+		Help:      "Failed attempts to deliver log events to the logging service.",
+	})
+
+	emailOutbox = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "email_outbox",
+		Help:      "Emails in the outbox per instance and status (pending or failed).",
+	}, []string{"instance_id", "status"})
+
+	emailDeliveryFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "email_delivery_failures_total",
+		Help:      "Failed attempts to hand emails over to the messaging service, per instance.",
+	}, []string{"instance_id"})
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		rpcDuration,
+		loginAttempts,
+		secondFactorChallenges,
+		passwordResets,
+		tokenRefreshes,
+		users,
+		jobDuration,
+		jobAffectedUsers,
+		auditOutboxBacklog,
+		auditDeliveryFailures,
+		emailOutbox,
+		emailDeliveryFailures,
+	)
+}
+
+// Login methods
+const (
+	LoginMethodEmail       = "email"
+	LoginMethodExternalIDP = "external_idp"
+)
+
+// LoginSucceeded counts a successful login
+func LoginSucceeded(method string) {
+	loginAttempts.WithLabelValues(method, "success").Inc()
+}
+
+// LoginFailed counts a failed login with its reason, e.g. "wrong_password"
+func LoginFailed(method string, reason string) {
+	loginAttempts.WithLabelValues(method, reason).Inc()
+}
+
+// SecondFactorChallenged counts a verification code sent during login
+func SecondFactorChallenged() {
+	secondFactorChallenges.Inc()
+}
+
+// PasswordResetInitiated counts a password reset email
+func PasswordResetInitiated() {
+	passwordResets.WithLabelValues("initiated").Inc()
+}
+
+// PasswordResetCompleted counts a password set with a reset token
+func PasswordResetCompleted() {
+	passwordResets.WithLabelValues("completed").Inc()
+}
+
+// TokenRefreshed counts a successful token refresh
+func TokenRefreshed() {
+	tokenRefreshes.WithLabelValues("success").Inc()
+}
+
+// TokenRefreshFailed counts a refused token refresh with its reason, e.g. "wrong_refresh_token"
+func TokenRefreshFailed(reason string) {
+	tokenRefreshes.WithLabelValues(reason).Inc()
+}
+
+// StartJob starts measuring the run of a background job, call the returned function when it is done
+func StartJob(job string) (done func()) {
+	timer := prometheus.NewTimer(jobDuration.WithLabelValues(job))
+	return func() {
+		timer.ObserveDuration()
+	}
+}
+
+// AddJobAffectedUsers counts the users a background job acted on
+func AddJobAffectedUsers(job string, instanceID string, count int) {
+	jobAffectedUsers.WithLabelValues(job, instanceID).Add(float64(count))
+}
+
+// SetAuditOutboxBacklog sets the number of undelivered log events
+func SetAuditOutboxBacklog(count int64) {
+	auditOutboxBacklog.Set(float64(count))
+}
+
+// AuditEventDeliveryFailed counts a failed delivery to the logging service
+func AuditEventDeliveryFailed() {
+	auditDeliveryFailures.Inc()
+}
+
+// SetEmailOutboxCount sets the number of emails of an instance with the given outbox status
+func SetEmailOutboxCount(instanceID string, status string, count int64) {
+	emailOutbox.WithLabelValues(instanceID, status).Set(float64(count))
+}
+
+// EmailDeliveryFailed counts a failed attempt to send an email of the instance
+func EmailDeliveryFailed(instanceID string) {
+	emailDeliveryFailures.WithLabelValues(instanceID).Inc()
+}
+
+// UpdateUserCounts sets the user count gauge of each instance
+func UpdateUserCounts(instanceIDs []string, countUsers func(instanceID string) (int64, error)) {
+	for _, instanceID := range instanceIDs {
+		count, err := countUsers(instanceID)
+		if err != nil {
+			logger.Error.Printf("metrics: failed to count users of %s: %v", instanceID, err)
+			continue
+		}
+		users.WithLabelValues(instanceID).Set(float64(count))
+	}
+}
+
+// RemoveInstance deletes the gauges of a disabled or removed instance
+func RemoveInstance(instanceID string) {
+	users.DeleteLabelValues(instanceID)
+	emailOutbox.DeletePartialMatch(prometheus.Labels{"instance_id": instanceID})
+}
+
+// Handler serves the metrics in the Prometheus text format
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry})
+}
+
+// RunServer serves the metrics and refreshes the user counts of the current instances until ctx is done
+func RunServer(ctx context.Context, port string, instanceIDs func() []string, countUsers func(instanceID string) (int64, error)) error {
+	countsDone := make(chan struct{})
+	go func() {
+		defer close(countsDone)
+		ticker := time.NewTicker(userCountInterval)
+		defer ticker.Stop()
+		for {
+			UpdateUserCounts(instanceIDs(), countUsers)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	mux := http.NewServeMux()
+	mux.Handle(Path, Handler())
+	server := &http.Server{
+		Addr:              ":" + port,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	shutdownDone := make(chan struct{})
+	go func() {
+		defer close(shutdownDone)
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			logger.Error.Printf("metrics server shutdown: %v", err)
+		}
+	}()
+
+	logger.Debug.Println("starting metrics server...")
+	logger.Debug.Println("wait connections on port " + port)
+	if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		return err
+	}
+	// ListenAndServe returns as soon as the shutdown starts, the requests in progress are waited for
+	<-shutdownDone
+	<-countsDone
+	return nil
+}
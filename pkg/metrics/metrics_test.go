@@ -0,0 +1,97 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/test.Api/Login"}
+	_, _ = UnaryServerInterceptor(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	})
+	_, err := UnaryServerInterceptor(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, status.Error(codes.InvalidArgument, "wrong")
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("error should be passed through: %v", err)
+	}
+
+	if n := testutil.CollectAndCount(rpcDuration, "user_management_rpc_duration_seconds"); n != 2 {
+		t.Errorf("expected a series per code, got %d", n)
+	}
+}
+
+func TestAuthCounters(t *testing.T) {
+	LoginSucceeded(LoginMethodEmail)
+	LoginFailed(LoginMethodEmail, "wrong_password")
+	LoginFailed(LoginMethodEmail, "wrong_password")
+	TokenRefreshFailed("wrong_refresh_token")
+
+	if v := testutil.ToFloat64(loginAttempts.WithLabelValues(LoginMethodEmail, "wrong_password")); v != 2 {
+		t.Errorf("unexpected count: %v", v)
+	}
+	if v := testutil.ToFloat64(loginAttempts.WithLabelValues(LoginMethodEmail, "success")); v != 1 {
+		t.Errorf("unexpected count: %v", v)
+	}
+	if v := testutil.ToFloat64(tokenRefreshes.WithLabelValues("wrong_refresh_token")); v != 1 {
+		t.Errorf("unexpected count: %v", v)
+	}
+}
+
+func TestJobMetrics(t *testing.T) {
+	done := StartJob("test_job")
+	done()
+	AddJobAffectedUsers("test_job", "inst1", 3)
+
+	if n := testutil.CollectAndCount(jobDuration, "user_management_job_duration_seconds"); n != 1 {
+		t.Errorf("unexpected series count: %d", n)
+	}
+	if v := testutil.ToFloat64(jobAffectedUsers.WithLabelValues("test_job", "inst1")); v != 3 {
+		t.Errorf("unexpected count: %v", v)
+	}
+}
+
+func TestUpdateUserCounts(t *testing.T) {
+	UpdateUserCounts([]string{"inst1", "inst2"}, func(instanceID string) (int64, error) {
+		if instanceID == "inst2" {
+			return 0, errors.New("DB down")
+		}
+		return 42, nil
+	})
+	if v := testutil.ToFloat64(users.WithLabelValues("inst1")); v != 42 {
+		t.Errorf("unexpected count: %v", v)
+	}
+
+	rec := httptest.NewRecorder()
+	Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, Path, nil))
+	if rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), `user_management_users{instance_id="inst1"} 42`) {
+		t.Errorf("unexpected response: %d", rec.Code)
+	}
+	if strings.Contains(rec.Body.String(), `instance_id="inst2"`) {
+		t.Error("failed count should not be reported")
+	}
+}
+
+func TestRemoveInstance(t *testing.T) {
+	UpdateUserCounts([]string{"removed"}, func(instanceID string) (int64, error) { return 3, nil })
+	SetEmailOutboxCount("removed", "pending", 1)
+	SetEmailOutboxCount("removed", "failed", 2)
+
+	RemoveInstance("removed")
+
+	rec := httptest.NewRecorder()
+	Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, Path, nil))
+	if strings.Contains(rec.Body.String(), `instance_id="removed"`) {
+		t.Error("gauges of removed instance should be deleted")
+	}
+}
@@ -1,18 +1,31 @@
 package models
 
 import (
+	"crypto/rand"
+	"encoding/hex"
+
 	"github.com/influenzanet/user-management-service/pkg/api"
 )
 
 // Account holds information about user authentication methods
 type Account struct {
-	Type               string           `bson:"type"`
-	AccountID          string           `bson:"accountID"`
+	Type      string `bson:"type"`
+	AccountID string `bson:"accountID"`
+	// AccountIDIndex is the blind index of the account ID, if the user DB encrypts it
+	AccountIDIndex     string           `bson:"accountIDIndex,omitempty"`
 	AccountConfirmedAt int64            `bson:"accountConfirmedAt"`
 	Password           string           `bson:"password"`
 	AuthType           string           `bson:"authType"`
 	VerificationCode   VerificationCode `bson:"verificationCode"`
 	PreferredLanguage  string           `bson:"preferredLanguage"`
+	DeactivatedAt      int64            `bson:"deactivatedAt,omitempty"`
+	ProvisionedBy      string           `bson:"provisionedBy,omitempty"` // name of the directory client that created the account
+	// DeletionScheduledAt is when the account is deleted, after the participant requested its deletion
+	DeletionScheduledAt int64 `bson:"deletionScheduledAt,omitempty"`
+	// ErasureStartedAt is set once the erasure of the account started, it can't be restored anymore
+	ErasureStartedAt int64 `bson:"erasureStartedAt,omitempty"`
+	// AnonymizedAt is set once the personal data of the account was removed, its profiles are kept
+	AnonymizedAt int64 `bson:"anonymizedAt,omitempty"`
 
 	// Rate limiting
 	FailedLoginAttempts   []int64 `bson:"failedLoginAttempts"`
@@ -27,6 +40,31 @@ type VerificationCode struct {
 	ExpiresAt int64  `bson:"expiresAt"`
 }
 
+// IsDeactivated checks whether the account was disabled, e.g. by the directory provisioning it
+func (a Account) IsDeactivated() bool {
+	return a.DeactivatedAt > 0
+}
+
+// IsPendingDeletion checks whether the deletion of the account was requested or is in progress
+func (a Account) IsPendingDeletion() bool {
+	return a.DeletionScheduledAt > 0 || a.ErasureStartedAt > 0
+}
+
+// IsAnonymized checks whether the personal data of the account was removed
+func (a Account) IsAnonymized() bool {
+	return a.AnonymizedAt > 0
+}
+
+// AnonymizedAccountID returns a random account ID replacing the one of an anonymized account. Its domain can't
+// receive emails.
+func AnonymizedAccountID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return "anonymized-" + hex.EncodeToString(b) + "@anonymized.invalid", nil
+}
+
 func AccountFromAPI(a *api.User_Account) Account {
 	if a == nil {
 		return Account{}
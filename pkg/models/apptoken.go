@@ -11,3 +11,21 @@ type AppToken struct {
 	Tokens    []string           `bson:"tokens"`
 	Instances []string           `bson:"instances"`
 }
+
+// ScimToken is a database entry for a bearer token accepted by the SCIM provisioning endpoint
+type ScimToken struct {
+	ID         primitive.ObjectID `bson:"_id,omitempty" json:"id,omitempty"`
+	ClientName string             `bson:"clientName"`
+	Tokens     []string           `bson:"tokens"`
+	Instances  []string           `bson:"instances"`
+}
+
+// HasInstance checks whether the token may be used for the given instance
+func (t ScimToken) HasInstance(instanceID string) bool {
+	for _, i := range t.Instances {
+		if i == instanceID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,25 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// AuditOutboxEntry is a log event waiting to be delivered to the logging service
+type AuditOutboxEntry struct {
+	ID         primitive.ObjectID `bson:"_id,omitempty"`
+	Origin     string             `bson:"origin"`
+	InstanceID string             `bson:"instanceID"`
+	UserID     string             `bson:"userID"`
+	EventType  int32              `bson:"eventType"`
+	EventName  string             `bson:"eventName"`
+	Msg        string             `bson:"msg"`
+	CreatedAt  int64              `bson:"createdAt"`
+
+	Attempts      int    `bson:"attempts"`
+	NextAttemptAt int64  `bson:"nextAttemptAt"`
+	LastError     string `bson:"lastError,omitempty"`
+	// DeliveredAt is a date so that delivered entries can expire through a TTL index
+	DeliveredAt *time.Time `bson:"deliveredAt,omitempty"`
+}
@@ -0,0 +1,30 @@
+package models
+
+import "github.com/influenzanet/user-management-service/pkg/api"
+
+// ConsentRecord is a consent given for a profile in a version of the consent text. Records are kept when the consent
+// is withdrawn or accepted again in a newer version, so that the consent history can be shown.
+type ConsentRecord struct {
+	ConsentType string `bson:"consentType"`
+	Version     string `bson:"version"`
+	AcceptedAt  int64  `bson:"acceptedAt"`
+	WithdrawnAt int64  `bson:"withdrawnAt,omitempty"`
+	// Channel is where the consent was given, e.g. web or app
+	Channel string `bson:"channel,omitempty"`
+}
+
+// IsWithdrawn tells if the consent was withdrawn
+func (c ConsentRecord) IsWithdrawn() bool {
+	return c.WithdrawnAt > 0
+}
+
+// ToAPI converts the object from DB to API format
+func (c ConsentRecord) ToAPI() *api.ConsentRecord {
+	return &api.ConsentRecord{
+		ConsentType: c.ConsentType,
+		Version:     c.Version,
+		AcceptedAt:  c.AcceptedAt,
+		WithdrawnAt: c.WithdrawnAt,
+		Channel:     c.Channel,
+	}
+}
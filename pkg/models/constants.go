@@ -3,4 +3,5 @@ package models
 const (
 	ACCOUNT_TYPE_EMAIL    = "email"
 	ACCOUNT_TYPE_EXTERNAL = "external"
+	ACCOUNT_TYPE_LDAP     = "ldap"
 )
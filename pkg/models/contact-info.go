@@ -13,7 +13,9 @@ type ContactInfo struct {
 	ConfirmedAt            int64              `bson:"confirmedAt"`
 	ConfirmationLinkSentAt int64              `bson:"confirmationLinkSentAt"`
 	Email                  string             `bson:"email,omitempty"`
-	Phone                  string             `bson:"phone,omitempty"`
+	// EmailIndex is the blind index of the email, if the user DB encrypts it
+	EmailIndex string `bson:"emailIndex,omitempty"`
+	Phone      string `bson:"phone,omitempty"`
 }
 
 func ContactInfoFromAPI(obj *api.ContactInfo) ContactInfo {
@@ -0,0 +1,59 @@
+package models
+
+import "github.com/influenzanet/user-management-service/pkg/api"
+
+// DryRunReport lists the accounts of an instance a job would delete or notify, computed without acting on them
+type DryRunReport struct {
+	Job        string
+	InstanceID string
+	CreatedAt  int64
+	// Settings are the thresholds the report was computed with
+	Settings *api.InstanceSettings
+	Accounts []DryRunAccount
+	// Note tells why the job would skip the instance, empty if it would run
+	Note string
+}
+
+// DryRunAccount is an account a job would act on
+type DryRunAccount struct {
+	UserID            string
+	AccountID         string
+	CreatedAt         int64
+	LastLogin         int64
+	LastTokenRefresh  int64
+	MarkedForDeletion int64
+}
+
+// NewDryRunAccount keeps the fields of the user the jobs decide on
+func NewDryRunAccount(u User) DryRunAccount {
+	return DryRunAccount{
+		UserID:            u.ID.Hex(),
+		AccountID:         u.Account.AccountID,
+		CreatedAt:         u.Timestamps.CreatedAt,
+		LastLogin:         u.Timestamps.LastLogin,
+		LastTokenRefresh:  u.Timestamps.LastTokenRefresh,
+		MarkedForDeletion: u.Timestamps.MarkedForDeletion,
+	}
+}
+
+func (r DryRunReport) ToAPI() *api.DryRunReport {
+	report := &api.DryRunReport{
+		Job:        r.Job,
+		InstanceId: r.InstanceID,
+		CreatedAt:  r.CreatedAt,
+		Settings:   r.Settings,
+		Accounts:   make([]*api.DryRunAccount, len(r.Accounts)),
+		Note:       r.Note,
+	}
+	for i, a := range r.Accounts {
+		report.Accounts[i] = &api.DryRunAccount{
+			UserId:            a.UserID,
+			AccountId:         a.AccountID,
+			CreatedAt:         a.CreatedAt,
+			LastLogin:         a.LastLogin,
+			LastTokenRefresh:  a.LastTokenRefresh,
+			MarkedForDeletion: a.MarkedForDeletion,
+		}
+	}
+	return report
+}
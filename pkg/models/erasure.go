@@ -0,0 +1,109 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	ERASURE_STATUS_PENDING = "pending"
+	ERASURE_STATUS_DONE    = "done"
+	// ERASURE_STATUS_FAILED is set after the last retry, the remaining steps are not retried anymore
+	ERASURE_STATUS_FAILED = "failed"
+)
+
+// Steps of an erasure, in the order they are run
+const (
+	ERASURE_STEP_STUDY_SERVICE = "study-service"
+	ERASURE_STEP_TEMP_TOKENS   = "temp-tokens"
+	ERASURE_STEP_RENEW_TOKENS  = "renew-tokens"
+	ERASURE_STEP_USER          = "user"
+	ERASURE_STEP_LOG_EVENT     = "log-event"
+)
+
+var ErasureSteps = []string{
+	ERASURE_STEP_STUDY_SERVICE,
+	ERASURE_STEP_TEMP_TOKENS,
+	ERASURE_STEP_RENEW_TOKENS,
+	ERASURE_STEP_USER,
+	ERASURE_STEP_LOG_EVENT,
+}
+
+// Erasure is the progress of the removal of an account and of its data in the other services, or of the
+// anonymization of the account. It holds what the remaining steps need from the user, so that it can be resumed after
+// the user document is deleted.
+type Erasure struct {
+	ID                primitive.ObjectID `bson:"_id,omitempty"`
+	UserID            string             `bson:"userID"`
+	AccountID         string             `bson:"accountID,omitempty"`
+	PreferredLanguage string             `bson:"preferredLanguage"`
+	// ProfileIDs starts with the main profile
+	ProfileIDs  []string `bson:"profileIDs"`
+	RequestedBy string   `bson:"requestedBy"`
+	// EmailType is sent to the account when it is deleted, no email if empty
+	EmailType string `bson:"emailType,omitempty"`
+	// LogEvent is saved once the account is deleted, no event if empty
+	LogEvent string `bson:"logEvent,omitempty"`
+	// Anonymize keeps the account and its profiles, only the personal data is removed
+	Anonymize bool  `bson:"anonymize,omitempty"`
+	CreatedAt int64 `bson:"createdAt"`
+
+	DoneSteps []string `bson:"doneSteps"`
+	// NotifiedProfiles are the profiles the study service deleted already
+	NotifiedProfiles []string `bson:"notifiedProfiles"`
+
+	Status        string `bson:"status"`
+	Attempts      int    `bson:"attempts"`
+	NextAttemptAt int64  `bson:"nextAttemptAt"`
+	LastError     string `bson:"lastError,omitempty"`
+	// ClaimID identifies the run currently owning the erasure
+	ClaimID string `bson:"claimID,omitempty"`
+	// FinishedAt is a date so that finished erasures can expire through a TTL index
+	FinishedAt *time.Time `bson:"finishedAt,omitempty"`
+}
+
+// NewErasure creates a pending erasure of the user
+func NewErasure(u User, requestedBy string, emailType string, logEvent string) Erasure {
+	profileIDs := []string{}
+	for _, p := range u.Profiles {
+		if p.MainProfile {
+			profileIDs = append([]string{p.ID.Hex()}, profileIDs...)
+		} else {
+			profileIDs = append(profileIDs, p.ID.Hex())
+		}
+	}
+	now := time.Now().Unix()
+	return Erasure{
+		UserID:            u.ID.Hex(),
+		AccountID:         u.Account.AccountID,
+		PreferredLanguage: u.Account.PreferredLanguage,
+		ProfileIDs:        profileIDs,
+		RequestedBy:       requestedBy,
+		EmailType:         emailType,
+		LogEvent:          logEvent,
+		CreatedAt:         now,
+		DoneSteps:         []string{},
+		NotifiedProfiles:  []string{},
+		Status:            ERASURE_STATUS_PENDING,
+		NextAttemptAt:     now,
+	}
+}
+
+func (e Erasure) IsDone(step string) bool {
+	for _, s := range e.DoneSteps {
+		if s == step {
+			return true
+		}
+	}
+	return false
+}
+
+func (e Erasure) IsNotified(profileID string) bool {
+	for _, p := range e.NotifiedProfiles {
+		if p == profileID {
+			return true
+		}
+	}
+	return false
+}
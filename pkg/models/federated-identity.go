@@ -0,0 +1,19 @@
+package models
+
+import "github.com/influenzanet/user-management-service/pkg/api"
+
+// FederatedIdentity links an identity of an external identity provider to the user account
+type FederatedIdentity struct {
+	Issuer   string `bson:"issuer"`
+	Subject  string `bson:"subject"`
+	LinkedAt int64  `bson:"linkedAt"`
+}
+
+// ToAPI converts the object from DB to API format
+func (fi FederatedIdentity) ToAPI() *api.FederatedIdentity {
+	return &api.FederatedIdentity{
+		Issuer:   fi.Issuer,
+		Subject:  fi.Subject,
+		LinkedAt: fi.LinkedAt,
+	}
+}
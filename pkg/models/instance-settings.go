@@ -0,0 +1,77 @@
+package models
+
+import "github.com/influenzanet/user-management-service/pkg/api"
+
+// InstanceSettings override the service configuration for an instance, unset fields use the service configuration.
+// Durations are in seconds.
+type InstanceSettings struct {
+	InstanceID string `bson:"instanceID"`
+
+	AccessTokenLifetime              *int64 `bson:"accessTokenLifetime,omitempty"`
+	VerificationCodeLifetime         *int64 `bson:"verificationCodeLifetime,omitempty"`
+	InvitationTokenLifetime          *int64 `bson:"invitationTokenLifetime,omitempty"`
+	ContactVerificationTokenLifetime *int64 `bson:"contactVerificationTokenLifetime,omitempty"`
+
+	NewUserRateLimit          *int64  `bson:"newUserRateLimit,omitempty"`
+	MaxProfiles               *int32  `bson:"maxProfiles,omitempty"`
+	WeekdayAssignationWeights *string `bson:"weekdayAssignationWeights,omitempty"`
+	SecondFactorPolicy        *string `bson:"secondFactorPolicy,omitempty"`
+
+	CleanUpUnverifiedUsersAfter       *int64 `bson:"cleanUpUnverifiedUsersAfter,omitempty"`
+	ReminderToUnverifiedAccountsAfter *int64 `bson:"reminderToUnverifiedAccountsAfter,omitempty"`
+	NotifyInactiveUsersAfter          *int64 `bson:"notifyInactiveUsersAfter,omitempty"`
+	DeleteAccountAfterNotifyingUser   *int64 `bson:"deleteAccountAfterNotifyingUser,omitempty"`
+
+	AccountDeletionGracePeriod *int64  `bson:"accountDeletionGracePeriod,omitempty"`
+	RetentionAction            *string `bson:"retentionAction,omitempty"`
+	// RequiredConsentVersions is the minimum version of each consent type profiles must have accepted, by consent type
+	RequiredConsentVersions map[string]string `bson:"requiredConsentVersions,omitempty"`
+
+	UpdatedAt int64  `bson:"updatedAt"`
+	UpdatedBy string `bson:"updatedBy"`
+}
+
+func InstanceSettingsFromAPI(s *api.InstanceSettings) InstanceSettings {
+	if s == nil {
+		return InstanceSettings{}
+	}
+	return InstanceSettings{
+		AccessTokenLifetime:               s.AccessTokenLifetime,
+		VerificationCodeLifetime:          s.VerificationCodeLifetime,
+		InvitationTokenLifetime:           s.InvitationTokenLifetime,
+		ContactVerificationTokenLifetime:  s.ContactVerificationTokenLifetime,
+		NewUserRateLimit:                  s.NewUserRateLimit,
+		MaxProfiles:                       s.MaxProfiles,
+		WeekdayAssignationWeights:         s.WeekdayAssignationWeights,
+		SecondFactorPolicy:                s.SecondFactorPolicy,
+		CleanUpUnverifiedUsersAfter:       s.CleanUpUnverifiedUsersAfter,
+		ReminderToUnverifiedAccountsAfter: s.ReminderToUnverifiedAccountsAfter,
+		NotifyInactiveUsersAfter:          s.NotifyInactiveUsersAfter,
+		DeleteAccountAfterNotifyingUser:   s.DeleteAccountAfterNotifyingUser,
+		AccountDeletionGracePeriod:        s.AccountDeletionGracePeriod,
+		RetentionAction:                   s.RetentionAction,
+		RequiredConsentVersions:           s.RequiredConsentVersions,
+	}
+}
+
+func (s InstanceSettings) ToAPI() *api.InstanceSettings {
+	return &api.InstanceSettings{
+		AccessTokenLifetime:               s.AccessTokenLifetime,
+		VerificationCodeLifetime:          s.VerificationCodeLifetime,
+		InvitationTokenLifetime:           s.InvitationTokenLifetime,
+		ContactVerificationTokenLifetime:  s.ContactVerificationTokenLifetime,
+		NewUserRateLimit:                  s.NewUserRateLimit,
+		MaxProfiles:                       s.MaxProfiles,
+		WeekdayAssignationWeights:         s.WeekdayAssignationWeights,
+		SecondFactorPolicy:                s.SecondFactorPolicy,
+		CleanUpUnverifiedUsersAfter:       s.CleanUpUnverifiedUsersAfter,
+		ReminderToUnverifiedAccountsAfter: s.ReminderToUnverifiedAccountsAfter,
+		NotifyInactiveUsersAfter:          s.NotifyInactiveUsersAfter,
+		DeleteAccountAfterNotifyingUser:   s.DeleteAccountAfterNotifyingUser,
+		AccountDeletionGracePeriod:        s.AccountDeletionGracePeriod,
+		RetentionAction:                   s.RetentionAction,
+		RequiredConsentVersions:           s.RequiredConsentVersions,
+		UpdatedAt:                         s.UpdatedAt,
+		UpdatedBy:                         s.UpdatedBy,
+	}
+}
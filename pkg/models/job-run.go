@@ -0,0 +1,89 @@
+package models
+
+import (
+	"github.com/influenzanet/user-management-service/pkg/api"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	JOB_TRIGGER_SCHEDULE = "schedule"
+	JOB_TRIGGER_MANUAL   = "manual"
+
+	// JOB_RUN_STATUS_PENDING runs were triggered by an admin and wait for the service instance running the jobs
+	JOB_RUN_STATUS_PENDING  = "pending"
+	JOB_RUN_STATUS_RUNNING  = "running"
+	JOB_RUN_STATUS_FINISHED = "finished"
+	// JOB_RUN_STATUS_INTERRUPTED runs were stopped by a shutdown or the loss of the leadership
+	JOB_RUN_STATUS_INTERRUPTED = "interrupted"
+)
+
+// maxJobRunErrors per instance are kept in the history
+const maxJobRunErrors = 20
+
+// JobRun is a run of a background job in the history of the global DB
+type JobRun struct {
+	ID      primitive.ObjectID `bson:"_id,omitempty"`
+	Job     string             `bson:"job"`
+	Trigger string             `bson:"trigger"`
+	// InstanceID limits a manual run to the instance of the admin, empty for runs over all instances
+	InstanceID  string `bson:"instanceID,omitempty"`
+	RequestedBy string `bson:"requestedBy,omitempty"`
+
+	Status      string              `bson:"status"`
+	RequestedAt int64               `bson:"requestedAt"`
+	StartedAt   int64               `bson:"startedAt,omitempty"`
+	FinishedAt  int64               `bson:"finishedAt,omitempty"`
+	Results     []JobInstanceResult `bson:"results"`
+}
+
+// JobInstanceResult is the outcome of a job run for an instance
+type JobInstanceResult struct {
+	InstanceID string   `bson:"instanceID"`
+	Affected   int64    `bson:"affected"`
+	Errors     []string `bson:"errors,omitempty"`
+}
+
+// AddAffected counts the users or tokens the job acted on in the instance
+func (r *JobRun) AddAffected(instanceID string, count int64) {
+	res := r.result(instanceID)
+	res.Affected += count
+}
+
+// AddError records a problem in the instance, the first errors are kept
+func (r *JobRun) AddError(instanceID string, msg string) {
+	res := r.result(instanceID)
+	if len(res.Errors) < maxJobRunErrors {
+		res.Errors = append(res.Errors, msg)
+	}
+}
+
+func (r *JobRun) result(instanceID string) *JobInstanceResult {
+	for i := range r.Results {
+		if r.Results[i].InstanceID == instanceID {
+			return &r.Results[i]
+		}
+	}
+	r.Results = append(r.Results, JobInstanceResult{InstanceID: instanceID})
+	return &r.Results[len(r.Results)-1]
+}
+
+// ToAPI converts the run with the results of the given instance only
+func (r JobRun) ToAPI(instanceID string) *api.JobRun {
+	run := &api.JobRun{
+		Id:          r.ID.Hex(),
+		Job:         r.Job,
+		Trigger:     r.Trigger,
+		Status:      r.Status,
+		RequestedBy: r.RequestedBy,
+		RequestedAt: r.RequestedAt,
+		StartedAt:   r.StartedAt,
+		FinishedAt:  r.FinishedAt,
+	}
+	for _, res := range r.Results {
+		if res.InstanceID == instanceID {
+			run.Affected = res.Affected
+			run.Errors = res.Errors
+		}
+	}
+	return run
+}
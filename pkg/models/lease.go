@@ -0,0 +1,11 @@
+package models
+
+// Lease gives one service instance the right to run a task, as long as it renews the lease before it expires
+type Lease struct {
+	Name   string `bson:"_id"`
+	Holder string `bson:"holder"`
+	// Token is incremented each time the lease changes hands, so that a former holder can't act on it anymore
+	Token      int64 `bson:"token"`
+	AcquiredAt int64 `bson:"acquiredAt"`
+	ExpiresAt  int64 `bson:"expiresAt"`
+}
@@ -0,0 +1,89 @@
+package models
+
+import (
+	"time"
+
+	messageAPI "github.com/influenzanet/messaging-service/pkg/api/messaging_service"
+	"github.com/influenzanet/user-management-service/pkg/api"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	// OUTGOING_EMAIL_INSTANT emails are sent by the messaging service right away
+	OUTGOING_EMAIL_INSTANT = "instant"
+	// OUTGOING_EMAIL_QUEUED emails are put into the messaging service's own queue
+	OUTGOING_EMAIL_QUEUED = "queued"
+
+	OUTGOING_EMAIL_STATUS_PENDING = "pending"
+	OUTGOING_EMAIL_STATUS_SENT    = "sent"
+	// OUTGOING_EMAIL_STATUS_FAILED is set after the last retry, the email is only sent again on request of an admin
+	OUTGOING_EMAIL_STATUS_FAILED = "failed"
+)
+
+// OutgoingEmail is a request to the messaging service waiting in the outbox of an instance
+type OutgoingEmail struct {
+	ID                primitive.ObjectID `bson:"_id,omitempty"`
+	Delivery          string             `bson:"delivery"`
+	To                []string           `bson:"to"`
+	MessageType       string             `bson:"messageType"`
+	StudyKey          string             `bson:"studyKey,omitempty"`
+	PreferredLanguage string             `bson:"preferredLanguage"`
+	ContentInfos      map[string]string  `bson:"contentInfos,omitempty"`
+	UseLowPrio        bool               `bson:"useLowPrio"`
+	CreatedAt         int64              `bson:"createdAt"`
+
+	Status        string `bson:"status"`
+	Attempts      int    `bson:"attempts"`
+	NextAttemptAt int64  `bson:"nextAttemptAt"`
+	LastAttemptAt int64  `bson:"lastAttemptAt,omitempty"`
+	LastError     string `bson:"lastError,omitempty"`
+	// ClaimID identifies the delivery attempt currently owning the email, so that a worker whose lease
+	// expired can't change the outcome of a newer attempt
+	ClaimID string `bson:"claimID,omitempty"`
+	// SentAt is a date so that sent emails can expire through a TTL index
+	SentAt *time.Time `bson:"sentAt,omitempty"`
+}
+
+// NewOutgoingEmail creates a pending outbox entry for the messaging service request
+func NewOutgoingEmail(delivery string, req *messageAPI.SendEmailReq) OutgoingEmail {
+	now := time.Now().Unix()
+	return OutgoingEmail{
+		Delivery:          delivery,
+		To:                req.To,
+		MessageType:       req.MessageType,
+		StudyKey:          req.StudyKey,
+		PreferredLanguage: req.PreferredLanguage,
+		ContentInfos:      req.ContentInfos,
+		UseLowPrio:        req.UseLowPrio,
+		CreatedAt:         now,
+		Status:            OUTGOING_EMAIL_STATUS_PENDING,
+		NextAttemptAt:     now,
+	}
+}
+
+// ToSendEmailReq converts the entry back into the messaging service request
+func (e OutgoingEmail) ToSendEmailReq(instanceID string) *messageAPI.SendEmailReq {
+	return &messageAPI.SendEmailReq{
+		InstanceId:        instanceID,
+		To:                e.To,
+		MessageType:       e.MessageType,
+		StudyKey:          e.StudyKey,
+		PreferredLanguage: e.PreferredLanguage,
+		ContentInfos:      e.ContentInfos,
+		UseLowPrio:        e.UseLowPrio,
+	}
+}
+
+// ToAPI converts the entry without its content, which may contain tokens
+func (e OutgoingEmail) ToAPI() *api.OutgoingEmail {
+	return &api.OutgoingEmail{
+		Id:                e.ID.Hex(),
+		To:                e.To,
+		MessageType:       e.MessageType,
+		PreferredLanguage: e.PreferredLanguage,
+		CreatedAt:         e.CreatedAt,
+		Attempts:          int32(e.Attempts),
+		LastAttemptAt:     e.LastAttemptAt,
+		LastError:         e.LastError,
+	}
+}
@@ -1,6 +1,11 @@
 package models
 
 import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
 	"github.com/influenzanet/user-management-service/pkg/api"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
@@ -13,6 +18,7 @@ type Profile struct {
 	CreatedAt          int64              `bson:"createdAt"`
 	AvatarID           string             `bson:"avatarID,omitempty"`
 	MainProfile        bool               `bson:"mainProfile"`
+	ConsentRecords     []ConsentRecord    `bson:"consentRecords,omitempty"`
 }
 
 func ProfileFromAPI(p *api.Profile) Profile {
@@ -35,6 +41,10 @@ func ProfileFromAPI(p *api.Profile) Profile {
 
 // ToAPI converts a person from DB format into the API format
 func (p Profile) ToAPI() *api.Profile {
+	consentRecords := make([]*api.ConsentRecord, len(p.ConsentRecords))
+	for i, c := range p.ConsentRecords {
+		consentRecords[i] = c.ToAPI()
+	}
 	return &api.Profile{
 		Id:                 p.ID.Hex(),
 		Alias:              p.Alias,
@@ -42,5 +52,80 @@ func (p Profile) ToAPI() *api.Profile {
 		CreatedAt:          p.CreatedAt,
 		AvatarId:           p.AvatarID,
 		MainProfile:        p.MainProfile,
+		ConsentRecords:     consentRecords,
+	}
+}
+
+// CurrentConsent returns the latest record of the consent type, false if the consent was never given
+func (p Profile) CurrentConsent(consentType string) (ConsentRecord, bool) {
+	for i := len(p.ConsentRecords) - 1; i >= 0; i-- {
+		if p.ConsentRecords[i].ConsentType == consentType {
+			return p.ConsentRecords[i], true
+		}
+	}
+	return ConsentRecord{}, false
+}
+
+// NeedsConsent tells if the profile has to accept the version of the consent type, because its consent is missing,
+// withdrawn or of an older version
+func (p Profile) NeedsConsent(consentType string, version string) bool {
+	c, found := p.CurrentConsent(consentType)
+	return !found || c.IsWithdrawn() || CompareConsentVersions(c.Version, version) < 0
+}
+
+// CompareConsentVersions returns -1, 0 or 1 if the version a is older, the same or newer than b. Versions are
+// compared by their dot-separated parts, numerically if both parts are numbers, e.g. "1.10" is newer than "1.9"
+func CompareConsentVersions(a string, b string) int {
+	partsA := strings.Split(a, ".")
+	partsB := strings.Split(b, ".")
+	for i := 0; i < len(partsA) || i < len(partsB); i++ {
+		partA, partB := "0", "0"
+		if i < len(partsA) {
+			partA = partsA[i]
+		}
+		if i < len(partsB) {
+			partB = partsB[i]
+		}
+		numA, errA := strconv.Atoi(partA)
+		numB, errB := strconv.Atoi(partB)
+		switch {
+		case errA == nil && errB == nil && numA != numB:
+			if numA < numB {
+				return -1
+			}
+			return 1
+		case (errA != nil || errB != nil) && partA != partB:
+			return strings.Compare(partA, partB)
+		}
+	}
+	return 0
+}
+
+// RecordConsent adds the consent to the records, it fails if the version is the current consent already
+func (p *Profile) RecordConsent(consentType string, version string, channel string) error {
+	if c, found := p.CurrentConsent(consentType); found && !c.IsWithdrawn() && c.Version == version {
+		return errors.New("consent already given")
+	}
+	p.ConsentRecords = append(p.ConsentRecords, ConsentRecord{
+		ConsentType: consentType,
+		Version:     version,
+		AcceptedAt:  time.Now().Unix(),
+		Channel:     channel,
+	})
+	return nil
+}
+
+// WithdrawConsent marks the current consent of the type as withdrawn
+func (p *Profile) WithdrawConsent(consentType string) error {
+	for i := len(p.ConsentRecords) - 1; i >= 0; i-- {
+		if p.ConsentRecords[i].ConsentType != consentType {
+			continue
+		}
+		if p.ConsentRecords[i].IsWithdrawn() {
+			break
+		}
+		p.ConsentRecords[i].WithdrawnAt = time.Now().Unix()
+		return nil
 	}
+	return errors.New("consent not given")
 }
@@ -0,0 +1,49 @@
+package models
+
+import "testing"
+
+func TestCompareConsentVersions(t *testing.T) {
+	cases := []struct {
+		a        string
+		b        string
+		expected int
+	}{
+		{a: "1", b: "1", expected: 0},
+		{a: "1.10", b: "1.9", expected: 1},
+		{a: "1.9", b: "1.10", expected: -1},
+		{a: "2", b: "10", expected: -1},
+		{a: "1", b: "1.0", expected: 0},
+		{a: "1.0.1", b: "1", expected: 1},
+		{a: "1.a", b: "1.b", expected: -1},
+		{a: "v2", b: "v2", expected: 0},
+		{a: "1.rc", b: "1.1", expected: 1},
+	}
+	for _, c := range cases {
+		if r := CompareConsentVersions(c.a, c.b); r != c.expected {
+			t.Errorf("%s vs %s: expected %d, got %d", c.a, c.b, c.expected, r)
+		}
+	}
+}
+
+func TestNeedsConsent(t *testing.T) {
+	p := Profile{ConsentRecords: []ConsentRecord{
+		{ConsentType: "participation", Version: "1.10", AcceptedAt: 10},
+		{ConsentType: "data-sharing", Version: "2", AcceptedAt: 10, WithdrawnAt: 20},
+	}}
+	cases := []struct {
+		consentType string
+		version     string
+		expected    bool
+	}{
+		{consentType: "participation", version: "1.9", expected: false},
+		{consentType: "participation", version: "1.10", expected: false},
+		{consentType: "participation", version: "1.11", expected: true},
+		{consentType: "data-sharing", version: "1", expected: true},
+		{consentType: "newsletter", version: "1", expected: true},
+	}
+	for _, c := range cases {
+		if r := p.NeedsConsent(c.consentType, c.version); r != c.expected {
+			t.Errorf("%s %s: expected %v, got %v", c.consentType, c.version, c.expected, r)
+		}
+	}
+}
@@ -5,6 +5,9 @@ import (
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
+// TEMP_TOKEN_INFO_CONTACT_ID names the contact info a contact verification or invitation token confirms
+const TEMP_TOKEN_INFO_CONTACT_ID = "contactID"
+
 // TempToken is a database entry for a temporary token
 type TempToken struct {
 	ID         primitive.ObjectID `bson:"_id,omitempty" json:"token_id,omitempty"`
@@ -59,3 +62,24 @@ func (items TempTokens) ToAPI() *api_types.TempTokenInfos {
 		TempTokens: res,
 	}
 }
+
+// ContactVerificationInfos are the infos of a token confirming the email address of the user. The address is
+// identified by its contact info, so that the token holds no copy of it.
+func (u User) ContactVerificationInfos(email string) map[string]string {
+	ci, _ := u.FindContactInfoByTypeAndAddr(ACCOUNT_TYPE_EMAIL, email)
+	return map[string]string{
+		"type":                     ACCOUNT_TYPE_EMAIL,
+		TEMP_TOKEN_INFO_CONTACT_ID: ci.ID.Hex(),
+	}
+}
+
+// ContactVerificationAddress returns the email address the token infos confirm, false if the user doesn't have it.
+// Tokens created before hold the address itself.
+func (u User) ContactVerificationAddress(info map[string]string) (string, bool) {
+	if id, ok := info[TEMP_TOKEN_INFO_CONTACT_ID]; ok {
+		ci, found := u.FindContactInfoById(id)
+		return ci.Email, found && ci.Email != ""
+	}
+	email, ok := info["email"]
+	return email, ok
+}
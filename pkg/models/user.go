@@ -10,13 +10,14 @@ import (
 
 // User describes the user as saved in the DB
 type User struct {
-	ID                 primitive.ObjectID `bson:"_id,omitempty" json:"user_id,omitempty"`
-	Account            Account            `bson:"account"`
-	Roles              []string           `bson:"roles" json:"roles"`
-	Timestamps         Timestamps         `bson:"timestamps"`
-	Profiles           []Profile          `bson:"profiles"`
-	ContactPreferences ContactPreferences `bson:"contactPreferences"`
-	ContactInfos       []ContactInfo      `bson:"contactInfos"`
+	ID                  primitive.ObjectID  `bson:"_id,omitempty" json:"user_id,omitempty"`
+	Account             Account             `bson:"account"`
+	Roles               []string            `bson:"roles" json:"roles"`
+	Timestamps          Timestamps          `bson:"timestamps"`
+	Profiles            []Profile           `bson:"profiles"`
+	ContactPreferences  ContactPreferences  `bson:"contactPreferences"`
+	ContactInfos        []ContactInfo       `bson:"contactInfos"`
+	FederatedIdentities []FederatedIdentity `bson:"federatedIdentities,omitempty"`
 }
 
 // ToAPI converts the object from DB to API format
@@ -29,14 +30,19 @@ func (u User) ToAPI() *api.User {
 	for i, c := range u.ContactInfos {
 		contactInfos[i] = c.ToAPI()
 	}
+	federatedIdentities := make([]*api.FederatedIdentity, len(u.FederatedIdentities))
+	for i, fi := range u.FederatedIdentities {
+		federatedIdentities[i] = fi.ToAPI()
+	}
 	return &api.User{
-		Id:                 u.ID.Hex(),
-		Account:            u.Account.ToAPI(),
-		Roles:              u.Roles,
-		Timestamps:         u.Timestamps.ToAPI(),
-		Profiles:           profiles,
-		ContactPreferences: u.ContactPreferences.ToAPI(),
-		ContactInfos:       contactInfos,
+		Id:                  u.ID.Hex(),
+		Account:             u.Account.ToAPI(),
+		Roles:               u.Roles,
+		Timestamps:          u.Timestamps.ToAPI(),
+		Profiles:            profiles,
+		ContactPreferences:  u.ContactPreferences.ToAPI(),
+		ContactInfos:        contactInfos,
+		FederatedIdentities: federatedIdentities,
 	}
 }
 
@@ -168,6 +174,40 @@ func (u *User) ReplaceContactInfoInContactPreferences(oldId string, newId string
 	}
 }
 
+// FindFederatedIdentity finds a linked identity by issuer and subject
+func (u User) FindFederatedIdentity(issuer string, subject string) (FederatedIdentity, bool) {
+	for _, fi := range u.FederatedIdentities {
+		if fi.Issuer == issuer && fi.Subject == subject {
+			return fi, true
+		}
+	}
+	return FederatedIdentity{}, false
+}
+
+// AddFederatedIdentity links a new external identity to the user
+func (u *User) AddFederatedIdentity(issuer string, subject string) error {
+	if _, found := u.FindFederatedIdentity(issuer, subject); found {
+		return errors.New("identity already linked")
+	}
+	u.FederatedIdentities = append(u.FederatedIdentities, FederatedIdentity{
+		Issuer:   issuer,
+		Subject:  subject,
+		LinkedAt: time.Now().Unix(),
+	})
+	return nil
+}
+
+// RemoveFederatedIdentity removes the link to an external identity
+func (u *User) RemoveFederatedIdentity(issuer string, subject string) error {
+	for i, fi := range u.FederatedIdentities {
+		if fi.Issuer == issuer && fi.Subject == subject {
+			u.FederatedIdentities = append(u.FederatedIdentities[:i], u.FederatedIdentities[i+1:]...)
+			return nil
+		}
+	}
+	return errors.New("identity not found")
+}
+
 // AddProfile generates unique ID and adds profile to the user's array
 func (u *User) AddProfile(p Profile) {
 	p.ID = primitive.NewObjectID()
@@ -180,6 +220,8 @@ func (u *User) UpdateProfile(p Profile) error {
 	for i, cP := range u.Profiles {
 		if cP.ID == p.ID {
 			p.MainProfile = cP.MainProfile
+			// consents are only changed through RecordConsent and WithdrawConsent
+			p.ConsentRecords = cP.ConsentRecords
 			u.Profiles[i] = p
 			return nil
 		}
@@ -197,6 +239,26 @@ func (u User) FindProfile(id string) (Profile, error) {
 	return Profile{}, errors.New("profile with given ID not found")
 }
 
+// RecordConsent records the consent for the profile with the given ID
+func (u *User) RecordConsent(profileID string, consentType string, version string, channel string) error {
+	for i := range u.Profiles {
+		if u.Profiles[i].ID.Hex() == profileID {
+			return u.Profiles[i].RecordConsent(consentType, version, channel)
+		}
+	}
+	return errors.New("profile with given ID not found")
+}
+
+// WithdrawConsent withdraws the consent of the profile with the given ID
+func (u *User) WithdrawConsent(profileID string, consentType string) error {
+	for i := range u.Profiles {
+		if u.Profiles[i].ID.Hex() == profileID {
+			return u.Profiles[i].WithdrawConsent(consentType)
+		}
+	}
+	return errors.New("profile with given ID not found")
+}
+
 // RemoveProfile finds and removes profile from the user's array
 func (u *User) RemoveProfile(id string) error {
 	for i, cP := range u.Profiles {
@@ -0,0 +1,106 @@
+// Package outbox runs the delivery of the outbox collections, from which entries are handed over to another service.
+// Due entries are claimed one by one, so that several service instances can share an outbox. A failed entry is
+// retried after a delay doubling with each attempt, and is dead-lettered after too many attempts.
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/coneno/logger"
+	"github.com/influenzanet/user-management-service/pkg/utils"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Queue is an outbox collection holding entries of type E
+type Queue[E any] interface {
+	// Claim returns the oldest entry due at now and postpones it by lease, mongo.ErrNoDocuments if none is due
+	Claim(now int64, lease time.Duration) (E, error)
+	// Deliver hands the entry over to the receiving service
+	Deliver(ctx context.Context, entry E) error
+	// MarkDelivered records the delivery of the entry
+	MarkDelivered(entry E) error
+	// MarkFailed records the failed attempt, with deadLetter the entry is not retried anymore
+	MarkFailed(entry E, nextAttemptAt int64, reason string, deadLetter bool) error
+	// Describe names the entry in log messages
+	Describe(entry E) string
+	// Attempts is the number of attempts the entry already failed
+	Attempts(entry E) int
+	// DeliveryFailed is called after each failed attempt, e.g. to count the failures
+	DeliveryFailed()
+	// UpdateBacklog is called once the due entries were dispatched, e.g. to update the gauges
+	UpdateBacklog()
+}
+
+// Config of the delivery
+type Config struct {
+	// Name of the outbox in log messages
+	Name             string
+	DispatchInterval time.Duration
+	DeliveryTimeout  time.Duration
+	// ClaimLease must be longer than a delivery, so an entry is not delivered twice by different service instances
+	ClaimLease    time.Duration
+	MinRetryDelay time.Duration
+	MaxRetryDelay time.Duration
+	// MaxAttempts before an entry is dead-lettered, entries are retried forever if 0
+	MaxAttempts int
+}
+
+// Run dispatches the due entries every DispatchInterval until ctx is done. queues is called before each run, e.g.
+// to return the outbox of each instance.
+func Run[E any](ctx context.Context, conf Config, queues func() []Queue[E]) {
+	ticker := time.NewTicker(conf.DispatchInterval)
+	defer ticker.Stop()
+	for {
+		for _, q := range queues() {
+			DispatchDue(ctx, conf, q)
+			q.UpdateBacklog()
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// DispatchDue delivers all due entries of the queue. It stops at the first failure, as the receiving service is
+// likely down.
+func DispatchDue[E any](ctx context.Context, conf Config, q Queue[E]) (delivered int) {
+	for ctx.Err() == nil {
+		entry, err := q.Claim(time.Now().Unix(), conf.ClaimLease)
+		if err != nil {
+			if err != mongo.ErrNoDocuments {
+				logger.Error.Printf("%s: %v", conf.Name, err)
+			}
+			return
+		}
+
+		if err := deliver(ctx, conf, q, entry); err != nil {
+			attempts := q.Attempts(entry) + 1
+			deadLetter := conf.MaxAttempts > 0 && attempts >= conf.MaxAttempts
+			delay := utils.RetryDelay(attempts, conf.MinRetryDelay, conf.MaxRetryDelay)
+			if deadLetter {
+				logger.Error.Printf("%s: %s failed %d times, giving up: %v", conf.Name, q.Describe(entry), attempts, err)
+			} else {
+				logger.Warning.Printf("%s: %s failed (attempt %d), retry in %s: %v", conf.Name, q.Describe(entry), attempts, delay, err)
+			}
+			q.DeliveryFailed()
+			if err := q.MarkFailed(entry, time.Now().Add(delay).Unix(), err.Error(), deadLetter); err != nil {
+				logger.Error.Printf("%s: %v", conf.Name, err)
+			}
+			return
+		}
+		if err := q.MarkDelivered(entry); err != nil {
+			logger.Error.Printf("%s: %s delivered, but not marked: %v", conf.Name, q.Describe(entry), err)
+		}
+		delivered++
+	}
+	return
+}
+
+func deliver[E any](ctx context.Context, conf Config, q Queue[E], entry E) error {
+	ctx, cancel := context.WithTimeout(ctx, conf.DeliveryTimeout)
+	defer cancel()
+	return q.Deliver(ctx, entry)
+}
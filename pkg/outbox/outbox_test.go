@@ -0,0 +1,141 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type testEntry struct {
+	id       int
+	attempts int
+}
+
+type failedEntry struct {
+	entry         testEntry
+	nextAttemptAt int64
+	deadLetter    bool
+}
+
+// testQueue delivers its entries in order, failing for the IDs in failing
+type testQueue struct {
+	due       []testEntry
+	failing   map[int]bool
+	delivered []int
+	failed    []failedEntry
+	failures  int
+}
+
+func (q *testQueue) Claim(now int64, lease time.Duration) (testEntry, error) {
+	if len(q.due) == 0 {
+		return testEntry{}, mongo.ErrNoDocuments
+	}
+	entry := q.due[0]
+	q.due = q.due[1:]
+	return entry, nil
+}
+
+func (q *testQueue) Deliver(ctx context.Context, entry testEntry) error {
+	if _, ok := ctx.Deadline(); !ok {
+		return errors.New("delivery without timeout")
+	}
+	if q.failing[entry.id] {
+		return errors.New("unavailable")
+	}
+	return nil
+}
+
+func (q *testQueue) MarkDelivered(entry testEntry) error {
+	q.delivered = append(q.delivered, entry.id)
+	return nil
+}
+
+func (q *testQueue) MarkFailed(entry testEntry, nextAttemptAt int64, reason string, deadLetter bool) error {
+	q.failed = append(q.failed, failedEntry{entry: entry, nextAttemptAt: nextAttemptAt, deadLetter: deadLetter})
+	return nil
+}
+
+func (q *testQueue) Describe(entry testEntry) string {
+	return "entry " + strconv.Itoa(entry.id)
+}
+
+func (q *testQueue) Attempts(entry testEntry) int {
+	return entry.attempts
+}
+
+func (q *testQueue) DeliveryFailed() {
+	q.failures++
+}
+
+func (q *testQueue) UpdateBacklog() {}
+
+var testConfig = Config{
+	Name:            "test outbox",
+	DeliveryTimeout: time.Second,
+	ClaimLease:      time.Minute,
+	MinRetryDelay:   time.Minute,
+	MaxRetryDelay:   time.Hour,
+	MaxAttempts:     3,
+}
+
+func TestDispatchDue(t *testing.T) {
+	t.Run("deliver all due entries", func(t *testing.T) {
+		q := &testQueue{due: []testEntry{{id: 1}, {id: 2}}}
+		if delivered := DispatchDue[testEntry](context.Background(), testConfig, q); delivered != 2 {
+			t.Errorf("unexpected number of delivered entries: %d", delivered)
+		}
+		if len(q.delivered) != 2 || len(q.failed) != 0 {
+			t.Errorf("unexpected result: %v %v", q.delivered, q.failed)
+		}
+	})
+
+	t.Run("stop at the first failure", func(t *testing.T) {
+		q := &testQueue{due: []testEntry{{id: 1}, {id: 2, attempts: 1}, {id: 3}}, failing: map[int]bool{2: true}}
+		before := time.Now().Unix()
+		if delivered := DispatchDue[testEntry](context.Background(), testConfig, q); delivered != 1 {
+			t.Errorf("unexpected number of delivered entries: %d", delivered)
+		}
+		if len(q.failed) != 1 || q.failed[0].entry.id != 2 || q.failed[0].deadLetter || q.failures != 1 {
+			t.Errorf("unexpected failed entries: %v", q.failed)
+			return
+		}
+		// second attempt
+		if delay := q.failed[0].nextAttemptAt - before; delay < 120 || delay > 121 {
+			t.Errorf("unexpected retry delay: %d", delay)
+		}
+		if len(q.due) != 1 {
+			t.Errorf("remaining entries should not be claimed: %v", q.due)
+		}
+	})
+
+	t.Run("dead-letter after max attempts", func(t *testing.T) {
+		q := &testQueue{due: []testEntry{{id: 1, attempts: 2}}, failing: map[int]bool{1: true}}
+		DispatchDue[testEntry](context.Background(), testConfig, q)
+		if len(q.failed) != 1 || !q.failed[0].deadLetter {
+			t.Errorf("entry should be dead-lettered: %v", q.failed)
+		}
+	})
+
+	t.Run("retry forever without max attempts", func(t *testing.T) {
+		conf := testConfig
+		conf.MaxAttempts = 0
+		q := &testQueue{due: []testEntry{{id: 1, attempts: 100}}, failing: map[int]bool{1: true}}
+		DispatchDue[testEntry](context.Background(), conf, q)
+		if len(q.failed) != 1 || q.failed[0].deadLetter {
+			t.Errorf("entry should not be dead-lettered: %v", q.failed)
+		}
+	})
+
+	t.Run("stop when ctx is done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		q := &testQueue{due: []testEntry{{id: 1}}}
+		if delivered := DispatchDue[testEntry](ctx, testConfig, q); delivered != 0 || len(q.due) != 1 {
+			t.Errorf("no entry should be claimed: %d", delivered)
+		}
+	})
+}
@@ -0,0 +1,284 @@
+package scim
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// filterTarget is implemented by resources that can be matched against a filter.
+// attr is passed in lower case, the returned values are compared case-insensitively.
+type filterTarget interface {
+	attributeValues(attr string) []string
+}
+
+// filterExpr is a parsed SCIM filter (RFC 7644, section 3.4.2.2)
+type filterExpr interface {
+	matches(t filterTarget) bool
+}
+
+type logicalExpr struct {
+	op    string // "and" or "or"
+	left  filterExpr
+	right filterExpr
+}
+
+func (e logicalExpr) matches(t filterTarget) bool {
+	if e.op == "and" {
+		return e.left.matches(t) && e.right.matches(t)
+	}
+	return e.left.matches(t) || e.right.matches(t)
+}
+
+type notExpr struct {
+	expr filterExpr
+}
+
+func (e notExpr) matches(t filterTarget) bool {
+	return !e.expr.matches(t)
+}
+
+type compareExpr struct {
+	attr  string
+	op    string
+	value string
+}
+
+func (e compareExpr) matches(t filterTarget) bool {
+	values := t.attributeValues(e.attr)
+	if e.op == "pr" {
+		for _, v := range values {
+			if v != "" {
+				return true
+			}
+		}
+		return false
+	}
+	if e.op == "ne" {
+		for _, v := range values {
+			if strings.EqualFold(v, e.value) {
+				return false
+			}
+		}
+		return true
+	}
+	for _, v := range values {
+		v = strings.ToLower(v)
+		switch e.op {
+		case "eq":
+			if v == e.value {
+				return true
+			}
+		case "co":
+			if strings.Contains(v, e.value) {
+				return true
+			}
+		case "sw":
+			if strings.HasPrefix(v, e.value) {
+				return true
+			}
+		case "ew":
+			if strings.HasSuffix(v, e.value) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// parseFilter parses a filter expression, only attributes from supportedAttributes are accepted
+func parseFilter(filter string, supportedAttributes []string) (filterExpr, error) {
+	tokens, err := tokenizeFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return nil, errors.New("empty filter")
+	}
+	p := filterParser{
+		tokens:     tokens,
+		attributes: map[string]bool{},
+	}
+	for _, a := range supportedAttributes {
+		p.attributes[strings.ToLower(a)] = true
+	}
+	expr, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.tokens) {
+		return nil, fmt.Errorf("unexpected token: %s", p.tokens[p.pos].text)
+	}
+	return expr, nil
+}
+
+type filterToken struct {
+	text   string
+	quoted bool
+}
+
+func tokenizeFilter(filter string) ([]filterToken, error) {
+	tokens := []filterToken{}
+	runes := []rune(filter)
+	for i := 0; i < len(runes); {
+		c := runes[i]
+		switch {
+		case c == ' ' || c == '\t':
+			i++
+		case c == '(' || c == ')':
+			tokens = append(tokens, filterToken{text: string(c)})
+			i++
+		case c == '"':
+			value := strings.Builder{}
+			i++
+			closed := false
+			for i < len(runes) {
+				if runes[i] == '\\' && i+1 < len(runes) {
+					value.WriteRune(runes[i+1])
+					i += 2
+					continue
+				}
+				if runes[i] == '"' {
+					closed = true
+					i++
+					break
+				}
+				value.WriteRune(runes[i])
+				i++
+			}
+			if !closed {
+				return nil, errors.New("unterminated string in filter")
+			}
+			tokens = append(tokens, filterToken{text: value.String(), quoted: true})
+		default:
+			start := i
+			for i < len(runes) && runes[i] != ' ' && runes[i] != '\t' && runes[i] != '(' && runes[i] != ')' {
+				i++
+			}
+			tokens = append(tokens, filterToken{text: string(runes[start:i])})
+		}
+	}
+	return tokens, nil
+}
+
+type filterParser struct {
+	tokens     []filterToken
+	pos        int
+	attributes map[string]bool
+}
+
+func (p *filterParser) peekKeyword(keyword string) bool {
+	if p.pos >= len(p.tokens) {
+		return false
+	}
+	t := p.tokens[p.pos]
+	return !t.quoted && strings.EqualFold(t.text, keyword)
+}
+
+func (p *filterParser) parseOr() (filterExpr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peekKeyword("or") {
+		p.pos++
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = logicalExpr{op: "or", left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseAnd() (filterExpr, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.peekKeyword("and") {
+		p.pos++
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = logicalExpr{op: "and", left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseUnary() (filterExpr, error) {
+	if p.peekKeyword("not") {
+		p.pos++
+		if !p.peekKeyword("(") {
+			return nil, errors.New("not must be followed by a parenthesized expression")
+		}
+		expr, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return notExpr{expr: expr}, nil
+	}
+	if p.peekKeyword("(") {
+		p.pos++
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if !p.peekKeyword(")") {
+			return nil, errors.New("missing closing parenthesis")
+		}
+		p.pos++
+		return expr, nil
+	}
+	return p.parseComparison()
+}
+
+func (p *filterParser) parseComparison() (filterExpr, error) {
+	if p.pos+1 >= len(p.tokens) {
+		return nil, errors.New("incomplete filter expression")
+	}
+	attrToken := p.tokens[p.pos]
+	if attrToken.quoted {
+		return nil, errors.New("attribute name expected")
+	}
+	attr := strings.ToLower(stripSchemaPrefix(attrToken.text))
+	if !p.attributes[attr] {
+		return nil, fmt.Errorf("unsupported filter attribute: %s", attrToken.text)
+	}
+	op := strings.ToLower(p.tokens[p.pos+1].text)
+	p.pos += 2
+
+	switch op {
+	case "pr":
+		return compareExpr{attr: attr, op: op}, nil
+	case "eq", "ne", "co", "sw", "ew":
+		if p.pos >= len(p.tokens) {
+			return nil, errors.New("comparison value missing")
+		}
+		value := p.tokens[p.pos]
+		p.pos++
+		if !value.quoted && strings.EqualFold(value.text, "null") {
+			// null equals "not present"
+			if op == "eq" {
+				return notExpr{expr: compareExpr{attr: attr, op: "pr"}}, nil
+			}
+			if op == "ne" {
+				return compareExpr{attr: attr, op: "pr"}, nil
+			}
+			return nil, errors.New("null can only be compared with eq or ne")
+		}
+		return compareExpr{attr: attr, op: op, value: strings.ToLower(value.text)}, nil
+	}
+	return nil, fmt.Errorf("unsupported filter operator: %s", op)
+}
+
+// stripSchemaPrefix removes a fully qualified schema URN from an attribute path
+func stripSchemaPrefix(attr string) string {
+	for _, schema := range []string{schemaUser, schemaGroup} {
+		if len(attr) > len(schema) && strings.EqualFold(attr[:len(schema)], schema) {
+			return strings.TrimPrefix(attr[len(schema):], ":")
+		}
+	}
+	return attr
+}
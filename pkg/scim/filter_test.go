@@ -0,0 +1,74 @@
+package scim
+
+import (
+	"testing"
+)
+
+func TestParseFilter(t *testing.T) {
+	active := true
+	inactive := false
+	users := []User{
+		{ID: "1", UserName: "admin@test.com", Active: &active, Groups: []GroupRef{{Value: "ADMIN"}}},
+		{ID: "2", UserName: "researcher@test.com", Active: &active, PreferredLanguage: "de", Groups: []GroupRef{{Value: "RESEARCHER"}}},
+		{ID: "3", UserName: "former@test.com", Active: &inactive, Emails: []Email{{Value: "former@other.com"}}},
+	}
+
+	testCases := []struct {
+		filter  string
+		matches []string
+	}{
+		{filter: `userName eq "ADMIN@test.com"`, matches: []string{"1"}},
+		{filter: `userName sw "res"`, matches: []string{"2"}},
+		{filter: `userName ew "@test.com" and active eq false`, matches: []string{"3"}},
+		{filter: `active eq true or emails.value co "other"`, matches: []string{"1", "2", "3"}},
+		{filter: `not (active eq true)`, matches: []string{"3"}},
+		{filter: `preferredLanguage pr`, matches: []string{"2"}},
+		{filter: `preferredLanguage eq null`, matches: []string{"1", "3"}},
+		{filter: `groups.value eq "ADMIN" or (groups.value eq "RESEARCHER" and preferredLanguage eq "de")`, matches: []string{"1", "2"}},
+		{filter: `urn:ietf:params:scim:schemas:core:2.0:User:userName eq "former@test.com"`, matches: []string{"3"}},
+		{filter: `userName ne "former@test.com"`, matches: []string{"1", "2"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.filter, func(t *testing.T) {
+			expr, err := parseFilter(tc.filter, userFilterAttributes)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			found := []string{}
+			for _, u := range users {
+				if expr.matches(u) {
+					found = append(found, u.ID)
+				}
+			}
+			if len(found) != len(tc.matches) {
+				t.Errorf("unexpected matches: %v, expected %v", found, tc.matches)
+				return
+			}
+			for i := range found {
+				if found[i] != tc.matches[i] {
+					t.Errorf("unexpected matches: %v, expected %v", found, tc.matches)
+				}
+			}
+		})
+	}
+
+	t.Run("with invalid filters", func(t *testing.T) {
+		for _, f := range []string{
+			``,
+			`userName`,
+			`userName eq`,
+			`password eq "secret"`,
+			`userName gt "a"`,
+			`userName eq "unterminated`,
+			`(userName eq "a"`,
+			`userName eq "a" active eq true`,
+			`not userName eq "a"`,
+		} {
+			if _, err := parseFilter(f, userFilterAttributes); err == nil {
+				t.Errorf("filter should be rejected: %s", f)
+			}
+		}
+	})
+}
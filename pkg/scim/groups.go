@@ -0,0 +1,172 @@
+package scim
+
+import (
+	"net/http"
+
+	"github.com/influenzanet/go-utils/pkg/constants"
+	loggingAPI "github.com/influenzanet/logging-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/models"
+)
+
+// groupRoles are the roles exposed as SCIM groups. Participant accounts are not managed by directories.
+var groupRoles = []string{
+	constants.USER_ROLE_ADMIN,
+	constants.USER_ROLE_RESEARCHER,
+	constants.USER_ROLE_SERVICE_ACCOUNT,
+}
+
+func isGroupRole(role string) bool {
+	for _, r := range groupRoles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Server) listGroups(w http.ResponseWriter, r *http.Request, rc requestContext) {
+	var filter filterExpr
+	if f := r.URL.Query().Get("filter"); f != "" {
+		var err error
+		filter, err = parseFilter(f, groupFilterAttributes)
+		if err != nil {
+			writeError(w, newRequestError(http.StatusBadRequest, "invalidFilter", err.Error()))
+			return
+		}
+	}
+
+	users, err := s.userDBservice.FindProvisionedUsers(rc.instanceID, rc.clientName)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	excludeMembers := r.URL.Query().Get("excludedAttributes") == "members"
+	resources := []Group{}
+	for _, role := range groupRoles {
+		group := groupFromRole(role, users, rc.baseURL)
+		if filter != nil && !filter.matches(group) {
+			continue
+		}
+		if excludeMembers {
+			group.Members = nil
+		}
+		resources = append(resources, group)
+	}
+
+	startIndex, count := parsePagination(r)
+	from, to := paginate(len(resources), startIndex, count)
+	writeJSON(w, http.StatusOK, ListResponse{
+		Schemas:      []string{schemaListResponse},
+		TotalResults: len(resources),
+		StartIndex:   startIndex,
+		ItemsPerPage: to - from,
+		Resources:    resources[from:to],
+	})
+}
+
+func (s *Server) getGroup(w http.ResponseWriter, rc requestContext, id string) {
+	if !isGroupRole(id) {
+		writeError(w, newRequestError(http.StatusNotFound, "", "group not found"))
+		return
+	}
+	users, err := s.userDBservice.FindProvisionedUsers(rc.instanceID, rc.clientName)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, groupFromRole(id, users, rc.baseURL))
+}
+
+func (s *Server) patchGroup(w http.ResponseWriter, r *http.Request, rc requestContext, id string) {
+	if !isGroupRole(id) {
+		writeError(w, newRequestError(http.StatusNotFound, "", "group not found"))
+		return
+	}
+	req := PatchRequest{}
+	if err := decodeBody(r, &req); err != nil {
+		writeError(w, err)
+		return
+	}
+	changes, err := parseGroupPatch(req)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	toAdd := changes.add
+	toRemove := changes.remove
+	if changes.replace {
+		users, err := s.userDBservice.FindProvisionedUsers(rc.instanceID, rc.clientName)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		keep := map[string]bool{}
+		for _, uid := range changes.add {
+			keep[uid] = true
+		}
+		toRemove = []string{}
+		for _, u := range users {
+			if u.HasRole(id) && !keep[u.ID.Hex()] {
+				toRemove = append(toRemove, u.ID.Hex())
+			}
+		}
+	}
+
+	// resolve all members first, so that an unknown id doesn't leave the group half updated
+	updates := []models.User{}
+	for _, uid := range toAdd {
+		user, err := s.findMember(rc, uid)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		if user.AddRole(id) == nil {
+			updates = append(updates, user)
+		}
+	}
+	removed := []models.User{}
+	for _, uid := range toRemove {
+		user, err := s.findMember(rc, uid)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		if user.RemoveRole(id) == nil {
+			removed = append(removed, user)
+		}
+	}
+
+	for _, user := range updates {
+		if _, err := s.userDBservice.UpdateUser(rc.instanceID, user); err != nil {
+			writeError(w, err)
+			return
+		}
+		s.saveLogEvent(rc, user.ID.Hex(), loggingAPI.LogEventType_SECURITY, constants.LOG_EVENT_ACCOUNT_ROLE_ADDED, id)
+	}
+	for _, user := range removed {
+		if _, err := s.userDBservice.UpdateUser(rc.instanceID, user); err != nil {
+			writeError(w, err)
+			return
+		}
+		// sessions still carry the removed role
+		if _, err := s.userDBservice.DeleteRenewTokensForUser(rc.instanceID, user.ID.Hex()); err != nil {
+			writeError(w, err)
+			return
+		}
+		s.saveLogEvent(rc, user.ID.Hex(), loggingAPI.LogEventType_SECURITY, constants.LOG_EVENT_ACCOUNT_ROLE_REMOVED, id)
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// findMember loads a user referenced as group member. Members must be users provisioned by the client, so that
+// participants and accounts created by hand can't be given a role.
+func (s *Server) findMember(rc requestContext, id string) (models.User, error) {
+	user, err := s.userDBservice.GetUserByID(rc.instanceID, id)
+	if err != nil || !isProvisioned(user, rc.clientName) {
+		return user, newRequestError(http.StatusBadRequest, "invalidValue", "unknown member: %s", id)
+	}
+	return user, nil
+}
@@ -0,0 +1,271 @@
+package scim
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// requestError is an error that is reported to the SCIM client with the given status and scimType
+type requestError struct {
+	status   int
+	scimType string
+	detail   string
+}
+
+func (e requestError) Error() string {
+	return e.detail
+}
+
+func newRequestError(status int, scimType string, format string, args ...interface{}) requestError {
+	return requestError{
+		status:   status,
+		scimType: scimType,
+		detail:   fmt.Sprintf(format, args...),
+	}
+}
+
+// userChanges collects the attribute updates of a PATCH request on a user, nil means unchanged
+type userChanges struct {
+	userName          *string
+	active            *bool
+	preferredLanguage *string
+	password          *string
+}
+
+// parseUserPatch validates the operations of a PATCH request on a user resource.
+// Attributes the service doesn't store (e.g. name or title) are ignored, so that
+// directories sending their full user schema can still provision accounts.
+func parseUserPatch(req PatchRequest) (userChanges, error) {
+	changes := userChanges{}
+	if err := checkPatchRequest(req); err != nil {
+		return changes, err
+	}
+
+	for _, op := range req.Operations {
+		opName := strings.ToLower(op.Op)
+		path := strings.ToLower(stripSchemaPrefix(op.Path))
+
+		switch opName {
+		case "add", "replace":
+			if path == "" {
+				values, ok := op.Value.(map[string]interface{})
+				if !ok {
+					return changes, newRequestError(http.StatusBadRequest, "invalidValue", "value must be an object when no path is given")
+				}
+				for k, v := range values {
+					if err := changes.set(strings.ToLower(stripSchemaPrefix(k)), v); err != nil {
+						return changes, err
+					}
+				}
+				continue
+			}
+			if err := changes.set(path, op.Value); err != nil {
+				return changes, err
+			}
+		case "remove":
+			switch path {
+			case "":
+				return changes, newRequestError(http.StatusBadRequest, "noTarget", "remove requires a path")
+			case "username", "active":
+				return changes, newRequestError(http.StatusBadRequest, "mutability", "%s cannot be removed", op.Path)
+			case "preferredlanguage":
+				empty := ""
+				changes.preferredLanguage = &empty
+			}
+		default:
+			return changes, newRequestError(http.StatusBadRequest, "invalidSyntax", "unsupported patch operation: %s", op.Op)
+		}
+	}
+	return changes, nil
+}
+
+func (c *userChanges) set(attr string, value interface{}) error {
+	switch attr {
+	case "username":
+		v, ok := value.(string)
+		if !ok || v == "" {
+			return newRequestError(http.StatusBadRequest, "invalidValue", "userName must be a non-empty string")
+		}
+		c.userName = &v
+	case "active":
+		v, err := parseBool(value)
+		if err != nil {
+			return err
+		}
+		c.active = &v
+	case "preferredlanguage":
+		v, ok := value.(string)
+		if !ok {
+			return newRequestError(http.StatusBadRequest, "invalidValue", "preferredLanguage must be a string")
+		}
+		c.preferredLanguage = &v
+	case "password":
+		v, ok := value.(string)
+		if !ok || v == "" {
+			return newRequestError(http.StatusBadRequest, "invalidValue", "password must be a non-empty string")
+		}
+		c.password = &v
+	}
+	return nil
+}
+
+// parseBool accepts JSON booleans and their string representation (as sent by some directories)
+func parseBool(value interface{}) (bool, error) {
+	switch v := value.(type) {
+	case bool:
+		return v, nil
+	case string:
+		b, err := strconv.ParseBool(strings.ToLower(v))
+		if err == nil {
+			return b, nil
+		}
+	}
+	return false, newRequestError(http.StatusBadRequest, "invalidValue", "boolean value expected")
+}
+
+// memberChanges collects the membership updates of a PATCH request on a group
+type memberChanges struct {
+	replace bool // if set, the members are replaced by add
+	add     []string
+	remove  []string
+}
+
+// parseGroupPatch validates the operations of a PATCH request on a group resource.
+// Groups map to fixed roles, so only the members can be changed.
+func parseGroupPatch(req PatchRequest) (memberChanges, error) {
+	changes := memberChanges{
+		add:    []string{},
+		remove: []string{},
+	}
+	if err := checkPatchRequest(req); err != nil {
+		return changes, err
+	}
+
+	for _, op := range req.Operations {
+		opName := strings.ToLower(op.Op)
+		path := strings.ToLower(stripSchemaPrefix(op.Path))
+
+		value := op.Value
+		if path == "" && (opName == "add" || opName == "replace") {
+			values, ok := op.Value.(map[string]interface{})
+			if !ok {
+				return changes, newRequestError(http.StatusBadRequest, "invalidValue", "value must be an object when no path is given")
+			}
+			for k, v := range values {
+				switch strings.ToLower(k) {
+				case "members":
+					value = v
+				case "displayname", "id":
+					return changes, newRequestError(http.StatusBadRequest, "mutability", "%s cannot be changed", k)
+				}
+			}
+			path = "members"
+		}
+
+		if strings.HasPrefix(path, "members[") {
+			memberID, err := parseMemberValueFilter(op.Path)
+			if err != nil {
+				return changes, err
+			}
+			if opName != "remove" {
+				return changes, newRequestError(http.StatusBadRequest, "invalidPath", "value filter on members is only supported for remove")
+			}
+			changes.remove = append(changes.remove, memberID)
+			continue
+		}
+		if path != "members" {
+			return changes, newRequestError(http.StatusBadRequest, "mutability", "%s cannot be changed", op.Path)
+		}
+
+		switch opName {
+		case "add":
+			ids, err := memberIDsFromValue(value)
+			if err != nil {
+				return changes, err
+			}
+			changes.add = append(changes.add, ids...)
+		case "replace":
+			ids, err := memberIDsFromValue(value)
+			if err != nil {
+				return changes, err
+			}
+			changes.replace = true
+			changes.add = ids
+			changes.remove = []string{}
+		case "remove":
+			if value == nil {
+				changes.replace = true
+				changes.add = []string{}
+				changes.remove = []string{}
+				continue
+			}
+			ids, err := memberIDsFromValue(value)
+			if err != nil {
+				return changes, err
+			}
+			changes.remove = append(changes.remove, ids...)
+		default:
+			return changes, newRequestError(http.StatusBadRequest, "invalidSyntax", "unsupported patch operation: %s", op.Op)
+		}
+	}
+	return changes, nil
+}
+
+// parseMemberValueFilter extracts the user id from a path like members[value eq "id"]
+func parseMemberValueFilter(path string) (string, error) {
+	start := strings.Index(path, "[")
+	end := strings.LastIndex(path, "]")
+	if start < 0 || end < start {
+		return "", newRequestError(http.StatusBadRequest, "invalidPath", "invalid path: %s", path)
+	}
+	expr, err := parseFilter(path[start+1:end], []string{"value"})
+	if err != nil {
+		return "", newRequestError(http.StatusBadRequest, "invalidFilter", err.Error())
+	}
+	cmp, ok := expr.(compareExpr)
+	if !ok || cmp.op != "eq" {
+		return "", newRequestError(http.StatusBadRequest, "invalidFilter", "only value eq filter supported for members")
+	}
+	return cmp.value, nil
+}
+
+func memberIDsFromValue(value interface{}) ([]string, error) {
+	items, ok := value.([]interface{})
+	if !ok {
+		items = []interface{}{value}
+	}
+	ids := []string{}
+	for _, item := range items {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, newRequestError(http.StatusBadRequest, "invalidValue", "member must be an object with a value")
+		}
+		id, ok := m["value"].(string)
+		if !ok || id == "" {
+			return nil, newRequestError(http.StatusBadRequest, "invalidValue", "member must be an object with a value")
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func checkPatchRequest(req PatchRequest) error {
+	hasSchema := false
+	for _, s := range req.Schemas {
+		if s == schemaPatchOp {
+			hasSchema = true
+		}
+	}
+	if !hasSchema {
+		return newRequestError(http.StatusBadRequest, "invalidSyntax", "patch request must use the %s schema", schemaPatchOp)
+	}
+	if len(req.Operations) == 0 {
+		return newRequestError(http.StatusBadRequest, "invalidSyntax", "no operations")
+	}
+	if len(req.Operations) > maxPatchOperations {
+		return newRequestError(http.StatusRequestEntityTooLarge, "tooMany", "too many operations")
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package scim
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func patchRequestFromJSON(t *testing.T, body string) PatchRequest {
+	req := PatchRequest{}
+	if err := json.Unmarshal([]byte(body), &req); err != nil {
+		t.Fatalf("invalid test input: %v", err)
+	}
+	return req
+}
+
+func TestParseUserPatch(t *testing.T) {
+	t.Run("without patch schema", func(t *testing.T) {
+		_, err := parseUserPatch(patchRequestFromJSON(t, `{"Operations":[{"op":"replace","path":"active","value":false}]}`))
+		if err == nil {
+			t.Error("should fail")
+		}
+	})
+
+	t.Run("with deactivation", func(t *testing.T) {
+		changes, err := parseUserPatch(patchRequestFromJSON(t, `{
+			"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+			"Operations":[{"op":"Replace","path":"active","value":"False"}]
+		}`))
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if changes.active == nil || *changes.active {
+			t.Error("active should be set to false")
+		}
+		if changes.userName != nil || changes.preferredLanguage != nil {
+			t.Error("unexpected changes")
+		}
+	})
+
+	t.Run("without path", func(t *testing.T) {
+		changes, err := parseUserPatch(patchRequestFromJSON(t, `{
+			"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+			"Operations":[{"op":"replace","value":{"userName":"new@test.com","preferredLanguage":"en","name.givenName":"ignored"}}]
+		}`))
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if changes.userName == nil || *changes.userName != "new@test.com" {
+			t.Error("userName not parsed")
+		}
+		if changes.preferredLanguage == nil || *changes.preferredLanguage != "en" {
+			t.Error("preferredLanguage not parsed")
+		}
+	})
+
+	t.Run("with invalid value", func(t *testing.T) {
+		_, err := parseUserPatch(patchRequestFromJSON(t, `{
+			"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+			"Operations":[{"op":"replace","path":"active","value":"maybe"}]
+		}`))
+		reqErr, ok := err.(requestError)
+		if !ok || reqErr.status != http.StatusBadRequest || reqErr.scimType != "invalidValue" {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("remove userName", func(t *testing.T) {
+		_, err := parseUserPatch(patchRequestFromJSON(t, `{
+			"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+			"Operations":[{"op":"remove","path":"userName"}]
+		}`))
+		if err == nil {
+			t.Error("should fail")
+		}
+	})
+}
+
+func TestParseGroupPatch(t *testing.T) {
+	t.Run("add and remove members", func(t *testing.T) {
+		changes, err := parseGroupPatch(patchRequestFromJSON(t, `{
+			"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+			"Operations":[
+				{"op":"add","path":"members","value":[{"value":"u1"},{"value":"u2"}]},
+				{"op":"remove","path":"members[value eq \"u3\"]"}
+			]
+		}`))
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if changes.replace || len(changes.add) != 2 || len(changes.remove) != 1 || changes.remove[0] != "u3" {
+			t.Errorf("unexpected changes: %+v", changes)
+		}
+	})
+
+	t.Run("replace members", func(t *testing.T) {
+		changes, err := parseGroupPatch(patchRequestFromJSON(t, `{
+			"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+			"Operations":[{"op":"replace","value":{"members":[{"value":"u1"}]}}]
+		}`))
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if !changes.replace || len(changes.add) != 1 {
+			t.Errorf("unexpected changes: %+v", changes)
+		}
+	})
+
+	t.Run("rename group", func(t *testing.T) {
+		_, err := parseGroupPatch(patchRequestFromJSON(t, `{
+			"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+			"Operations":[{"op":"replace","path":"displayName","value":"OTHER"}]
+		}`))
+		if err == nil {
+			t.Error("should fail")
+		}
+	})
+}
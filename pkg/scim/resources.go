@@ -0,0 +1,229 @@
+package scim
+
+import (
+	"time"
+
+	"github.com/influenzanet/user-management-service/pkg/models"
+)
+
+const (
+	schemaUser            = "urn:ietf:params:scim:schemas:core:2.0:User"
+	schemaGroup           = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	schemaListResponse    = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	schemaPatchOp         = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	schemaError           = "urn:ietf:params:scim:api:messages:2.0:Error"
+	schemaServiceProvider = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+	schemaResourceType    = "urn:ietf:params:scim:schemas:core:2.0:ResourceType"
+	resourceTypeUser      = "User"
+	resourceTypeGroup     = "Group"
+	contentTypeSCIM       = "application/scim+json"
+	defaultListCount      = 100
+	maxListCount          = 500
+	maxPatchOperations    = 50
+)
+
+// Meta is the common resource metadata
+type Meta struct {
+	ResourceType string `json:"resourceType"`
+	Created      string `json:"created,omitempty"`
+	LastModified string `json:"lastModified,omitempty"`
+	Location     string `json:"location,omitempty"`
+}
+
+// Email is a multi-valued email attribute of the user resource
+type Email struct {
+	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+// GroupRef references a group the user is member of
+type GroupRef struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+	Ref     string `json:"$ref,omitempty"`
+}
+
+// MemberRef references a user being member of a group
+type MemberRef struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+	Ref     string `json:"$ref,omitempty"`
+}
+
+// User is the SCIM representation of a (non-participant) user account
+type User struct {
+	Schemas           []string   `json:"schemas"`
+	ID                string     `json:"id,omitempty"`
+	UserName          string     `json:"userName"`
+	Active            *bool      `json:"active,omitempty"`
+	PreferredLanguage string     `json:"preferredLanguage,omitempty"`
+	Emails            []Email    `json:"emails,omitempty"`
+	Groups            []GroupRef `json:"groups,omitempty"`
+	Password          string     `json:"password,omitempty"`
+	Meta              *Meta      `json:"meta,omitempty"`
+}
+
+// Group is the SCIM representation of a role
+type Group struct {
+	Schemas     []string    `json:"schemas"`
+	ID          string      `json:"id"`
+	DisplayName string      `json:"displayName"`
+	Members     []MemberRef `json:"members"`
+	Meta        *Meta       `json:"meta,omitempty"`
+}
+
+// ListResponse wraps the results of a query
+type ListResponse struct {
+	Schemas      []string    `json:"schemas"`
+	TotalResults int         `json:"totalResults"`
+	StartIndex   int         `json:"startIndex"`
+	ItemsPerPage int         `json:"itemsPerPage"`
+	Resources    interface{} `json:"Resources"`
+}
+
+// PatchOperation is one entry of a PATCH request
+type PatchOperation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path,omitempty"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+// PatchRequest is the body of a PATCH request
+type PatchRequest struct {
+	Schemas    []string         `json:"schemas"`
+	Operations []PatchOperation `json:"Operations"`
+}
+
+// Error is the body of an error response
+type Error struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail,omitempty"`
+}
+
+func formatTimestamp(ts int64) string {
+	if ts <= 0 {
+		return ""
+	}
+	return time.Unix(ts, 0).UTC().Format(time.RFC3339)
+}
+
+// userFromModel converts a user from the DB to the SCIM representation
+func userFromModel(u models.User, baseURL string) User {
+	active := !u.Account.IsDeactivated()
+	res := User{
+		Schemas:           []string{schemaUser},
+		ID:                u.ID.Hex(),
+		UserName:          u.Account.AccountID,
+		Active:            &active,
+		PreferredLanguage: u.Account.PreferredLanguage,
+		Emails:            []Email{},
+		Groups:            []GroupRef{},
+		Meta: &Meta{
+			ResourceType: resourceTypeUser,
+			Created:      formatTimestamp(u.Timestamps.CreatedAt),
+			LastModified: formatTimestamp(u.Timestamps.UpdatedAt),
+			Location:     baseURL + "/Users/" + u.ID.Hex(),
+		},
+	}
+	for _, ci := range u.ContactInfos {
+		if ci.Type != "email" {
+			continue
+		}
+		res.Emails = append(res.Emails, Email{
+			Value:   ci.Email,
+			Type:    "work",
+			Primary: ci.Email == u.Account.AccountID,
+		})
+	}
+	for _, r := range u.Roles {
+		if !isGroupRole(r) {
+			continue
+		}
+		res.Groups = append(res.Groups, GroupRef{
+			Value:   r,
+			Display: r,
+			Ref:     baseURL + "/Groups/" + r,
+		})
+	}
+	return res
+}
+
+// groupFromRole builds the SCIM group for a role using the given members
+func groupFromRole(role string, members []models.User, baseURL string) Group {
+	res := Group{
+		Schemas:     []string{schemaGroup},
+		ID:          role,
+		DisplayName: role,
+		Members:     []MemberRef{},
+		Meta: &Meta{
+			ResourceType: resourceTypeGroup,
+			Location:     baseURL + "/Groups/" + role,
+		},
+	}
+	for _, m := range members {
+		if !m.HasRole(role) {
+			continue
+		}
+		res.Members = append(res.Members, MemberRef{
+			Value:   m.ID.Hex(),
+			Display: m.Account.AccountID,
+			Ref:     baseURL + "/Users/" + m.ID.Hex(),
+		})
+	}
+	return res
+}
+
+// attributeValues implements filterTarget for users
+func (u User) attributeValues(attr string) []string {
+	switch attr {
+	case "id":
+		return []string{u.ID}
+	case "username":
+		return []string{u.UserName}
+	case "active":
+		if u.Active == nil || *u.Active {
+			return []string{"true"}
+		}
+		return []string{"false"}
+	case "preferredlanguage":
+		return []string{u.PreferredLanguage}
+	case "emails", "emails.value":
+		values := []string{}
+		for _, e := range u.Emails {
+			values = append(values, e.Value)
+		}
+		return values
+	case "groups", "groups.value", "groups.display":
+		values := []string{}
+		for _, g := range u.Groups {
+			values = append(values, g.Value)
+		}
+		return values
+	}
+	return nil
+}
+
+// attributeValues implements filterTarget for groups
+func (g Group) attributeValues(attr string) []string {
+	switch attr {
+	case "id":
+		return []string{g.ID}
+	case "displayname":
+		return []string{g.DisplayName}
+	case "members", "members.value":
+		values := []string{}
+		for _, m := range g.Members {
+			values = append(values, m.Value)
+		}
+		return values
+	}
+	return nil
+}
+
+var (
+	userFilterAttributes  = []string{"id", "userName", "active", "preferredLanguage", "emails", "emails.value", "groups", "groups.value", "groups.display"}
+	groupFilterAttributes = []string{"id", "displayName", "members", "members.value"}
+)
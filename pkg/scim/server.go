@@ -0,0 +1,338 @@
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/coneno/logger"
+	loggingAPI "github.com/influenzanet/logging-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/dbs/globaldb"
+	"github.com/influenzanet/user-management-service/pkg/dbs/userdb"
+	"github.com/influenzanet/user-management-service/pkg/instances"
+	"github.com/influenzanet/user-management-service/pkg/instancesettings"
+	"github.com/influenzanet/user-management-service/pkg/models"
+)
+
+const (
+	// BasePath is the path prefix of the SCIM endpoint, followed by the instance ID
+	BasePath = "/scim/v2"
+
+	maxRequestBodySize = 1 << 20
+
+	logEventAccountDeactivated = "ACCOUNT DEACTIVATED"
+	logEventAccountReactivated = "ACCOUNT REACTIVATED"
+)
+
+// Server exposes non-participant user accounts (as Users) and roles (as Groups) over SCIM 2.0,
+// so that staff accounts can be provisioned by an external identity directory.
+type Server struct {
+	clients         *models.APIClients
+	userDBservice   *userdb.UserDBService
+	globalDBService *globaldb.GlobalDBService
+	// instanceSettings holds the token lifetimes and weekday strategy of each instance
+	instanceSettings *instancesettings.Store
+	instances        *instances.Registry
+}
+
+// NewServer creates a new SCIM handler
+func NewServer(
+	clients *models.APIClients,
+	userDBservice *userdb.UserDBService,
+	globalDBservice *globaldb.GlobalDBService,
+	instanceSettings *instancesettings.Store,
+	instances *instances.Registry,
+) *Server {
+	return &Server{
+		clients:          clients,
+		userDBservice:    userDBservice,
+		globalDBService:  globalDBservice,
+		instanceSettings: instanceSettings,
+		instances:        instances,
+	}
+}
+
+// RunServer starts the SCIM HTTP server and stops it when ctx is done
+func RunServer(ctx context.Context, port string, handler *Server) error {
+	mux := http.NewServeMux()
+	mux.Handle(BasePath+"/", handler)
+
+	server := &http.Server{
+		Addr:              ":" + port,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	shutdownDone := make(chan struct{})
+	go func() {
+		defer close(shutdownDone)
+		<-ctx.Done()
+		logger.Debug.Println("shutting down SCIM server...")
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			logger.Error.Printf("SCIM server shutdown: %v", err)
+		}
+	}()
+
+	logger.Debug.Println("starting SCIM server...")
+	logger.Debug.Println("wait connections on port " + port)
+	if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		return err
+	}
+	// ListenAndServe returns as soon as the shutdown starts, the requests in progress are waited for
+	<-shutdownDone
+	return nil
+}
+
+// requestContext holds the resolved infos of an authenticated request
+type requestContext struct {
+	instanceID string
+	clientName string
+	baseURL    string
+}
+
+// ServeHTTP routes /scim/v2/{instanceID}/{resource}[/{id}]
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, BasePath), "/"), "/")
+	if len(parts) < 2 || len(parts) > 3 || parts[0] == "" {
+		writeError(w, newRequestError(http.StatusNotFound, "", "resource not found"))
+		return
+	}
+	instanceID := parts[0]
+	resource := parts[1]
+	resourceID := ""
+	if len(parts) == 3 {
+		resourceID = parts[2]
+	}
+
+	rc, err := s.authenticate(r, instanceID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxRequestBodySize)
+
+	switch resource {
+	case "Users":
+		s.routeUsers(w, r, rc, resourceID)
+	case "Groups":
+		s.routeGroups(w, r, rc, resourceID)
+	case "ServiceProviderConfig":
+		if r.Method != http.MethodGet || resourceID != "" {
+			writeError(w, newRequestError(http.StatusMethodNotAllowed, "", "method not allowed"))
+			return
+		}
+		writeJSON(w, http.StatusOK, serviceProviderConfig(rc.baseURL))
+	case "ResourceTypes":
+		if r.Method != http.MethodGet || resourceID != "" {
+			writeError(w, newRequestError(http.StatusMethodNotAllowed, "", "method not allowed"))
+			return
+		}
+		types := resourceTypes(rc.baseURL)
+		writeJSON(w, http.StatusOK, ListResponse{
+			Schemas:      []string{schemaListResponse},
+			TotalResults: len(types),
+			StartIndex:   1,
+			ItemsPerPage: len(types),
+			Resources:    types,
+		})
+	default:
+		writeError(w, newRequestError(http.StatusNotFound, "", "resource not found"))
+	}
+}
+
+func (s *Server) routeUsers(w http.ResponseWriter, r *http.Request, rc requestContext, id string) {
+	switch {
+	case r.Method == http.MethodGet && id == "":
+		s.listUsers(w, r, rc)
+	case r.Method == http.MethodPost && id == "":
+		s.createUser(w, r, rc)
+	case r.Method == http.MethodGet:
+		s.getUser(w, rc, id)
+	case r.Method == http.MethodPut:
+		s.replaceUser(w, r, rc, id)
+	case r.Method == http.MethodPatch:
+		s.patchUser(w, r, rc, id)
+	case r.Method == http.MethodDelete:
+		s.deleteUser(w, rc, id)
+	default:
+		writeError(w, newRequestError(http.StatusMethodNotAllowed, "", "method not allowed"))
+	}
+}
+
+func (s *Server) routeGroups(w http.ResponseWriter, r *http.Request, rc requestContext, id string) {
+	switch {
+	case r.Method == http.MethodGet && id == "":
+		s.listGroups(w, r, rc)
+	case r.Method == http.MethodGet:
+		s.getGroup(w, rc, id)
+	case r.Method == http.MethodPatch:
+		s.patchGroup(w, r, rc, id)
+	case r.Method == http.MethodPost || r.Method == http.MethodPut || r.Method == http.MethodDelete:
+		writeError(w, newRequestError(http.StatusNotImplemented, "", "groups map to fixed roles, only their members can be changed"))
+	default:
+		writeError(w, newRequestError(http.StatusMethodNotAllowed, "", "method not allowed"))
+	}
+}
+
+// authenticate checks the bearer token against the SCIM tokens of the global DB
+func (s *Server) authenticate(r *http.Request, instanceID string) (requestContext, error) {
+	rc := requestContext{instanceID: instanceID}
+
+	authHeader := r.Header.Get("Authorization")
+	if len(authHeader) < 7 || !strings.EqualFold(authHeader[:7], "Bearer ") {
+		return rc, newRequestError(http.StatusUnauthorized, "", "missing bearer token")
+	}
+	token := strings.TrimSpace(authHeader[7:])
+	if token == "" {
+		return rc, newRequestError(http.StatusUnauthorized, "", "missing bearer token")
+	}
+
+	scimToken, err := s.globalDBService.FindScimToken(token)
+	if err != nil {
+		logger.Warning.Printf("SECURITY WARNING: SCIM request with invalid token from %s", r.RemoteAddr)
+		return rc, newRequestError(http.StatusUnauthorized, "", "invalid token")
+	}
+	if !scimToken.HasInstance(instanceID) || !s.isInstanceIDAllowed(instanceID) {
+		logger.Warning.Printf("SECURITY WARNING: SCIM client %s tried to access instance %s", scimToken.ClientName, instanceID)
+		return rc, newRequestError(http.StatusForbidden, "", "token not valid for this instance")
+	}
+
+	rc.clientName = scimToken.ClientName
+	rc.baseURL = requestBaseURL(r) + BasePath + "/" + instanceID
+	return rc, nil
+}
+
+func (s *Server) isInstanceIDAllowed(instanceID string) bool {
+	return s.instances.Has(instanceID)
+}
+
+func (s *Server) saveLogEvent(rc requestContext, userID string, eventType loggingAPI.LogEventType, eventName string, msg string) {
+	_, err := s.clients.LoggingService.SaveLogEvent(context.TODO(), &loggingAPI.NewLogEvent{
+		Origin:     "user-management",
+		InstanceId: rc.instanceID,
+		UserId:     userID,
+		EventType:  eventType,
+		EventName:  eventName,
+		Msg:        "scim client " + rc.clientName + ": " + msg,
+	})
+	if err != nil {
+		logger.Error.Printf("failed to save log: %s", err.Error())
+	}
+}
+
+func requestBaseURL(r *http.Request) string {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	if proto := r.Header.Get("X-Forwarded-Proto"); proto != "" {
+		scheme = proto
+	}
+	return scheme + "://" + r.Host
+}
+
+// parsePagination reads startIndex (1-based) and count from the query
+func parsePagination(r *http.Request) (startIndex int, count int) {
+	startIndex = 1
+	count = defaultListCount
+	if v, err := strconv.Atoi(r.URL.Query().Get("startIndex")); err == nil && v > 1 {
+		startIndex = v
+	}
+	if v, err := strconv.Atoi(r.URL.Query().Get("count")); err == nil && v >= 0 {
+		count = v
+	}
+	if count > maxListCount {
+		count = maxListCount
+	}
+	return
+}
+
+// paginate returns the bounds of the requested page within a result list of the given size
+func paginate(total int, startIndex int, count int) (from int, to int) {
+	from = startIndex - 1
+	if from > total {
+		from = total
+	}
+	to = from + count
+	if to > total {
+		to = total
+	}
+	return
+}
+
+func decodeBody(r *http.Request, v interface{}) error {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		return newRequestError(http.StatusBadRequest, "invalidSyntax", "invalid request body: %v", err)
+	}
+	return nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", contentTypeSCIM)
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		logger.Error.Printf("SCIM: failed to write response: %v", err)
+	}
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	reqErr, ok := err.(requestError)
+	if !ok {
+		logger.Error.Printf("SCIM: %v", err)
+		reqErr = newRequestError(http.StatusInternalServerError, "", "internal error")
+	}
+	writeJSON(w, reqErr.status, Error{
+		Schemas:  []string{schemaError},
+		Status:   strconv.Itoa(reqErr.status),
+		ScimType: reqErr.scimType,
+		Detail:   reqErr.detail,
+	})
+}
+
+func serviceProviderConfig(baseURL string) map[string]interface{} {
+	return map[string]interface{}{
+		"schemas":        []string{schemaServiceProvider},
+		"patch":          map[string]bool{"supported": true},
+		"bulk":           map[string]interface{}{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+		"filter":         map[string]interface{}{"supported": true, "maxResults": maxListCount},
+		"changePassword": map[string]bool{"supported": true},
+		"sort":           map[string]bool{"supported": false},
+		"etag":           map[string]bool{"supported": false},
+		"authenticationSchemes": []map[string]interface{}{
+			{
+				"type":        "oauthbearertoken",
+				"name":        "Bearer Token",
+				"description": "Per-instance token issued by the service operator",
+				"primary":     true,
+			},
+		},
+		"meta": Meta{ResourceType: "ServiceProviderConfig", Location: baseURL + "/ServiceProviderConfig"},
+	}
+}
+
+func resourceTypes(baseURL string) []map[string]interface{} {
+	return []map[string]interface{}{
+		{
+			"schemas":  []string{schemaResourceType},
+			"id":       resourceTypeUser,
+			"name":     resourceTypeUser,
+			"endpoint": "/Users",
+			"schema":   schemaUser,
+			"meta":     Meta{ResourceType: "ResourceType", Location: baseURL + "/ResourceTypes/" + resourceTypeUser},
+		},
+		{
+			"schemas":  []string{schemaResourceType},
+			"id":       resourceTypeGroup,
+			"name":     resourceTypeGroup,
+			"endpoint": "/Groups",
+			"schema":   schemaGroup,
+			"meta":     Meta{ResourceType: "ResourceType", Location: baseURL + "/ResourceTypes/" + resourceTypeGroup},
+		},
+	}
+}
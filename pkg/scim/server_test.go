@@ -0,0 +1,60 @@
+package scim
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/influenzanet/user-management-service/pkg/instances"
+	"github.com/influenzanet/user-management-service/pkg/instancesettings"
+)
+
+func TestServeHTTPWithoutToken(t *testing.T) {
+	s := NewServer(nil, nil, nil, instancesettings.NewStore(nil, instancesettings.Settings{}), instances.NewStaticRegistry("test"))
+
+	t.Run("unknown path", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		s.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, BasePath+"/test", nil))
+		if rec.Code != http.StatusNotFound {
+			t.Errorf("unexpected status: %d", rec.Code)
+		}
+	})
+
+	t.Run("missing bearer token", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		s.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, BasePath+"/test/Users", nil))
+		if rec.Code != http.StatusUnauthorized {
+			t.Errorf("unexpected status: %d", rec.Code)
+			return
+		}
+		if rec.Header().Get("Content-Type") != contentTypeSCIM {
+			t.Errorf("unexpected content type: %s", rec.Header().Get("Content-Type"))
+		}
+		body := Error{}
+		if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if body.Status != "401" || len(body.Schemas) != 1 || body.Schemas[0] != schemaError {
+			t.Errorf("unexpected error body: %+v", body)
+		}
+	})
+}
+
+func TestPaginate(t *testing.T) {
+	testCases := []struct {
+		total, startIndex, count, from, to int
+	}{
+		{total: 10, startIndex: 1, count: 100, from: 0, to: 10},
+		{total: 10, startIndex: 3, count: 2, from: 2, to: 4},
+		{total: 10, startIndex: 12, count: 2, from: 10, to: 10},
+		{total: 10, startIndex: 1, count: 0, from: 0, to: 0},
+	}
+	for _, tc := range testCases {
+		from, to := paginate(tc.total, tc.startIndex, tc.count)
+		if from != tc.from || to != tc.to {
+			t.Errorf("unexpected page for %+v: %d-%d", tc, from, to)
+		}
+	}
+}
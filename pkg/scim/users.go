@@ -0,0 +1,385 @@
+package scim
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/coneno/logger"
+	"github.com/influenzanet/go-utils/pkg/constants"
+	loggingAPI "github.com/influenzanet/logging-service/pkg/api"
+	messageAPI "github.com/influenzanet/messaging-service/pkg/api/messaging_service"
+	"github.com/influenzanet/user-management-service/pkg/dbs/userdb"
+	"github.com/influenzanet/user-management-service/pkg/erasure"
+	"github.com/influenzanet/user-management-service/pkg/models"
+	"github.com/influenzanet/user-management-service/pkg/pwhash"
+	"github.com/influenzanet/user-management-service/pkg/tokens"
+	"github.com/influenzanet/user-management-service/pkg/utils"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func (s *Server) listUsers(w http.ResponseWriter, r *http.Request, rc requestContext) {
+	var filter filterExpr
+	if f := r.URL.Query().Get("filter"); f != "" {
+		var err error
+		filter, err = parseFilter(f, userFilterAttributes)
+		if err != nil {
+			writeError(w, newRequestError(http.StatusBadRequest, "invalidFilter", err.Error()))
+			return
+		}
+	}
+
+	users, err := s.userDBservice.FindProvisionedUsers(rc.instanceID, rc.clientName)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	resources := []User{}
+	for _, u := range users {
+		scimUser := userFromModel(u, rc.baseURL)
+		if filter != nil && !filter.matches(scimUser) {
+			continue
+		}
+		resources = append(resources, scimUser)
+	}
+
+	startIndex, count := parsePagination(r)
+	from, to := paginate(len(resources), startIndex, count)
+	writeJSON(w, http.StatusOK, ListResponse{
+		Schemas:      []string{schemaListResponse},
+		TotalResults: len(resources),
+		StartIndex:   startIndex,
+		ItemsPerPage: to - from,
+		Resources:    resources[from:to],
+	})
+}
+
+func (s *Server) getUser(w http.ResponseWriter, rc requestContext, id string) {
+	user, err := s.findProvisionedUser(rc, id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, userFromModel(user, rc.baseURL))
+}
+
+func (s *Server) createUser(w http.ResponseWriter, r *http.Request, rc requestContext) {
+	req := User{}
+	if err := decodeBody(r, &req); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	accountID := utils.SanitizeEmail(req.UserName)
+	if !utils.CheckEmailFormat(accountID) {
+		writeError(w, newRequestError(http.StatusBadRequest, "invalidValue", "userName must be a valid email address"))
+		return
+	}
+	if req.PreferredLanguage != "" && !utils.CheckLanguageCode(req.PreferredLanguage) {
+		writeError(w, newRequestError(http.StatusBadRequest, "invalidValue", "invalid preferredLanguage"))
+		return
+	}
+	if _, err := s.userDBservice.GetUserByAccountID(rc.instanceID, accountID); err == nil {
+		writeError(w, newRequestError(http.StatusConflict, "uniqueness", "userName already in use"))
+		return
+	}
+
+	sendInvitation := req.Password == ""
+	password := req.Password
+	if sendInvitation {
+		// not used, the user sets the password through the invitation
+		randomPW, err := tokens.GenerateUniqueTokenString()
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		password = randomPW
+	} else if !utils.CheckPasswordFormat(password) {
+		writeError(w, newRequestError(http.StatusBadRequest, "invalidValue", "password too weak"))
+		return
+	}
+	hashedPassword, err := pwhash.HashPassword(password)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	newUser := models.User{
+		Account: models.Account{
+			Type:                  models.ACCOUNT_TYPE_EMAIL,
+			AccountID:             accountID,
+			AccountConfirmedAt:    time.Now().Unix(), // address is asserted by the directory
+			Password:              hashedPassword,
+			PreferredLanguage:     req.PreferredLanguage,
+			ProvisionedBy:         rc.clientName,
+			FailedLoginAttempts:   []int64{},
+			PasswordResetTriggers: []int64{},
+		},
+		Roles: []string{},
+		Profiles: []models.Profile{
+			{
+				ID:                 primitive.NewObjectID(),
+				Alias:              utils.BlurEmailAddress(accountID),
+				AvatarID:           "default",
+				ConsentConfirmedAt: time.Now().Unix(),
+				MainProfile:        true,
+			},
+		},
+		Timestamps: models.Timestamps{
+			CreatedAt: time.Now().Unix(),
+		},
+	}
+	if req.Active != nil && !*req.Active {
+		newUser.Account.DeactivatedAt = time.Now().Unix()
+	}
+	newUser.AddNewEmail(accountID, true)
+	newUser.ContactPreferences.SubscribedToNewsletter = false
+	newUser.ContactPreferences.SendNewsletterTo = []string{newUser.ContactInfos[0].ID.Hex()}
+	newUser.ContactPreferences.SubscribedToWeekly = false
+	weekdayStrategy := s.instanceSettings.Get(rc.instanceID).WeekdayStrategy
+	newUser.ContactPreferences.ReceiveWeeklyMessageDayOfWeek = int32(weekdayStrategy.Weekday())
+
+	newUser.ID = primitive.NewObjectID()
+
+	// the invitation is queued together with the user, so it is sent even if the messaging service is down
+	emails := []models.OutgoingEmail{}
+	invitationToken := ""
+	if sendInvitation && !newUser.Account.IsDeactivated() {
+		invitation, tempToken, err := s.invitationEmail(rc, newUser)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		emails = append(emails, invitation)
+		invitationToken = tempToken
+	}
+
+	id, err := s.userDBservice.AddUserWithOutgoingEmails(rc.instanceID, newUser, emails)
+	if err != nil {
+		if invitationToken != "" {
+			if err := s.globalDBService.DeleteTempToken(invitationToken); err != nil {
+				logger.Error.Printf("SCIM: failed to remove invitation token: %s", err.Error())
+			}
+		}
+		writeError(w, newRequestError(http.StatusConflict, "uniqueness", "userName already in use"))
+		return
+	}
+
+	s.saveLogEvent(rc, id, loggingAPI.LogEventType_LOG, constants.LOG_EVENT_ACCOUNT_CREATED, "")
+	writeJSON(w, http.StatusCreated, userFromModel(newUser, rc.baseURL))
+}
+
+func (s *Server) replaceUser(w http.ResponseWriter, r *http.Request, rc requestContext, id string) {
+	req := User{}
+	if err := decodeBody(r, &req); err != nil {
+		writeError(w, err)
+		return
+	}
+	if req.UserName == "" {
+		writeError(w, newRequestError(http.StatusBadRequest, "invalidValue", "userName is required"))
+		return
+	}
+
+	user, err := s.findProvisionedUser(rc, id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	active := true
+	if req.Active != nil {
+		active = *req.Active
+	}
+	changes := userChanges{
+		userName:          &req.UserName,
+		active:            &active,
+		preferredLanguage: &req.PreferredLanguage,
+	}
+	if req.Password != "" {
+		changes.password = &req.Password
+	}
+
+	user, err = s.applyUserChanges(rc, user, changes)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, userFromModel(user, rc.baseURL))
+}
+
+func (s *Server) patchUser(w http.ResponseWriter, r *http.Request, rc requestContext, id string) {
+	req := PatchRequest{}
+	if err := decodeBody(r, &req); err != nil {
+		writeError(w, err)
+		return
+	}
+	changes, err := parseUserPatch(req)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	user, err := s.findProvisionedUser(rc, id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	user, err = s.applyUserChanges(rc, user, changes)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, userFromModel(user, rc.baseURL))
+}
+
+func (s *Server) deleteUser(w http.ResponseWriter, rc requestContext, id string) {
+	user, err := s.findProvisionedUser(rc, id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	// the event is logged here to name the client, a failed step of the erasure is resumed later
+	e, err := erasure.New(s.userDBservice, s.globalDBService, s.clients).Erase(context.TODO(), rc.instanceID, erasure.Request{
+		User:        user,
+		RequestedBy: "scim client " + rc.clientName,
+	})
+	if err == userdb.ErrUserChanged {
+		writeError(w, newRequestError(http.StatusNotFound, "", "user not found"))
+		return
+	}
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if e.Status != models.ERASURE_STATUS_DONE {
+		logger.Warning.Printf("SCIM: erasure of user %s not completed: %s", id, e.LastError)
+	}
+
+	s.saveLogEvent(rc, id, loggingAPI.LogEventType_LOG, constants.LOG_EVENT_ACCOUNT_DELETED, "")
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// findProvisionedUser loads a user that is visible to SCIM clients
+func (s *Server) findProvisionedUser(rc requestContext, id string) (models.User, error) {
+	if _, err := primitive.ObjectIDFromHex(id); err != nil {
+		return models.User{}, newRequestError(http.StatusNotFound, "", "user not found")
+	}
+	user, err := s.userDBservice.GetUserByID(rc.instanceID, id)
+	if err != nil || !isProvisioned(user, rc.clientName) {
+		return models.User{}, newRequestError(http.StatusNotFound, "", "user not found")
+	}
+	return user, nil
+}
+
+// isProvisioned checks if the user was created by the SCIM client, which alone manages it. Accounts created by hand or
+// by another client are not visible, even with a group role.
+func isProvisioned(user models.User, clientName string) bool {
+	return user.Account.ProvisionedBy != "" && user.Account.ProvisionedBy == clientName
+}
+
+func (s *Server) applyUserChanges(rc requestContext, user models.User, changes userChanges) (models.User, error) {
+	if changes.userName != nil {
+		accountID := utils.SanitizeEmail(*changes.userName)
+		if !utils.CheckEmailFormat(accountID) {
+			return user, newRequestError(http.StatusBadRequest, "invalidValue", "userName must be a valid email address")
+		}
+		if accountID != user.Account.AccountID {
+			if other, err := s.userDBservice.GetUserByAccountID(rc.instanceID, accountID); err == nil && other.ID != user.ID {
+				return user, newRequestError(http.StatusConflict, "uniqueness", "userName already in use")
+			}
+			oldAccountID := user.Account.AccountID
+			user.Account.AccountID = accountID
+			if ci, found := user.FindContactInfoByTypeAndAddr("email", oldAccountID); found {
+				for i := range user.ContactInfos {
+					if user.ContactInfos[i].ID == ci.ID {
+						user.ContactInfos[i].Email = accountID
+						user.ContactInfos[i].ConfirmedAt = time.Now().Unix()
+					}
+				}
+			} else {
+				user.AddNewEmail(accountID, true)
+			}
+			s.saveLogEvent(rc, user.ID.Hex(), loggingAPI.LogEventType_SECURITY, constants.LOG_EVENT_ACCOUNT_ID_CHANGED, "")
+		}
+	}
+
+	if changes.preferredLanguage != nil {
+		if *changes.preferredLanguage != "" && !utils.CheckLanguageCode(*changes.preferredLanguage) {
+			return user, newRequestError(http.StatusBadRequest, "invalidValue", "invalid preferredLanguage")
+		}
+		user.Account.PreferredLanguage = *changes.preferredLanguage
+	}
+
+	passwordChanged := false
+	if changes.password != nil {
+		if !utils.CheckPasswordFormat(*changes.password) {
+			return user, newRequestError(http.StatusBadRequest, "invalidValue", "password too weak")
+		}
+		hashedPassword, err := pwhash.HashPassword(*changes.password)
+		if err != nil {
+			return user, err
+		}
+		user.Account.Password = hashedPassword
+		passwordChanged = true
+	}
+
+	deactivated := false
+	if changes.active != nil {
+		if !*changes.active && !user.Account.IsDeactivated() {
+			user.Account.DeactivatedAt = time.Now().Unix()
+			deactivated = true
+		} else if *changes.active && user.Account.IsDeactivated() {
+			user.Account.DeactivatedAt = 0
+			s.saveLogEvent(rc, user.ID.Hex(), loggingAPI.LogEventType_SECURITY, logEventAccountReactivated, "")
+		}
+	}
+
+	updatedUser, err := s.userDBservice.UpdateUser(rc.instanceID, user)
+	if err != nil {
+		return user, err
+	}
+
+	if deactivated || passwordChanged {
+		// end existing sessions
+		if _, err := s.userDBservice.DeleteRenewTokensForUser(rc.instanceID, user.ID.Hex()); err != nil {
+			logger.Error.Printf("SCIM: error, when trying to remove renew tokens: %s", err.Error())
+		}
+	}
+	if deactivated {
+		s.saveLogEvent(rc, user.ID.Hex(), loggingAPI.LogEventType_SECURITY, logEventAccountDeactivated, "")
+	}
+	if passwordChanged {
+		s.saveLogEvent(rc, user.ID.Hex(), loggingAPI.LogEventType_SECURITY, constants.LOG_EVENT_PASSWORD_CHANGED, "")
+	}
+	return updatedUser, nil
+}
+
+// invitationEmail creates the invitation token of the user and returns the email to queue with the user
+func (s *Server) invitationEmail(rc requestContext, user models.User) (models.OutgoingEmail, string, error) {
+	tempTokenInfos := models.TempToken{
+		UserID:     user.ID.Hex(),
+		InstanceID: rc.instanceID,
+		Purpose:    constants.TOKEN_PURPOSE_INVITATION,
+		Info:       user.ContactVerificationInfos(user.Account.AccountID),
+		Expiration: tokens.GetExpirationTime(s.instanceSettings.Get(rc.instanceID).Intervals.InvitationTokenLifetime),
+	}
+	tempToken, err := s.globalDBService.AddTempToken(tempTokenInfos)
+	if err != nil {
+		return models.OutgoingEmail{}, "", err
+	}
+
+	invitation := models.NewOutgoingEmail(models.OUTGOING_EMAIL_INSTANT, &messageAPI.SendEmailReq{
+		InstanceId:  rc.instanceID,
+		To:          []string{user.Account.AccountID},
+		MessageType: constants.EMAIL_TYPE_INVITATION,
+		ContentInfos: map[string]string{
+			"token": tempToken,
+		},
+		PreferredLanguage: user.Account.PreferredLanguage,
+		UseLowPrio:        true,
+	})
+	return invitation, tempToken, nil
+}
@@ -0,0 +1,29 @@
+package timer_event
+
+import (
+	"context"
+	"time"
+
+	"github.com/coneno/logger"
+	"github.com/influenzanet/user-management-service/pkg/models"
+)
+
+// expiredTempTokensKeptFor is how long expired tokens are kept, so that using them is answered with "token expired"
+const expiredTempTokensKeptFor = time.Hour
+
+// CleanUpExpiredTempTokens removes the temp tokens that expired
+func (s *UserManagementTimerService) CleanUpExpiredTempTokens(ctx context.Context, run *models.JobRun) {
+	logger.Debug.Println("Starting clean up job for expired temp tokens:")
+	for _, instanceID := range s.instancesOf(run) {
+		if !s.isLeader(ctx) {
+			return
+		}
+		count, err := s.globalDBService.DeleteTempTokensExpireBefore(instanceID, "", time.Now().Add(-expiredTempTokensKeptFor).Unix())
+		if err != nil {
+			reportError(run, instanceID, "unexpected error: %s", err.Error())
+			continue
+		}
+		reportAffected(run, instanceID, int(count))
+		logger.Debug.Printf("%s: removed %d expired temp tokens", instanceID, count)
+	}
+}
@@ -1,29 +1,40 @@
 package timer_event
 
 import (
-	"time"
+	"context"
 
 	"github.com/coneno/logger"
+	"github.com/influenzanet/user-management-service/pkg/dbs/userdb"
+	"github.com/influenzanet/user-management-service/pkg/erasure"
+	"github.com/influenzanet/user-management-service/pkg/models"
 )
 
-// CleanUpUnverifiedUsers handles the deletion of unverified accounts after a threshold delay
-func (s *UserManagementTimerService) CleanUpUnverifiedUsers() {
+// CleanUpUnverifiedUsers handles the deletion of unverified accounts after a threshold delay, or their anonymization
+// if the instance keeps the accounts
+func (s *UserManagementTimerService) CleanUpUnverifiedUsers(ctx context.Context, run *models.JobRun) {
 	logger.Debug.Println("Starting clean up job for unverified users:")
-	instances, err := s.globalDBService.GetAllInstances()
-	if err != nil {
-		logger.Error.Printf("unexpected error: %s", err.Error())
-	}
-	deleteUnverifiedUsersAfter := s.CleanUpTimeThreshold
-	for _, instance := range instances {
-		count, err := s.userDBService.DeleteUnverfiedUsers(instance.InstanceID, time.Now().Unix()-deleteUnverifiedUsersAfter)
+	for _, instanceID := range s.instancesOf(run) {
+		if !s.isLeader(ctx) {
+			return
+		}
+		settings := s.instanceSettings.Get(instanceID)
+		createdBefore := unverifiedUsersCreatedBefore(settings)
+		users, err := s.userDBService.FindUnverifiedUsers(instanceID, createdBefore)
 		if err != nil {
-			logger.Error.Printf("unexpected error: %s", err.Error())
+			reportError(run, instanceID, "unexpected error: %s", err.Error())
 			continue
 		}
+		// no email nor log event, the account was never confirmed
+		count := s.eraseUsers(ctx, run, instanceID, users, erasure.Request{
+			RequestedBy: run.Job,
+			Filter:      userdb.UnverifiedUsers(createdBefore),
+			Anonymize:   settings.AnonymizesAccounts(),
+		})
+		reportAffected(run, instanceID, count)
 		if count > 0 {
-			logger.Info.Printf("%s: removed %d unverified accounts", instance.InstanceID, count)
+			logger.Info.Printf("%s: removed %d unverified accounts", instanceID, count)
 		} else {
-			logger.Debug.Printf("%s: removed %d unverified accounts", instance.InstanceID, count)
+			logger.Debug.Printf("%s: removed %d unverified accounts", instanceID, count)
 		}
 
 	}
@@ -2,100 +2,55 @@ package timer_event
 
 import (
 	"context"
+	"time"
 
 	"github.com/coneno/logger"
-	"github.com/influenzanet/go-utils/pkg/api_types"
 	"github.com/influenzanet/go-utils/pkg/constants"
-	loggingAPI "github.com/influenzanet/logging-service/pkg/api"
-	messageAPI "github.com/influenzanet/messaging-service/pkg/api/messaging_service"
-	"github.com/influenzanet/user-management-service/pkg/utils"
+	"github.com/influenzanet/user-management-service/pkg/dbs/userdb"
+	"github.com/influenzanet/user-management-service/pkg/erasure"
+	"github.com/influenzanet/user-management-service/pkg/models"
 )
 
-// CleanupUsersMarkedForDeletion handles the deletion of accounts that did not react to reminder mail
-func (s *UserManagementTimerService) CleanupUsersMarkedForDeletion() {
+// CleanupUsersMarkedForDeletion handles the deletion of accounts that did not react to reminder mail, or their
+// anonymization if the instance keeps the accounts
+func (s *UserManagementTimerService) CleanupUsersMarkedForDeletion(ctx context.Context, run *models.JobRun) {
 	logger.Debug.Println("Starting clean up job for users marked for deletion:")
-	instances, err := s.globalDBService.GetAllInstances()
-	if err != nil {
-		logger.Error.Printf("unexpected error: %s", err.Error())
-	}
-	for _, instance := range instances {
-		users, err := s.userDBService.FindUsersMarkedForDeletion(instance.InstanceID)
-		count := 0
-
+	for _, instanceID := range s.instancesOf(run) {
+		if !s.isLeader(ctx) {
+			return
+		}
+		settings := s.instanceSettings.Get(instanceID)
+		if !settings.HandlesInactiveUsers() {
+			continue
+		}
+		if keepsUsersMarkedForDeletion(settings, s.clients.StudyService != nil) {
+			reportError(run, instanceID, noteUsersMarkedForDeletionKept)
+			continue
+		}
+		anonymize := settings.AnonymizesAccounts()
+		now := time.Now().Unix()
+		users, err := s.userDBService.FindUsersMarkedForDeletion(instanceID)
 		if err != nil {
-			logger.Error.Printf("unexpected error: %s", err.Error())
+			reportError(run, instanceID, "unexpected error: %s", err.Error())
 			continue
 		}
-		for _, u := range users {
-
-			//notify study service
-			mainProfileID, otherProfileIDs := utils.GetMainAndOtherProfiles(u)
-			userProfileIDs := []string{mainProfileID}
-			userProfileIDs = append(userProfileIDs, otherProfileIDs...)
-			token := &api_types.TokenInfos{
-				Id:              u.ID.Hex(),
-				InstanceId:      instance.InstanceID,
-				ProfilId:        mainProfileID,
-				OtherProfileIds: otherProfileIDs,
-			}
-			studyServiceError := error(nil)
-			for _, profileId := range userProfileIDs {
-				token.ProfilId = profileId
-				if _, err := s.clients.StudyService.ProfileDeleted(context.Background(), token); err != nil {
-					logger.Error.Printf("failed to notify study service: %s", err.Error())
-					studyServiceError = err
-					continue
-				}
-			}
-			if studyServiceError != nil {
-				logger.Error.Printf("failed to notify study service: %s", studyServiceError.Error())
-				continue
-			}
-			err := s.globalDBService.DeleteAllTempTokenForUser(instance.InstanceID, u.ID.Hex(), "")
-			if err != nil {
-				logger.Error.Printf("error, when trying to remove temp-tokens: %s", err.Error())
-				continue
-			}
-			_, err = s.userDBService.DeleteRenewTokensForUser(instance.InstanceID, u.ID.Hex())
-			if err != nil {
-				logger.Error.Printf("error, when trying to remove renew tokens: %s", err.Error())
-				continue
-			}
-			err = s.userDBService.DeleteUser(instance.InstanceID, u.ID.Hex())
-			if err != nil {
-				logger.Error.Printf("error, when trying to delete user: %s", err.Error())
-				continue
-			}
-			// ---> Trigger message sending
-			_, err = s.clients.MessagingService.QueueEmailTemplateForSending(context.TODO(), &messageAPI.SendEmailReq{
-				InstanceId:        instance.InstanceID,
-				To:                []string{u.Account.AccountID},
-				MessageType:       constants.EMAIL_TYPE_ACCOUNT_DELETED_AFTER_INACTIVITY,
-				PreferredLanguage: u.Account.PreferredLanguage,
-				UseLowPrio:        true,
-			})
-			if err != nil {
-				logger.Error.Printf("DeleteAccount: %s", err.Error())
-			}
-
-			_, err = s.clients.LoggingService.SaveLogEvent(context.TODO(), &loggingAPI.NewLogEvent{
-				Origin:     "user-management",
-				InstanceId: instance.InstanceID,
-				UserId:     u.ID.Hex(),
-				EventType:  loggingAPI.LogEventType_LOG,
-				EventName:  constants.LOG_EVENT_ACCOUNT_DELETED_AFTER_INACTIVITY,
-				Msg:        u.Account.AccountID,
-			})
-			if err != nil {
-				logger.Error.Printf("failed to save log: %s", err.Error())
-			}
-			logger.Info.Printf("%s: removed account with user ID %s", instance.InstanceID, u.ID.Hex())
-			count++
+		logEvent := constants.LOG_EVENT_ACCOUNT_DELETED_AFTER_INACTIVITY
+		if anonymize {
+			logEvent = erasure.LogEventAccountAnonymized
 		}
+		// the filter keeps the users who logged in since they were found
+		count := s.eraseUsers(ctx, run, instanceID, users, erasure.Request{
+			RequestedBy: run.Job,
+			EmailType:   constants.EMAIL_TYPE_ACCOUNT_DELETED_AFTER_INACTIVITY,
+			LogEvent:    logEvent,
+			Filter:      userdb.UsersMarkedForDeletion(now),
+			Anonymize:   anonymize,
+		})
+		reportAffected(run, instanceID, count)
 		if count > 0 {
-			logger.Info.Printf("%s: removed %d inactive accounts", instance.InstanceID, count)
+			logger.Info.Printf("%s: removed %d inactive accounts", instanceID, count)
 		} else {
-			logger.Debug.Printf("%s: removed %d inactive accounts", instance.InstanceID, count)
+			logger.Debug.Printf("%s: removed %d inactive accounts", instanceID, count)
 		}
 
 	}
@@ -0,0 +1,41 @@
+package timer_event
+
+import (
+	"context"
+	"time"
+
+	"github.com/coneno/logger"
+	"github.com/influenzanet/go-utils/pkg/constants"
+	"github.com/influenzanet/user-management-service/pkg/dbs/userdb"
+	"github.com/influenzanet/user-management-service/pkg/erasure"
+	"github.com/influenzanet/user-management-service/pkg/models"
+)
+
+// CompleteAccountDeletions deletes the accounts of participants who requested it, once they can't be restored anymore
+func (s *UserManagementTimerService) CompleteAccountDeletions(ctx context.Context, run *models.JobRun) {
+	logger.Debug.Println("Starting job completing the requested account deletions:")
+	for _, instanceID := range s.instancesOf(run) {
+		if !s.isLeader(ctx) {
+			return
+		}
+		now := time.Now().Unix()
+		users, err := s.userDBService.FindUsersWithDeletionDue(instanceID, now)
+		if err != nil {
+			reportError(run, instanceID, "unexpected error: %s", err.Error())
+			continue
+		}
+		// the filter keeps the accounts restored since they were found
+		count := s.eraseUsers(ctx, run, instanceID, users, erasure.Request{
+			RequestedBy: run.Job,
+			EmailType:   constants.EMAIL_TYPE_ACCOUNT_DELETED,
+			LogEvent:    constants.LOG_EVENT_ACCOUNT_DELETED,
+			Filter:      userdb.UsersWithDeletionDue(now),
+		})
+		reportAffected(run, instanceID, count)
+		if count > 0 {
+			logger.Info.Printf("%s: completed %d account deletions", instanceID, count)
+		} else {
+			logger.Debug.Printf("%s: completed %d account deletions", instanceID, count)
+		}
+	}
+}
@@ -11,43 +11,49 @@ import (
 	"github.com/influenzanet/user-management-service/pkg/tokens"
 )
 
-func (s *UserManagementTimerService) DetectAndNotifyInactiveUsers() {
+func (s *UserManagementTimerService) DetectAndNotifyInactiveUsers(ctx context.Context, run *models.JobRun) {
 
 	logger.Debug.Println("Starting search and notify job for inactive users:")
-	instances, err := s.globalDBService.GetAllInstances()
-	if err != nil {
-		logger.Error.Printf("unexpected error: %s", err.Error())
-	}
 
-	for _, instance := range instances {
+	for _, instanceID := range s.instancesOf(run) {
+		if !s.isLeader(ctx) {
+			return
+		}
+
+		settings := s.instanceSettings.Get(instanceID)
+		if !settings.HandlesInactiveUsers() {
+			continue
+		}
 
-		users, err := s.userDBService.FindInactiveUsers(instance.InstanceID, s.NotifyInactiveUserThreshold)
+		users, err := s.userDBService.FindInactiveUsers(instanceID, settings.NotifyInactiveUsersAfter)
 		count := 0
 		if err != nil {
-			logger.Error.Printf("unexpected error: %s", err.Error())
+			reportError(run, instanceID, "unexpected error: %s", err.Error())
 			continue
 		}
 
 		for _, u := range users {
+			if !s.isLeader(ctx) {
+				logger.Info.Printf("%s: notification of inactive users interrupted", instanceID)
+				break
+			}
 			tempTokenInfos := models.TempToken{
 				UserID:     u.ID.Hex(),
-				InstanceID: instance.InstanceID,
+				InstanceID: instanceID,
 				Purpose:    constants.TOKEN_PURPOSE_INACTIVE_USER_NOTIFICATION,
 				Info: map[string]string{
-					"type":  models.ACCOUNT_TYPE_EMAIL,
-					"email": u.Account.AccountID,
+					"type": models.ACCOUNT_TYPE_EMAIL,
 				},
-				Expiration: tokens.GetExpirationTime(time.Second * time.Duration(s.DeleteAccountAfterNotifyingThreshold)),
+				Expiration: tokens.GetExpirationTime(time.Second * time.Duration(settings.DeleteAccountAfterNotifyingUser)),
 			}
 			tempToken, err := s.globalDBService.AddTempToken(tempTokenInfos)
 			if err != nil {
-				logger.Error.Printf("failed to create verification token: %s", err.Error())
+				reportError(run, instanceID, "failed to create verification token: %s", err.Error())
 				continue
 			}
-			//send message
-			// ---> Trigger message sending
-			_, err = s.clients.MessagingService.QueueEmailTemplateForSending(context.TODO(), &messageAPI.SendEmailReq{
-				InstanceId:  instance.InstanceID,
+			// the notification is queued together with the deletion mark, so it is sent once
+			notification := models.NewOutgoingEmail(models.OUTGOING_EMAIL_QUEUED, &messageAPI.SendEmailReq{
+				InstanceId:  instanceID,
 				To:          []string{u.Account.AccountID},
 				MessageType: constants.EMAIL_TYPE_ACCOUNT_INACTIVITY,
 				ContentInfos: map[string]string{
@@ -55,24 +61,23 @@ func (s *UserManagementTimerService) DetectAndNotifyInactiveUsers() {
 				},
 				PreferredLanguage: u.Account.PreferredLanguage,
 			})
+			marked, err := s.userDBService.MarkForDeletionWithOutgoingEmails(ctx, instanceID, u.ID.Hex(), settings.DeleteAccountAfterNotifyingUser, []models.OutgoingEmail{notification})
 			if err != nil {
-				logger.Error.Printf("unexpected error: %v", err)
-				continue
-			}
-			succcess, err := s.userDBService.UpdateMarkedForDeletionTime(instance.InstanceID, u.ID.Hex(), s.DeleteAccountAfterNotifyingThreshold, false)
-			if err != nil {
-				logger.Error.Printf("unexpected error: %v", err)
-				continue
+				reportError(run, instanceID, "unexpected error: %v", err)
 			}
-			if !succcess { //markedForDeletion already set by other service
+			if err != nil || !marked { //markedForDeletion already set by other service
+				if err := s.globalDBService.DeleteTempToken(tempToken); err != nil {
+					logger.Error.Printf("%s: failed to remove inactivity token: %s", instanceID, err.Error())
+				}
 				continue
 			}
 			count++
 		}
+		reportAffected(run, instanceID, count)
 		if count > 0 {
-			logger.Info.Printf("%s: notification mail will be sent to %d inactive accounts", instance.InstanceID, count)
+			logger.Info.Printf("%s: notification mail will be sent to %d inactive accounts", instanceID, count)
 		} else {
-			logger.Debug.Printf("%s: notification mail will be sent to %d inactive accounts", instance.InstanceID, count)
+			logger.Debug.Printf("%s: notification mail will be sent to %d inactive accounts", instanceID, count)
 		}
 	}
 }
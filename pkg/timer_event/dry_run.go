@@ -0,0 +1,86 @@
+package timer_event
+
+import (
+	"errors"
+	"time"
+
+	"github.com/influenzanet/user-management-service/pkg/dbs/userdb"
+	"github.com/influenzanet/user-management-service/pkg/instancesettings"
+	"github.com/influenzanet/user-management-service/pkg/jobs"
+	"github.com/influenzanet/user-management-service/pkg/models"
+)
+
+// ErrNoDryRun is returned for jobs without dry-run mode
+var ErrNoDryRun = errors.New("job has no dry run")
+
+// DryRunJobs can be run without side effects, to review which accounts they would delete or notify
+var DryRunJobs = []string{
+	jobs.CleanUpUnverifiedUsers,
+	jobs.DetectAndNotifyInactiveUsers,
+	jobs.CleanupUsersMarkedForDeletion,
+	jobs.CompleteAccountDeletions,
+}
+
+const (
+	noteInactiveUsersNotHandled    = "inactive users are not handled, notifyInactiveUsersAfter and deleteAccountAfterNotifyingUser must both be set"
+	noteUsersMarkedForDeletionKept = "users marked for deletion are kept, no connection to the study service"
+)
+
+// DryRun returns the accounts of the instance the job would delete or notify if it ran now with settings. It uses
+// the same queries as the job, but doesn't change anything. studyServiceConnected tells if the job could notify the
+// study service, without it the accounts marked for deletion are kept.
+func DryRun(userDBService *userdb.UserDBService, job string, instanceID string, settings instancesettings.Settings, studyServiceConnected bool) (models.DryRunReport, error) {
+	report := models.DryRunReport{
+		Job:        job,
+		InstanceID: instanceID,
+		CreatedAt:  time.Now().Unix(),
+		Settings:   settings.ToAPI(),
+		Accounts:   []models.DryRunAccount{},
+	}
+
+	var users []models.User
+	var err error
+	switch job {
+	case jobs.CleanUpUnverifiedUsers:
+		users, err = userDBService.FindUnverifiedUsers(instanceID, unverifiedUsersCreatedBefore(settings))
+	case jobs.DetectAndNotifyInactiveUsers:
+		if !settings.HandlesInactiveUsers() {
+			report.Note = noteInactiveUsersNotHandled
+			return report, nil
+		}
+		users, err = userDBService.FindInactiveUsers(instanceID, settings.NotifyInactiveUsersAfter)
+	case jobs.CleanupUsersMarkedForDeletion:
+		if !settings.HandlesInactiveUsers() {
+			report.Note = noteInactiveUsersNotHandled
+			return report, nil
+		}
+		if keepsUsersMarkedForDeletion(settings, studyServiceConnected) {
+			report.Note = noteUsersMarkedForDeletionKept
+			return report, nil
+		}
+		users, err = userDBService.FindUsersMarkedForDeletion(instanceID)
+	case jobs.CompleteAccountDeletions:
+		users, err = userDBService.FindUsersWithDeletionDue(instanceID, time.Now().Unix())
+	default:
+		return report, ErrNoDryRun
+	}
+	if err != nil {
+		return report, err
+	}
+
+	for _, u := range users {
+		report.Accounts = append(report.Accounts, models.NewDryRunAccount(u))
+	}
+	return report, nil
+}
+
+// keepsUsersMarkedForDeletion tells if the accounts marked for deletion are kept, as deleting them requires the study
+// service to be notified
+func keepsUsersMarkedForDeletion(settings instancesettings.Settings, studyServiceConnected bool) bool {
+	return !settings.AnonymizesAccounts() && !studyServiceConnected
+}
+
+// unverifiedUsersCreatedBefore is the creation time before which unverified accounts are deleted
+func unverifiedUsersCreatedBefore(settings instancesettings.Settings) int64 {
+	return time.Now().Unix() - settings.CleanUpUnverifiedUsersAfter
+}
@@ -0,0 +1,69 @@
+package timer_event
+
+import (
+	"context"
+
+	"github.com/coneno/logger"
+	"github.com/influenzanet/user-management-service/pkg/dbs/userdb"
+	"github.com/influenzanet/user-management-service/pkg/erasure"
+	"github.com/influenzanet/user-management-service/pkg/models"
+)
+
+// ResumeAccountErasures continues the account erasures whose step failed, once they are due for a retry
+func (s *UserManagementTimerService) ResumeAccountErasures(ctx context.Context, run *models.JobRun) {
+	logger.Debug.Println("Starting job resuming the account erasures:")
+	for _, instanceID := range s.instancesOf(run) {
+		if !s.isLeader(ctx) {
+			return
+		}
+		resumed, err := s.eraser.ResumeDue(ctx, instanceID, func() bool { return s.isLeader(ctx) })
+		if err != nil {
+			reportError(run, instanceID, "unexpected error: %s", err.Error())
+		}
+		count := 0
+		for _, e := range resumed {
+			switch e.Status {
+			case models.ERASURE_STATUS_DONE:
+				count++
+			case models.ERASURE_STATUS_FAILED:
+				reportError(run, instanceID, "erasure of user %s failed, no retry left: %s", e.UserID, e.LastError)
+			default:
+				reportError(run, instanceID, "erasure of user %s not completed, to be resumed: %s", e.UserID, e.LastError)
+			}
+		}
+		reportAffected(run, instanceID, count)
+		if count > 0 {
+			logger.Info.Printf("%s: completed %d account erasures", instanceID, count)
+		} else {
+			logger.Debug.Printf("%s: completed %d account erasures", instanceID, count)
+		}
+	}
+}
+
+// eraseUsers runs the erasure of each user with req, until ctx is done or the lease is lost. It returns how many
+// users were deleted, the erasures not completed are resumed by ResumeAccountErasures.
+func (s *UserManagementTimerService) eraseUsers(ctx context.Context, run *models.JobRun, instanceID string, users []models.User, req erasure.Request) (count int) {
+	for _, u := range users {
+		// the erasure of a user is not interrupted, only the next one is not started
+		if !s.isLeader(ctx) {
+			logger.Info.Printf("%s: %s interrupted", instanceID, run.Job)
+			break
+		}
+		req.User = u
+		e, err := s.eraser.Erase(ctx, instanceID, req)
+		if err == userdb.ErrUserChanged {
+			logger.Debug.Printf("%s: user %s kept, it changed since it was selected", instanceID, u.ID.Hex())
+			continue
+		}
+		if err != nil {
+			reportError(run, instanceID, "error, when trying to delete user: %s", err.Error())
+			continue
+		}
+		if e.Status != models.ERASURE_STATUS_DONE {
+			reportError(run, instanceID, "erasure of user %s not completed, to be resumed: %s", u.ID.Hex(), e.LastError)
+			continue
+		}
+		count++
+	}
+	return count
+}
@@ -0,0 +1,210 @@
+package timer_event
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/coneno/logger"
+	"github.com/influenzanet/user-management-service/pkg/metrics"
+	"github.com/influenzanet/user-management-service/pkg/models"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// triggerPollInterval is how long it takes to start a run requested by an admin
+const triggerPollInterval = 10 * time.Second
+
+// JobRunHistoryTTL is how long finished runs are kept in the history
+const JobRunHistoryTTL = 90 * 24 * time.Hour
+
+// jobFunc goes through the instances of the run and records what it did in run
+type jobFunc func(ctx context.Context, run *models.JobRun)
+
+// startTimerThread runs the jobs when they are due and when an admin requests it. It returns when ctx is done and
+// the running jobs have stopped.
+func (s *UserManagementTimerService) startTimerThread(ctx context.Context) {
+	logger.Info.Println("Starting timer thread")
+	if err := s.globalDBService.CreateIndexForJobRuns(JobRunHistoryTTL); err != nil {
+		logger.Error.Printf("index of the job runs couldn't be created: %v", err)
+	}
+	// runs can only be in progress on this instance of the service from now on
+	if count, err := s.globalDBService.InterruptRunningJobRuns(); err != nil {
+		logger.Error.Printf("unexpected error: %v", err)
+	} else if count > 0 {
+		logger.Warning.Printf("%d job runs were interrupted by the stop of the previous leader", count)
+	}
+
+	nextRuns := s.nextRuns(time.Now())
+	running := &runningJobs{names: map[string]bool{}}
+	var wg sync.WaitGroup
+	for {
+		wait := triggerPollInterval
+		for _, next := range nextRuns {
+			if d := time.Until(next); d < wait {
+				wait = d
+			}
+		}
+
+		select {
+		case <-time.After(wait):
+			now := time.Now()
+			for _, job := range s.jobs {
+				next, ok := nextRuns[job.Name]
+				if !ok || next.After(now) {
+					continue
+				}
+				nextRuns[job.Name] = job.NextRun(now.Unix(), now)
+				if running.has(job.Name) {
+					logger.Warning.Printf("%s: previous run still in progress, scheduled run skipped", job.Name)
+					continue
+				}
+				run, err := s.globalDBService.AddJobRun(models.JobRun{
+					Job:         job.Name,
+					Trigger:     models.JOB_TRIGGER_SCHEDULE,
+					Status:      models.JOB_RUN_STATUS_RUNNING,
+					RequestedAt: now.Unix(),
+					StartedAt:   now.Unix(),
+				})
+				if err != nil {
+					logger.Error.Printf("%s: run couldn't be saved: %v", job.Name, err)
+					continue
+				}
+				s.startRun(ctx, &wg, running, run)
+			}
+			s.startRequestedRuns(ctx, &wg, running)
+		case <-ctx.Done():
+			wg.Wait()
+			logger.Info.Println("Timer thread stopped")
+			return
+		}
+	}
+}
+
+// nextRuns returns when the enabled jobs are due. The schedules go on from the last runs in the history, so that
+// a new leader doesn't delay or repeat the jobs.
+func (s *UserManagementTimerService) nextRuns(now time.Time) map[string]time.Time {
+	nextRuns := map[string]time.Time{}
+	for _, job := range s.jobs {
+		if !job.Enabled {
+			logger.Info.Printf("%s: disabled", job.Name)
+			continue
+		}
+		lastRun := int64(0)
+		last, err := s.globalDBService.GetLastScheduledJobRun(job.Name)
+		if err == nil {
+			lastRun = last.RequestedAt
+		} else if err != mongo.ErrNoDocuments {
+			logger.Error.Printf("%s: last run couldn't be read: %v", job.Name, err)
+		}
+		nextRuns[job.Name] = job.NextRun(lastRun, now)
+		logger.Info.Printf("%s: scheduled '%s', next run at %s", job.Name, job.Schedule, nextRuns[job.Name].Format(time.RFC3339))
+	}
+	return nextRuns
+}
+
+// startRequestedRuns starts the pending runs requested by admins, except for jobs already running
+func (s *UserManagementTimerService) startRequestedRuns(ctx context.Context, wg *sync.WaitGroup, running *runningJobs) {
+	for ctx.Err() == nil {
+		run, err := s.globalDBService.ClaimPendingJobRun(running.list())
+		if err != nil {
+			if err != mongo.ErrNoDocuments {
+				logger.Error.Printf("unexpected error: %v", err)
+			}
+			return
+		}
+		logger.Info.Printf("%s: run requested by %s for instance %s", run.Job, run.RequestedBy, run.InstanceID)
+		s.startRun(ctx, wg, running, run)
+	}
+}
+
+func (s *UserManagementTimerService) startRun(ctx context.Context, wg *sync.WaitGroup, running *runningJobs, run models.JobRun) {
+	running.add(run.Job)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer running.remove(run.Job)
+		s.execute(ctx, &run)
+	}()
+}
+
+// execute runs the job and saves the outcome of the run
+func (s *UserManagementTimerService) execute(ctx context.Context, run *models.JobRun) {
+	job, ok := s.jobFuncs[run.Job]
+	if ok {
+		done := metrics.StartJob(run.Job)
+		job(ctx, run)
+		done()
+	} else {
+		run.AddError(run.InstanceID, "unknown job")
+	}
+
+	run.Status = models.JOB_RUN_STATUS_FINISHED
+	if ctx.Err() != nil {
+		run.Status = models.JOB_RUN_STATUS_INTERRUPTED
+	}
+	run.FinishedAt = time.Now().Unix()
+	if err := s.globalDBService.FinishJobRun(*run); err != nil {
+		logger.Error.Printf("%s: outcome of the run couldn't be saved: %v", run.Job, err)
+	}
+}
+
+// instancesOf returns the instances the run goes through
+func (s *UserManagementTimerService) instancesOf(run *models.JobRun) []string {
+	if run.InstanceID == "" {
+		return s.instances.IDs()
+	}
+	if !s.instances.Has(run.InstanceID) {
+		return []string{}
+	}
+	return []string{run.InstanceID}
+}
+
+// reportAffected counts the users or tokens the run acted on in the instance
+func reportAffected(run *models.JobRun, instanceID string, count int) {
+	run.AddAffected(instanceID, int64(count))
+	metrics.AddJobAffectedUsers(run.Job, instanceID, count)
+}
+
+// reportError logs a problem of the run in the instance and keeps it in the history
+func reportError(run *models.JobRun, instanceID string, format string, args ...interface{}) {
+	msg := fmt.Sprintf(format, args...)
+	logger.Error.Printf("%s: %s", instanceID, msg)
+	run.AddError(instanceID, msg)
+}
+
+// runningJobs are the jobs with a run in progress, a job doesn't run twice at the same time
+type runningJobs struct {
+	mu    sync.Mutex
+	names map[string]bool
+}
+
+func (r *runningJobs) add(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.names[name] = true
+}
+
+func (r *runningJobs) remove(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.names, name)
+}
+
+func (r *runningJobs) has(name string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.names[name]
+}
+
+func (r *runningJobs) list() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	names := make([]string, 0, len(r.names))
+	for name := range r.names {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
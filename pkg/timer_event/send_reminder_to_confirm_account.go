@@ -8,41 +8,39 @@ import (
 	"github.com/coneno/logger"
 	"github.com/influenzanet/go-utils/pkg/constants"
 	messageAPI "github.com/influenzanet/messaging-service/pkg/api/messaging_service"
+	"github.com/influenzanet/user-management-service/pkg/leader"
 	"github.com/influenzanet/user-management-service/pkg/models"
 	"github.com/influenzanet/user-management-service/pkg/tokens"
 )
 
 // CleanUpUnverifiedUsers handles the deletion of unverified accounts after a threshold delay
-func (s *UserManagementTimerService) ReminderToConfirmAccount() {
+func (s *UserManagementTimerService) ReminderToConfirmAccount(ctx context.Context, run *models.JobRun) {
 	logger.Debug.Println("Check if reminders to confirm accounts need to be sent out.")
-	instances, err := s.globalDBService.GetAllInstances()
-	if err != nil {
-		logger.Error.Printf("unexpected error: %s", err.Error())
-	}
-	sendReminderToConfirmAfter := s.ReminderTimeThreshold
-
+	// the loop over the users stops when loopCtx is cancelled
+	loopCtx, stopLoop := context.WithCancel(ctx)
+	defer stopLoop()
 	sendReminderToUser := func(instanceID string, user models.User, args ...interface{}) error {
+		if !s.isLeader(ctx) {
+			stopLoop()
+			return leader.ErrNotLeader
+		}
 		count, _ := args[0].(*int)
 
 		tempTokenInfos := models.TempToken{
 			UserID:     user.ID.Hex(),
 			InstanceID: instanceID,
 			Purpose:    constants.TOKEN_PURPOSE_CONTACT_VERIFICATION,
-			Info: map[string]string{
-				"type":  models.ACCOUNT_TYPE_EMAIL,
-				"email": user.Account.AccountID,
-			},
+			Info:       user.ContactVerificationInfos(user.Account.AccountID),
 			Expiration: tokens.GetExpirationTime(time.Hour * 24 * 30),
 		}
 		tempToken, err := s.globalDBService.AddTempToken(tempTokenInfos)
 		if err != nil {
-			logger.Error.Printf("unexpected error: %s", err.Error())
+			reportError(run, instanceID, "unexpected error: %s", err.Error())
 			return errors.New("failed to create verification token")
 		}
 
-		// ---> Trigger message sending
-
-		_, err = s.clients.MessagingService.SendInstantEmail(context.TODO(), &messageAPI.SendEmailReq{
+		// the reminder is queued together with its timestamp, so it is sent once
+		reminder := models.NewOutgoingEmail(models.OUTGOING_EMAIL_INSTANT, &messageAPI.SendEmailReq{
 			InstanceId:  instanceID,
 			To:          []string{user.Account.AccountID},
 			MessageType: constants.EMAIL_TYPE_REGISTRATION,
@@ -51,26 +49,38 @@ func (s *UserManagementTimerService) ReminderToConfirmAccount() {
 			},
 			PreferredLanguage: user.Account.PreferredLanguage,
 		})
-		if err != nil {
-			logger.Error.Printf("unexpected error: %s", err.Error())
+		if err := s.userDBService.MarkReminderToConfirmSentWithOutgoingEmails(ctx, instanceID, user.ID.Hex(), []models.OutgoingEmail{reminder}); err != nil {
+			reportError(run, instanceID, "unexpected error: %s", err.Error())
+			if err := s.globalDBService.DeleteTempToken(tempToken); err != nil {
+				logger.Error.Printf("%s: failed to remove verification token: %s", instanceID, err.Error())
+			}
 			return err
 		}
 		*count = *count + 1
 		return nil
 	}
 
-	for _, instance := range instances {
+	for _, instanceID := range s.instancesOf(run) {
+		if !s.isLeader(ctx) {
+			return
+		}
 		count := 0
-		ctx := context.Background()
-		err := s.userDBService.SendReminderToConfirmAccountLoop(ctx, instance.InstanceID, time.Now().Unix()-sendReminderToConfirmAfter, sendReminderToUser, &count)
+		sendReminderToConfirmAfter := s.instanceSettings.Get(instanceID).ReminderToUnverifiedAccountsAfter
+		err := s.userDBService.SendReminderToConfirmAccountLoop(loopCtx, instanceID, time.Now().Unix()-sendReminderToConfirmAfter, sendReminderToUser, &count)
+		if loopCtx.Err() != nil {
+			logger.Info.Printf("%s: reminders to unverified accounts interrupted", instanceID)
+			reportAffected(run, instanceID, count)
+			return
+		}
 		if err != nil {
-			logger.Error.Printf("unexpected error: %s", err.Error())
+			reportError(run, instanceID, "unexpected error: %s", err.Error())
 			continue
 		}
+		reportAffected(run, instanceID, count)
 		if count > 0 {
-			logger.Info.Printf("%s: %d sent reminders to unverified accounts", instance.InstanceID, count)
+			logger.Info.Printf("%s: %d sent reminders to unverified accounts", instanceID, count)
 		} else {
-			logger.Debug.Printf("%s: %d sent reminders to unverified accounts", instance.InstanceID, count)
+			logger.Debug.Printf("%s: %d sent reminders to unverified accounts", instanceID, count)
 		}
 
 	}
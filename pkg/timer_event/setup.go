@@ -2,66 +2,99 @@ package timer_event
 
 import (
 	"context"
-	"time"
+	"sync"
 
 	"github.com/coneno/logger"
 	"github.com/influenzanet/user-management-service/pkg/dbs/globaldb"
 	"github.com/influenzanet/user-management-service/pkg/dbs/userdb"
+	"github.com/influenzanet/user-management-service/pkg/erasure"
+	"github.com/influenzanet/user-management-service/pkg/instances"
+	"github.com/influenzanet/user-management-service/pkg/instancesettings"
+	"github.com/influenzanet/user-management-service/pkg/jobs"
+	"github.com/influenzanet/user-management-service/pkg/leader"
 	"github.com/influenzanet/user-management-service/pkg/models"
 )
 
+// leaseName of the leader election between the instances of the service
+const leaseName = "timer-jobs"
+
 // UserManagementTimerService handles background times for user management (cleanup for example).
 type UserManagementTimerService struct {
-	globalDBService                      *globaldb.GlobalDBService
-	userDBService                        *userdb.UserDBService
-	clients                              *models.APIClients
-	TimerEventFrequency                  int64 // how often the timer event should be performed (only from one instance of the service) - seconds
-	CleanUpTimeThreshold                 int64 // if user account not verified, remove user after this many seconds
-	ReminderTimeThreshold                int64 // if user account not verified, send a reminder email to the user after this many seconds
-	NotifyInactiveUserThreshold          int64 // if user account is inactive, send a reminder email to the user after this many seconds
-	DeleteAccountAfterNotifyingThreshold int64 // if user account is notified by mail, delete account after this many seconds
+	globalDBService *globaldb.GlobalDBService
+	userDBService   *userdb.UserDBService
+	clients         *models.APIClients
+	// eraser deletes the accounts and their data in the other services
+	eraser    *erasure.Eraser
+	instances *instances.Registry
+	// instanceSettings holds the thresholds of the jobs for each instance
+	instanceSettings *instancesettings.Store
+
+	// jobs are run by their schedule (only by the instance holding the lease) or on request of an admin
+	jobs     []jobs.Config
+	jobFuncs map[string]jobFunc
 
+	// elector makes sure that only one instance of the service runs the jobs
+	elector *leader.Elector
+
+	// wg tracks the leader election, which waits for the timer thread and its jobs
+	wg sync.WaitGroup
 }
 
 func NewUserManagmentTimerService(
-	frequency int64,
+	jobConfigs []jobs.Config,
 	globalDBService *globaldb.GlobalDBService,
 	userDBService *userdb.UserDBService,
 	clients *models.APIClients,
-	cleanUpTimeThreshold int64,
-	reminderTimeThreshold int64,
-	notifyInactiveUserThreshold int64,
-	deleteAccountAfterNotifyingThreshold int64,
+	instances *instances.Registry,
+	instanceSettings *instancesettings.Store,
 ) *UserManagementTimerService {
-	return &UserManagementTimerService{
-		globalDBService:                      globalDBService,
-		userDBService:                        userDBService,
-		TimerEventFrequency:                  frequency,
-		clients:                              clients,
-		CleanUpTimeThreshold:                 cleanUpTimeThreshold,
-		ReminderTimeThreshold:                reminderTimeThreshold,
-		NotifyInactiveUserThreshold:          notifyInactiveUserThreshold,
-		DeleteAccountAfterNotifyingThreshold: deleteAccountAfterNotifyingThreshold,
+	s := &UserManagementTimerService{
+		globalDBService:  globalDBService,
+		userDBService:    userDBService,
+		clients:          clients,
+		eraser:           erasure.New(userDBService, globalDBService, clients),
+		instances:        instances,
+		instanceSettings: instanceSettings,
+		jobs:             jobConfigs,
+		elector:          leader.NewElector(globalDBService, leaseName),
+	}
+	s.jobFuncs = map[string]jobFunc{
+		jobs.CleanUpUnverifiedUsers:        s.CleanUpUnverifiedUsers,
+		jobs.ReminderToConfirmAccount:      s.ReminderToConfirmAccount,
+		jobs.DetectAndNotifyInactiveUsers:  s.DetectAndNotifyInactiveUsers,
+		jobs.CleanupUsersMarkedForDeletion: s.CleanupUsersMarkedForDeletion,
+		jobs.CleanUpExpiredTempTokens:      s.CleanUpExpiredTempTokens,
+		jobs.CompleteAccountDeletions:      s.CompleteAccountDeletions,
+		jobs.ResumeAccountErasures:         s.ResumeAccountErasures,
 	}
+	return s
 }
 
+// Run starts the timer thread whenever this instance of the service is elected leader. When ctx is cancelled or the
+// leadership is lost, no new job is started and the running jobs stop after the user they are processing, use Wait
+// to know when they are done.
 func (s *UserManagementTimerService) Run(ctx context.Context) {
-	go s.startTimerThread(ctx, s.TimerEventFrequency)
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		s.elector.Run(ctx, s.startTimerThread)
+	}()
 }
 
-func (s *UserManagementTimerService) startTimerThread(ctx context.Context, timeCheckInterval int64) {
-	logger.Info.Printf("Starting timer thread with frequency %d seconds", timeCheckInterval)
-	for {
-		select {
-		case <-time.After(time.Duration(timeCheckInterval) * time.Second):
-			go s.CleanUpUnverifiedUsers()
-			go s.ReminderToConfirmAccount()
-			if s.NotifyInactiveUserThreshold > 0 && s.DeleteAccountAfterNotifyingThreshold > 0 {
-				go s.DetectAndNotifyInactiveUsers()
-				go s.CleanupUsersMarkedForDeletion()
-			}
-		case <-ctx.Done():
-			return
-		}
+// Wait blocks until the timer thread and its jobs have stopped
+func (s *UserManagementTimerService) Wait() {
+	s.wg.Wait()
+}
+
+// isLeader tells if the jobs may go on. Besides ctx, it checks that no other instance of the service took over
+// the lease, in case this one didn't notice yet. The jobs call it before each user they change.
+func (s *UserManagementTimerService) isLeader(ctx context.Context) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	if err := s.elector.Check(); err != nil {
+		logger.Warning.Printf("timer jobs stopped: %v", err)
+		return false
 	}
+	return true
 }
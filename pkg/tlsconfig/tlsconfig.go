@@ -0,0 +1,256 @@
+// Package tlsconfig creates TLS configurations for the gRPC server and clients. Certificates, keys and
+// CA bundles are read from files and reloaded when the files change, e.g. after a rotation by cert-manager.
+package tlsconfig
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/coneno/logger"
+)
+
+// ReloadInterval is how often the files are checked for changes
+const ReloadInterval = 30 * time.Second
+
+// ServerConfig holds the files of the server certificate and of the CA bundle verifying client certificates
+type ServerConfig struct {
+	CertFile string
+	KeyFile  string
+	// ClientCAFile enables mTLS. Client certificates are verified if given, and required with RequireClientCert.
+	ClientCAFile      string
+	RequireClientCert bool
+}
+
+// Enabled is true if a server certificate is configured
+func (c ServerConfig) Enabled() bool {
+	return c.CertFile != "" || c.KeyFile != ""
+}
+
+// ClientConfig holds the TLS settings of a connection to another service
+type ClientConfig struct {
+	Enabled bool
+	// CAFile verifying the server certificate, the system roots are used if empty
+	CAFile string
+	// CertFile and KeyFile of the client certificate for mTLS
+	CertFile string
+	KeyFile  string
+	// ServerName expected in the server certificate, defaults to the host of the address
+	ServerName string
+}
+
+// certStore holds the current certificate and CA pool loaded from files
+type certStore struct {
+	certFile string
+	keyFile  string
+	caFile   string
+
+	mu       sync.RWMutex
+	cert     *tls.Certificate
+	caPool   *x509.CertPool
+	modTimes map[string]time.Time
+}
+
+func newCertStore(ctx context.Context, certFile string, keyFile string, caFile string) (*certStore, error) {
+	if (certFile == "") != (keyFile == "") {
+		return nil, errors.New("certificate and key file must be set together")
+	}
+	s := &certStore{
+		certFile: certFile,
+		keyFile:  keyFile,
+		caFile:   caFile,
+	}
+	if err := s.load(); err != nil {
+		return nil, err
+	}
+	go s.watch(ctx, ReloadInterval)
+	return s, nil
+}
+
+func (s *certStore) files() []string {
+	files := []string{}
+	for _, f := range []string{s.certFile, s.keyFile, s.caFile} {
+		if f != "" {
+			files = append(files, f)
+		}
+	}
+	return files
+}
+
+func (s *certStore) load() error {
+	modTimes := map[string]time.Time{}
+	for _, f := range s.files() {
+		info, err := os.Stat(f)
+		if err != nil {
+			return err
+		}
+		modTimes[f] = info.ModTime()
+	}
+
+	var cert *tls.Certificate
+	if s.certFile != "" {
+		c, err := tls.LoadX509KeyPair(s.certFile, s.keyFile)
+		if err != nil {
+			return err
+		}
+		cert = &c
+	}
+
+	var caPool *x509.CertPool
+	if s.caFile != "" {
+		pem, err := os.ReadFile(s.caFile)
+		if err != nil {
+			return err
+		}
+		caPool = x509.NewCertPool()
+		if !caPool.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("%s: no certificate found", s.caFile)
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cert = cert
+	s.caPool = caPool
+	s.modTimes = modTimes
+	return nil
+}
+
+// changed checks the modification times, which follow the symlinks swapped by Kubernetes secret updates
+func (s *certStore) changed() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, f := range s.files() {
+		info, err := os.Stat(f)
+		if err != nil {
+			// possibly in the middle of an update, the next check will tell
+			return false
+		}
+		if !info.ModTime().Equal(s.modTimes[f]) {
+			return true
+		}
+	}
+	return false
+}
+
+// reload loads the files again if they changed. The previous certificates stay in use if loading fails.
+func (s *certStore) reload() {
+	if !s.changed() {
+		return
+	}
+	if err := s.load(); err != nil {
+		logger.Error.Printf("failed to reload TLS files %v: %v", s.files(), err)
+		return
+	}
+	logger.Info.Printf("reloaded TLS files %v", s.files())
+}
+
+func (s *certStore) watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.reload()
+		}
+	}
+}
+
+func (s *certStore) get() (*tls.Certificate, *x509.CertPool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.cert, s.caPool
+}
+
+// NewServerTLSConfig creates the config of the gRPC server. Files are watched until ctx is done.
+func NewServerTLSConfig(ctx context.Context, conf ServerConfig) (*tls.Config, error) {
+	if conf.CertFile == "" || conf.KeyFile == "" {
+		return nil, errors.New("server certificate and key file must be set")
+	}
+	if conf.RequireClientCert && conf.ClientCAFile == "" {
+		return nil, errors.New("client CA file must be set to require client certificates")
+	}
+	store, err := newCertStore(ctx, conf.CertFile, conf.KeyFile, conf.ClientCAFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		// a config per handshake, so that new connections use the latest files
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			cert, caPool := store.get()
+			c := &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*cert},
+				NextProtos:   []string{"h2"},
+			}
+			if caPool != nil {
+				c.ClientCAs = caPool
+				c.ClientAuth = tls.VerifyClientCertIfGiven
+				if conf.RequireClientCert {
+					c.ClientAuth = tls.RequireAndVerifyClientCert
+				}
+			}
+			return c, nil
+		},
+	}, nil
+}
+
+// NewClientTLSConfig creates the config of a connection to another service, or nil if TLS is not enabled.
+// Files are watched until ctx is done.
+func NewClientTLSConfig(ctx context.Context, conf ClientConfig) (*tls.Config, error) {
+	if !conf.Enabled {
+		return nil, nil
+	}
+	store, err := newCertStore(ctx, conf.CertFile, conf.KeyFile, conf.CAFile)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: conf.ServerName,
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			cert, _ := store.get()
+			if cert == nil {
+				// no certificate is sent
+				return &tls.Certificate{}, nil
+			}
+			return cert, nil
+		},
+	}
+	if conf.CAFile != "" {
+		// the standard verification can't use a CA pool changing after the config was created,
+		// so the chain is verified against the current pool here
+		c.InsecureSkipVerify = true
+		c.VerifyConnection = func(cs tls.ConnectionState) error {
+			_, caPool := store.get()
+			return verifyServerCertificate(cs, caPool)
+		}
+	}
+	return c, nil
+}
+
+func verifyServerCertificate(cs tls.ConnectionState, roots *x509.CertPool) error {
+	if len(cs.PeerCertificates) == 0 {
+		return errors.New("no server certificate")
+	}
+	opts := x509.VerifyOptions{
+		Roots:         roots,
+		DNSName:       cs.ServerName,
+		Intermediates: x509.NewCertPool(),
+	}
+	for _, c := range cs.PeerCertificates[1:] {
+		opts.Intermediates.AddCert(c)
+	}
+	_, err := cs.PeerCertificates[0].Verify(opts)
+	return err
+}
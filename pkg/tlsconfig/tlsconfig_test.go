@@ -0,0 +1,273 @@
+package tlsconfig
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newTestCA(t *testing.T, name string) testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, _ := x509.ParseCertificate(der)
+	return testCA{cert: cert, key: key, pem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+// issue writes a certificate and key signed by the CA and returns their paths
+func (ca testCA) issue(t *testing.T, dir string, cn string, serial int64, usage x509.ExtKeyUsage) (certFile string, keyFile string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: cn},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		DNSNames:     []string{"localhost"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certFile = filepath.Join(dir, cn+".crt")
+	keyFile = filepath.Join(dir, cn+".key")
+	writeFile(t, certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	writeFile(t, keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}))
+	return
+}
+
+func writeFile(t *testing.T, path string, content []byte) {
+	if err := os.WriteFile(path, content, 0600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// handshake connects a client to a server with the given configs and returns the certificates seen by each side
+func handshake(t *testing.T, serverConf *tls.Config, clientConf *tls.Config) (serverSeen []*x509.Certificate, clientSeen []*x509.Certificate, err error) {
+	l, err := tls.Listen("tcp", "127.0.0.1:0", serverConf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	done := make(chan []*x509.Certificate, 1)
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			done <- nil
+			return
+		}
+		defer conn.Close()
+		tlsConn := conn.(*tls.Conn)
+		if err := tlsConn.Handshake(); err != nil {
+			done <- nil
+			return
+		}
+		done <- tlsConn.ConnectionState().PeerCertificates
+	}()
+
+	conn, err := tls.Dial("tcp", l.Addr().String(), clientConf)
+	if err != nil {
+		<-done
+		return nil, nil, err
+	}
+	// the server's verification of the client certificate is reported with the first read
+	conn.SetReadDeadline(time.Now().Add(time.Second))
+	_, _ = conn.Read(make([]byte, 1))
+	clientSeen = conn.ConnectionState().PeerCertificates
+	conn.Close()
+	serverSeen = <-done
+	if serverSeen == nil {
+		return nil, clientSeen, net.ErrClosed
+	}
+	return serverSeen, clientSeen, nil
+}
+
+func TestServerAndClientTLS(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	dir := t.TempDir()
+	ca := newTestCA(t, "test-ca")
+	caFile := filepath.Join(dir, "ca.crt")
+	writeFile(t, caFile, ca.pem)
+	serverCert, serverKey := ca.issue(t, dir, "server", 2, x509.ExtKeyUsageServerAuth)
+	clientCert, clientKey := ca.issue(t, dir, "study-service", 3, x509.ExtKeyUsageClientAuth)
+
+	serverConf, err := NewServerTLSConfig(ctx, ServerConfig{
+		CertFile:          serverCert,
+		KeyFile:           serverKey,
+		ClientCAFile:      caFile,
+		RequireClientCert: true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	t.Run("with client certificate", func(t *testing.T) {
+		clientConf, err := NewClientTLSConfig(ctx, ClientConfig{
+			Enabled:    true,
+			CAFile:     caFile,
+			CertFile:   clientCert,
+			KeyFile:    clientKey,
+			ServerName: "localhost",
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		serverSeen, clientSeen, err := handshake(t, serverConf, clientConf)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if serverSeen[0].Subject.CommonName != "study-service" || clientSeen[0].Subject.CommonName != "server" {
+			t.Errorf("unexpected certificates: %s %s", serverSeen[0].Subject, clientSeen[0].Subject)
+		}
+	})
+
+	t.Run("without client certificate", func(t *testing.T) {
+		clientConf, err := NewClientTLSConfig(ctx, ClientConfig{Enabled: true, CAFile: caFile, ServerName: "localhost"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, _, err := handshake(t, serverConf, clientConf); err == nil {
+			t.Error("client certificate should be required")
+		}
+	})
+
+	t.Run("with unknown server CA", func(t *testing.T) {
+		otherCAFile := filepath.Join(dir, "other-ca.crt")
+		writeFile(t, otherCAFile, newTestCA(t, "other-ca").pem)
+		clientConf, err := NewClientTLSConfig(ctx, ClientConfig{
+			Enabled:    true,
+			CAFile:     otherCAFile,
+			CertFile:   clientCert,
+			KeyFile:    clientKey,
+			ServerName: "localhost",
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, _, err := handshake(t, serverConf, clientConf); err == nil {
+			t.Error("server certificate should not be accepted")
+		}
+	})
+
+	t.Run("with wrong server name", func(t *testing.T) {
+		clientConf, err := NewClientTLSConfig(ctx, ClientConfig{
+			Enabled:    true,
+			CAFile:     caFile,
+			CertFile:   clientCert,
+			KeyFile:    clientKey,
+			ServerName: "other.host",
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, _, err := handshake(t, serverConf, clientConf); err == nil {
+			t.Error("server name should be verified")
+		}
+	})
+}
+
+func TestReload(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	dir := t.TempDir()
+	ca := newTestCA(t, "test-ca")
+	certFile, keyFile := ca.issue(t, dir, "server", 2, x509.ExtKeyUsageServerAuth)
+
+	store, err := newCertStore(ctx, certFile, keyFile, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	first, _ := store.get()
+
+	t.Run("without changes", func(t *testing.T) {
+		store.reload()
+		if cert, _ := store.get(); cert != first {
+			t.Error("certificate should not be reloaded")
+		}
+	})
+
+	t.Run("with invalid files", func(t *testing.T) {
+		writeFile(t, certFile, []byte("invalid"))
+		later := time.Now().Add(time.Minute)
+		os.Chtimes(certFile, later, later)
+		store.reload()
+		if cert, _ := store.get(); cert != first {
+			t.Error("previous certificate should stay in use")
+		}
+	})
+
+	t.Run("with rotated certificate", func(t *testing.T) {
+		ca.issue(t, dir, "server", 5, x509.ExtKeyUsageServerAuth)
+		later := time.Now().Add(2 * time.Minute)
+		os.Chtimes(certFile, later, later)
+		store.reload()
+		cert, _ := store.get()
+		if cert == first {
+			t.Fatal("certificate should be reloaded")
+		}
+		leaf, err := x509.ParseCertificate(cert.Certificate[0])
+		if err != nil || leaf.SerialNumber.Int64() != 5 {
+			t.Errorf("unexpected certificate: %v %v", leaf, err)
+		}
+	})
+}
+
+func TestConfigErrors(t *testing.T) {
+	ctx := context.Background()
+	if _, err := NewServerTLSConfig(ctx, ServerConfig{CertFile: "server.crt"}); err == nil {
+		t.Error("key file should be required")
+	}
+	if _, err := NewServerTLSConfig(ctx, ServerConfig{CertFile: "server.crt", KeyFile: "server.key", RequireClientCert: true}); err == nil {
+		t.Error("client CA should be required")
+	}
+	if _, err := NewClientTLSConfig(ctx, ClientConfig{Enabled: true, CertFile: "client.crt"}); err == nil {
+		t.Error("key file should be required")
+	}
+	if c, err := NewClientTLSConfig(ctx, ClientConfig{}); c != nil || err != nil {
+		t.Errorf("disabled config should be nil: %v %v", c, err)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	b64 "encoding/base64"
 
 	jwt "github.com/golang-jwt/jwt/v4"
+	api_types "github.com/influenzanet/go-utils/pkg/api_types"
 	"github.com/influenzanet/user-management-service/pkg/models"
 )
 
@@ -30,6 +31,20 @@ type UserClaims struct {
 	jwt.StandardClaims
 }
 
+// TokenInfos converts the claims into the token infos the endpoints get with their requests
+func (c UserClaims) TokenInfos() *api_types.TokenInfos {
+	return &api_types.TokenInfos{
+		Id:               c.ID,
+		InstanceId:       c.InstanceID,
+		IssuedAt:         c.IssuedAt,
+		AccountConfirmed: c.AccountConfirmed,
+		Payload:          c.Payload,
+		ProfilId:         c.ProfileID,
+		OtherProfileIds:  c.OtherProfileIDs,
+		TempToken:        c.TempTokenInfos.ToAPI(),
+	}
+}
+
 func getSecretKey() (newSecretKey []byte, err error) {
 	newSecretKeyEnc := os.Getenv("JWT_TOKEN_KEY")
 	if secretKeyEnc == newSecretKeyEnc {
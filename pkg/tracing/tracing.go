@@ -0,0 +1,78 @@
+// Package tracing sets up OpenTelemetry tracing. The gRPC and MongoDB instrumentation use the global
+// tracer provider, so they don't record anything as long as tracing is not initialized.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// ExporterOTLP sends spans over OTLP/gRPC, configured with the standard OTEL_EXPORTER_OTLP_* variables
+	ExporterOTLP = "otlp"
+	// ExporterStdout prints spans, for local runs
+	ExporterStdout = "stdout"
+
+	defaultServiceName  = "user-management-service"
+	instrumentationName = "github.com/influenzanet/user-management-service"
+)
+
+// Config selects the span exporter, tracing is disabled if Exporter is empty
+type Config struct {
+	Exporter    string
+	SampleRatio float64 // ratio of traces started here that are sampled, between 0 and 1
+}
+
+// Init sets the global tracer provider and propagator. The returned function flushes and stops the exporter.
+func Init(ctx context.Context, conf Config) (shutdown func(context.Context) error, err error) {
+	noop := func(context.Context) error { return nil }
+
+	var exporter sdktrace.SpanExporter
+	switch conf.Exporter {
+	case "":
+		return noop, nil
+	case ExporterOTLP:
+		exporter, err = otlptracegrpc.New(ctx)
+	case ExporterStdout:
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+	default:
+		return noop, fmt.Errorf("unknown tracing exporter: %s", conf.Exporter)
+	}
+	if err != nil {
+		return noop, err
+	}
+
+	// OTEL_SERVICE_NAME and OTEL_RESOURCE_ATTRIBUTES override the defaults
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(semconv.ServiceName(defaultServiceName)))
+	if err != nil {
+		return noop, err
+	}
+	res, err = resource.Merge(res, resource.Environment())
+	if err != nil {
+		return noop, err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(conf.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	return provider.Shutdown, nil
+}
+
+// StartSpan starts a span for work done within the service, e.g. password hashing
+func StartSpan(ctx context.Context, name string) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, name)
+}
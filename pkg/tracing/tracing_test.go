@@ -0,0 +1,48 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/sdk/trace"
+)
+
+func TestInit(t *testing.T) {
+	t.Run("disabled", func(t *testing.T) {
+		shutdown, err := Init(context.Background(), Config{})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if err := shutdown(context.Background()); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if _, ok := otel.GetTracerProvider().(*trace.TracerProvider); ok {
+			t.Error("tracer provider should not be set")
+		}
+	})
+
+	t.Run("unknown exporter", func(t *testing.T) {
+		if _, err := Init(context.Background(), Config{Exporter: "zipkin"}); err == nil {
+			t.Error("should fail")
+		}
+	})
+
+	t.Run("stdout exporter", func(t *testing.T) {
+		t.Setenv("OTEL_SERVICE_NAME", "test-service")
+		shutdown, err := Init(context.Background(), Config{Exporter: ExporterStdout, SampleRatio: 1})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer shutdown(context.Background())
+
+		if _, ok := otel.GetTracerProvider().(*trace.TracerProvider); !ok {
+			t.Error("tracer provider not set")
+		}
+		_, span := StartSpan(context.Background(), "test")
+		if !span.SpanContext().IsSampled() {
+			t.Error("span should be sampled")
+		}
+		span.End()
+	})
+}
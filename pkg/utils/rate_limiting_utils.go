@@ -23,3 +23,15 @@ func RemoveAttemptsOlderThan(attempts []int64, olderThanSeconds int64) []int64 {
 	}
 	return updated
 }
+
+// RetryDelay doubles the delay with each failed attempt, from minDelay up to maxDelay
+func RetryDelay(attempts int, minDelay time.Duration, maxDelay time.Duration) time.Duration {
+	delay := minDelay
+	for i := 1; i < attempts && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxDelay {
+		delay = maxDelay
+	}
+	return delay
+}
@@ -29,3 +29,24 @@ func TestHasMoreAttemptsRecently(t *testing.T) {
 	})
 
 }
+
+func TestRetryDelay(t *testing.T) {
+	minDelay := 10 * time.Second
+	maxDelay := time.Hour
+	cases := []struct {
+		attempts int
+		expected time.Duration
+	}{
+		{attempts: 0, expected: minDelay},
+		{attempts: 1, expected: minDelay},
+		{attempts: 2, expected: 2 * minDelay},
+		{attempts: 4, expected: 8 * minDelay},
+		{attempts: 20, expected: maxDelay},
+		{attempts: 1000, expected: maxDelay},
+	}
+	for _, c := range cases {
+		if d := RetryDelay(c.attempts, minDelay, maxDelay); d != c.expected {
+			t.Errorf("attempt %d: expected %s, got %s", c.attempts, c.expected, d)
+		}
+	}
+}
@@ -0,0 +1,212 @@
+// Package ldapserver is a minimal in-process LDAP server for tests. It understands simple binds,
+// searches matched by their exact filter string, and unbinds.
+package ldapserver
+
+import (
+	"net"
+	"strings"
+	"sync"
+
+	ber "github.com/go-asn1-ber/asn1-ber"
+	"github.com/go-ldap/ldap/v3"
+)
+
+const (
+	appBindRequest           = 0
+	appBindResponse          = 1
+	appUnbindRequest         = 2
+	appSearchRequest         = 3
+	appSearchResultEntry     = 4
+	appSearchResultDone      = 5
+	resultSuccess            = 0
+	resultOperationsError    = 1
+	resultInvalidCredentials = 49
+)
+
+// Entry is a directory entry with its password for simple binds
+type Entry struct {
+	DN         string
+	Password   string
+	Attributes map[string][]string
+}
+
+// Server serves the entries on a local port until Close is called
+type Server struct {
+	listener net.Listener
+	entries  []Entry
+	filterFn func(filter string, entry Entry) bool
+
+	mu       sync.Mutex
+	binds    []string
+	searches []string
+	wg       sync.WaitGroup
+}
+
+// Start listens on a random local port. matches decides whether an entry is returned for a search filter.
+func Start(entries []Entry, matches func(filter string, entry Entry) bool) (*Server, error) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	s := &Server{
+		listener: l,
+		entries:  entries,
+		filterFn: matches,
+	}
+	s.wg.Add(1)
+	go s.serve()
+	return s, nil
+}
+
+// URL to connect to the server
+func (s *Server) URL() string {
+	return "ldap://" + s.listener.Addr().String()
+}
+
+// Binds returns the DNs of all bind requests received so far
+func (s *Server) Binds() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string{}, s.binds...)
+}
+
+// Searches returns the filters of all search requests received so far
+func (s *Server) Searches() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string{}, s.searches...)
+}
+
+// Close stops the server
+func (s *Server) Close() {
+	s.listener.Close()
+	s.wg.Wait()
+}
+
+func (s *Server) serve() {
+	defer s.wg.Done()
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			defer conn.Close()
+			s.handle(conn)
+		}()
+	}
+}
+
+func (s *Server) handle(conn net.Conn) {
+	for {
+		packet, err := ber.ReadPacket(conn)
+		if err != nil || len(packet.Children) < 2 {
+			return
+		}
+		messageID, ok := packet.Children[0].Value.(int64)
+		if !ok {
+			return
+		}
+		op := packet.Children[1]
+		if op.ClassType != ber.ClassApplication {
+			return
+		}
+
+		switch op.Tag {
+		case appBindRequest:
+			code := s.bind(op)
+			if _, err := conn.Write(response(messageID, appBindResponse, code).Bytes()); err != nil {
+				return
+			}
+		case appSearchRequest:
+			for _, p := range s.search(messageID, op) {
+				if _, err := conn.Write(p.Bytes()); err != nil {
+					return
+				}
+			}
+		case appUnbindRequest:
+			return
+		default:
+			if _, err := conn.Write(response(messageID, int(op.Tag)+1, resultOperationsError).Bytes()); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func (s *Server) bind(op *ber.Packet) int64 {
+	if len(op.Children) < 3 {
+		return resultOperationsError
+	}
+	dn := string(op.Children[1].Data.Bytes())
+	password := string(op.Children[2].Data.Bytes())
+
+	s.mu.Lock()
+	s.binds = append(s.binds, dn)
+	s.mu.Unlock()
+
+	if dn == "" && password == "" {
+		// anonymous
+		return resultSuccess
+	}
+	for _, e := range s.entries {
+		if strings.EqualFold(e.DN, dn) && e.Password != "" && e.Password == password {
+			return resultSuccess
+		}
+	}
+	return resultInvalidCredentials
+}
+
+func (s *Server) search(messageID int64, op *ber.Packet) []*ber.Packet {
+	if len(op.Children) < 7 {
+		return []*ber.Packet{response(messageID, appSearchResultDone, resultOperationsError)}
+	}
+	filter, err := ldap.DecompileFilter(op.Children[6])
+	if err != nil {
+		return []*ber.Packet{response(messageID, appSearchResultDone, resultOperationsError)}
+	}
+
+	s.mu.Lock()
+	s.searches = append(s.searches, filter)
+	s.mu.Unlock()
+
+	packets := []*ber.Packet{}
+	for _, e := range s.entries {
+		if !s.filterFn(filter, e) {
+			continue
+		}
+		entry := ber.Encode(ber.ClassApplication, ber.TypeConstructed, appSearchResultEntry, nil, "Search Result Entry")
+		entry.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, e.DN, "Object Name"))
+		attrs := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "Attributes")
+		for name, values := range e.Attributes {
+			attr := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "Attribute")
+			attr.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, name, "Type"))
+			set := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSet, nil, "Values")
+			for _, v := range values {
+				set.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, v, "Value"))
+			}
+			attr.AppendChild(set)
+			attrs.AppendChild(attr)
+		}
+		entry.AppendChild(attrs)
+		packets = append(packets, envelope(messageID, entry))
+	}
+	return append(packets, response(messageID, appSearchResultDone, resultSuccess))
+}
+
+func response(messageID int64, tag int, code int64) *ber.Packet {
+	op := ber.Encode(ber.ClassApplication, ber.TypeConstructed, ber.Tag(tag), nil, "Response")
+	op.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagEnumerated, code, "Result Code"))
+	op.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "Matched DN"))
+	op.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "Diagnostic Message"))
+	return envelope(messageID, op)
+}
+
+func envelope(messageID int64, op *ber.Packet) *ber.Packet {
+	p := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "LDAP Message")
+	p.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, messageID, "Message ID"))
+	p.AppendChild(op)
+	return p
+}
@@ -14,6 +14,7 @@ import (
 	"golang.org/x/term"
 
 	"github.com/coneno/logger"
+	"github.com/influenzanet/user-management-service/pkg/fieldcrypt"
 	"github.com/influenzanet/user-management-service/pkg/models"
 
 	"github.com/influenzanet/go-utils/pkg/constants"
@@ -58,6 +59,13 @@ func reqfromCLI() (UserRequest, error) {
 func init() {
 	conf := getDBConfig()
 	userDBService = userdb.NewUserDBService(conf)
+	if path := os.Getenv("FIELD_ENCRYPTION_KEYS_FILE"); path != "" {
+		keys, err := fieldcrypt.LoadKeyRing(path)
+		if err != nil {
+			logger.Error.Fatal("FIELD_ENCRYPTION_KEYS_FILE: " + err.Error())
+		}
+		userDBService.EnableFieldEncryption(keys)
+	}
 }
 
 func main() {
@@ -7,6 +7,8 @@ export DB_TIMEOUT=30
 export DB_IDLE_CONN_TIMEOUT=45
 export DB_MAX_POOL_SIZE=8
 export DB_DB_NAME_PREFIX="<db name prefix if any used>"
+# keys file of the service, if it encrypts the email addresses
+export FIELD_ENCRYPTION_KEYS_FILE=""
 
 
 go run main.go "$@"
\ No newline at end of file
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"crypto/rand"
+	b64 "encoding/base64"
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/coneno/logger"
+	"github.com/influenzanet/user-management-service/internal/config"
+	"github.com/influenzanet/user-management-service/pkg/dbs/globaldb"
+	"github.com/influenzanet/user-management-service/pkg/models"
+)
+
+func main() {
+	clientName := flag.String("client", "", "Name of the directory client using the token.")
+	instances := flag.String("instances", "", "Comma separated list of instance IDs the token is valid for.")
+	flag.Parse()
+
+	if *clientName == "" || *instances == "" {
+		logger.Error.Fatal("client and instances must be provided")
+	}
+
+	instanceIDs := []string{}
+	for _, i := range strings.Split(*instances, ",") {
+		i = strings.TrimSpace(i)
+		if i != "" {
+			instanceIDs = append(instanceIDs, i)
+		}
+	}
+
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		logger.Error.Fatal(err)
+	}
+	token := b64.RawURLEncoding.EncodeToString(secret)
+
+	globalDBService := globaldb.NewGlobalDBService(config.GetGlobalDBConfig())
+	err := globalDBService.AddScimToken(models.ScimToken{
+		ClientName: *clientName,
+		Tokens:     []string{token},
+		Instances:  instanceIDs,
+	})
+	if err != nil {
+		logger.Error.Fatal(err)
+	}
+	fmt.Println(token)
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	b64 "encoding/base64"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/coneno/logger"
+	"github.com/influenzanet/user-management-service/pkg/callerauth"
+)
+
+func main() {
+	caller := flag.String("caller", "", "Name of the calling service, as used in the caller policy.")
+	validDays := flag.Int("valid-days", 0, "Number of days the token is valid, 0 for no expiry.")
+	flag.Parse()
+
+	if *caller == "" {
+		logger.Error.Fatal("caller must be provided")
+	}
+
+	key, err := b64.StdEncoding.DecodeString(os.Getenv("SERVICE_TOKEN_KEY"))
+	if err != nil {
+		logger.Error.Fatalf("SERVICE_TOKEN_KEY: %v", err)
+	}
+
+	token, err := callerauth.NewServiceToken(key, *caller, time.Duration(*validDays)*24*time.Hour)
+	if err != nil {
+		logger.Error.Fatal(err)
+	}
+	fmt.Println(token)
+}
@@ -0,0 +1,122 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/coneno/logger"
+	"github.com/influenzanet/user-management-service/internal/config"
+	"github.com/influenzanet/user-management-service/pkg/dbs/globaldb"
+	"github.com/influenzanet/user-management-service/pkg/dbs/userdb"
+	"github.com/influenzanet/user-management-service/pkg/instancesettings"
+	"github.com/influenzanet/user-management-service/pkg/models"
+	"github.com/influenzanet/user-management-service/pkg/timer_event"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func main() {
+	configFile := flag.String("config", os.Getenv(config.ENV_CONFIG_FILE), "YAML or JSON config file of the service, environment variables override its settings")
+	instancesF := flag.String("instances", "", "Comma separated list of instance IDs, all enabled instances if empty.")
+	jobsF := flag.String("jobs", strings.Join(timer_event.DryRunJobs, ","), "Comma separated list of jobs.")
+	cleanUpUnverifiedUsersAfter := flag.Duration("clean-up-unverified-users-after", 0, "Threshold to try instead of the one in use, e.g. 48h.")
+	notifyInactiveUsersAfter := flag.Duration("notify-inactive-users-after", 0, "Threshold to try instead of the one in use.")
+	deleteAccountAfterNotifyingUser := flag.Duration("delete-account-after-notifying-user", 0, "Threshold to try instead of the one in use.")
+	asJSON := flag.Bool("json", false, "Print the reports as JSON, one per line.")
+	flag.Parse()
+
+	conf, err := config.Load(*configFile)
+	if err != nil {
+		logger.Error.Fatal(err)
+	}
+
+	globalDBService := globaldb.NewGlobalDBService(conf.GlobalDBConfig)
+	userDBService := userdb.NewUserDBService(conf.UserDBConfig)
+	if conf.FieldKeys != nil {
+		userDBService.EnableFieldEncryption(conf.FieldKeys)
+	}
+	store := instancesettings.NewStore(globalDBService, conf.InstanceSettingsDefaults())
+	if err := store.Refresh(); err != nil {
+		logger.Error.Fatalf("Couldn't read instance settings: %v", err)
+	}
+
+	instanceIDs := splitList(*instancesF)
+	if len(instanceIDs) == 0 {
+		instanceIDs, err = globalDBService.GetEnabledInstanceIDs()
+		if err != nil {
+			logger.Error.Fatalf("Couldn't read instance IDs: %v", err)
+		}
+	}
+
+	// thresholds given on the command line replace the ones of every instance
+	overrides := models.InstanceSettings{
+		CleanUpUnverifiedUsersAfter:     seconds(*cleanUpUnverifiedUsersAfter),
+		NotifyInactiveUsersAfter:        seconds(*notifyInactiveUsersAfter),
+		DeleteAccountAfterNotifyingUser: seconds(*deleteAccountAfterNotifyingUser),
+	}
+	if err := instancesettings.Validate(overrides); err != nil {
+		logger.Error.Fatal(err)
+	}
+
+	out := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	defer out.Flush()
+	for _, instanceID := range instanceIDs {
+		settings := store.Get(instanceID).Apply(overrides)
+		for _, job := range splitList(*jobsF) {
+			report, err := timer_event.DryRun(userDBService, job, instanceID, settings, conf.ServiceURLs.StudyService != "")
+			if err != nil {
+				logger.Error.Fatalf("%s %s: %v", instanceID, job, err)
+			}
+			if *asJSON {
+				fmt.Println(protojson.Format(report.ToAPI()))
+			} else {
+				printReport(out, report)
+			}
+		}
+	}
+}
+
+func printReport(out *tabwriter.Writer, report models.DryRunReport) {
+	fmt.Fprintf(out, "%s / %s: %d accounts\n", report.InstanceID, report.Job, len(report.Accounts))
+	if report.Note != "" {
+		fmt.Fprintf(out, "  %s\n", report.Note)
+	}
+	if len(report.Accounts) > 0 {
+		fmt.Fprintln(out, "  user ID\taccount ID\tcreated\tlast login\tlast token refresh\tmarked for deletion\t")
+	}
+	for _, a := range report.Accounts {
+		fmt.Fprintf(out, "  %s\t%s\t%s\t%s\t%s\t%s\t\n", a.UserID, a.AccountID, date(a.CreatedAt), date(a.LastLogin), date(a.LastTokenRefresh), date(a.MarkedForDeletion))
+	}
+	fmt.Fprintln(out)
+	out.Flush()
+}
+
+func splitList(list string) []string {
+	items := []string{}
+	for _, i := range strings.Split(list, ",") {
+		i = strings.TrimSpace(i)
+		if i != "" {
+			items = append(items, i)
+		}
+	}
+	return items
+}
+
+// seconds returns nil for unset thresholds
+func seconds(d time.Duration) *int64 {
+	if d == 0 {
+		return nil
+	}
+	s := int64(d / time.Second)
+	return &s
+}
+
+func date(t int64) string {
+	if t == 0 {
+		return "-"
+	}
+	return time.Unix(t, 0).Format(time.RFC3339)
+}
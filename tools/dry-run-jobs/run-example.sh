@@ -0,0 +1,7 @@
+export CONFIG_FILE="<path to the config file of the service>"
+
+export USER_DB_PASSWORD="<db-password>"
+export GLOBAL_DB_PASSWORD="<db-password>"
+
+
+go run main.go "$@"
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/coneno/logger"
+	"github.com/influenzanet/user-management-service/internal/config"
+	"github.com/influenzanet/user-management-service/pkg/dbs/globaldb"
+	"github.com/influenzanet/user-management-service/pkg/dbs/userdb"
+)
+
+func main() {
+	configFile := flag.String("config", os.Getenv(config.ENV_CONFIG_FILE), "YAML or JSON config file of the service, environment variables override its settings")
+	instancesF := flag.String("instances", "", "Comma separated list of instance IDs, all enabled instances if empty.")
+	commit := flag.Bool("commit", false, "Encrypt the users, otherwise they are only counted.")
+	flag.Parse()
+
+	conf, err := config.Load(*configFile)
+	if err != nil {
+		logger.Error.Fatal(err)
+	}
+	if conf.FieldKeys == nil {
+		logger.Error.Fatalf("%s must be set", config.ENV_FIELD_ENCRYPTION_KEYS_FILE)
+	}
+
+	globalDBService := globaldb.NewGlobalDBService(conf.GlobalDBConfig)
+	userDBService := userdb.NewUserDBService(conf.UserDBConfig)
+	userDBService.EnableFieldEncryption(conf.FieldKeys)
+
+	instanceIDs := []string{}
+	for _, i := range strings.Split(*instancesF, ",") {
+		if i = strings.TrimSpace(i); i != "" {
+			instanceIDs = append(instanceIDs, i)
+		}
+	}
+	if len(instanceIDs) == 0 {
+		instanceIDs, err = globalDBService.GetEnabledInstanceIDs()
+		if err != nil {
+			logger.Error.Fatalf("Couldn't read instance IDs: %v", err)
+		}
+	}
+
+	for _, instanceID := range instanceIDs {
+		// the index on the blind index is needed by the lookups once the users are encrypted
+		if err := userDBService.CreateIndexForUser(instanceID); err != nil {
+			logger.Error.Fatalf("%s: %v", instanceID, err)
+		}
+		count, err := userDBService.EncryptUserFields(context.Background(), instanceID, *commit)
+		if err != nil {
+			logger.Error.Fatalf("%s: %v", instanceID, err)
+		}
+		if *commit {
+			fmt.Printf("%s: %d users encrypted with key %s\n", instanceID, count, conf.FieldKeys.ActiveKey())
+		} else {
+			fmt.Printf("%s: %d users to encrypt with key %s\n", instanceID, count, conf.FieldKeys.ActiveKey())
+		}
+	}
+	if !*commit {
+		fmt.Println("Nothing was changed, add -commit to encrypt the users.")
+	}
+}
@@ -0,0 +1,8 @@
+export CONFIG_FILE="<path to the config file of the service>"
+export FIELD_ENCRYPTION_KEYS_FILE="<path to the keys file>"
+
+export USER_DB_PASSWORD="<db-password>"
+export GLOBAL_DB_PASSWORD="<db-password>"
+
+
+go run main.go "$@"
@@ -7,27 +7,25 @@ import (
 	"flag"
 	"fmt"
 	"math/rand"
+	"os"
 	"time"
 
 	"github.com/coneno/logger"
 	"github.com/influenzanet/user-management-service/internal/config"
+	"github.com/influenzanet/user-management-service/pkg/dbs/globaldb"
 	"github.com/influenzanet/user-management-service/pkg/dbs/userdb"
+	"github.com/influenzanet/user-management-service/pkg/instancesettings"
 	"github.com/influenzanet/user-management-service/pkg/models"
 )
 
-var userDBService *userdb.UserDBService
-
 type commandParams struct {
-	instance string
-	commit   bool
-}
-
-func init() {
-	conf := config.GetUserDBConfig()
-	userDBService = userdb.NewUserDBService(conf)
+	configFile string
+	instance   string
+	commit     bool
 }
 
 func loadParams() commandParams {
+	configFileF := flag.String("config", os.Getenv(config.ENV_CONFIG_FILE), "YAML or JSON config file of the service, environment variables override its settings")
 	instanceF := flag.String("instance", "", "Defines the instance ID.")
 	commitF := flag.Bool("commit", false, "Commit the changes")
 
@@ -37,7 +35,7 @@ func loadParams() commandParams {
 		logger.Error.Fatal("instance must be provided")
 	}
 	commit := *commitF
-	return commandParams{instance: instance, commit: commit}
+	return commandParams{configFile: *configFileF, instance: instance, commit: commit}
 }
 
 type weekdayCounter struct {
@@ -61,10 +59,26 @@ func main() {
 
 	rand.Seed(time.Now().UnixNano())
 
-	weekdayStrategy := config.GetWeekDayStrategy()
-
 	params := loadParams()
 
+	conf, err := config.Load(params.configFile)
+	if err != nil {
+		logger.Error.Fatal(err)
+	}
+
+	globalDBService := globaldb.NewGlobalDBService(conf.GlobalDBConfig)
+	userDBService := userdb.NewUserDBService(conf.UserDBConfig)
+	if conf.FieldKeys != nil {
+		userDBService.EnableFieldEncryption(conf.FieldKeys)
+	}
+	store := instancesettings.NewStore(globalDBService, conf.InstanceSettingsDefaults())
+	if err := store.Refresh(); err != nil {
+		logger.Error.Fatalf("Couldn't read instance settings: %v", err)
+	}
+
+	weekdayStrategy := store.Get(params.instance).WeekdayStrategy
+	fmt.Println("Weekday Strategy: ", weekdayStrategy.String())
+
 	userFilter := userdb.UserFilter{
 		OnlyConfirmed:   false,
 		ReminderWeekDay: -1,
@@ -76,7 +90,7 @@ func main() {
 	count_scanned := 0
 
 	ctx := context.Background()
-	err := userDBService.PerfomActionForUsers(ctx, params.instance, userFilter, func(instanceID string, user models.User, args ...interface{}) error {
+	err = userDBService.PerfomActionForUsers(ctx, params.instance, userFilter, func(instanceID string, user models.User, args ...interface{}) error {
 
 		//fmt.Printf("user %s %d\n", user.ID, user.ContactPreferences.ReceiveWeeklyMessageDayOfWeek)
 